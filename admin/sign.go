@@ -2,6 +2,7 @@
 package admin
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"math/big"
@@ -21,6 +22,10 @@ const (
 	swapAdminToAddress = "0x00000000000000000000000000000000000000cc"
 	// swapAdminChainID to make swap admin signer
 	swapAdminChainID = 30300
+
+	// ApproveRefundMethod admin method to approve refund,
+	// the signed call is kept as approval of refunds which need it.
+	ApproveRefundMethod = "approverefund"
 )
 
 var (
@@ -44,6 +49,11 @@ type CallArgs struct {
 // Sign sign
 func Sign(method string, params []string) (rawTx string, err error) {
 	log.Info("admin Sign", "method", method, "params", params)
+	return SignWithKey(keyWrapper.PrivateKey, method, params)
+}
+
+// SignWithKey sign with private key
+func SignWithKey(privKey *ecdsa.PrivateKey, method string, params []string) (rawTx string, err error) {
 	payload, err := encodeCallArgs(method, params)
 	if err != nil {
 		return "", err
@@ -58,7 +68,7 @@ func Sign(method string, params []string) (rawTx string, err error) {
 		payload,       // data
 	)
 
-	signedTx, err := types.SignTx(tx, adminSigner, keyWrapper.PrivateKey)
+	signedTx, err := types.SignTx(tx, adminSigner, privKey)
 	if err != nil {
 		return "", err
 	}
@@ -102,10 +112,7 @@ func decodeCallArgs(data []byte) (*CallArgs, error) {
 
 // VerifyTransaction get sender
 func VerifyTransaction(tx *types.Transaction) (*common.Address, *CallArgs, error) {
-	if tx.To() == nil || *tx.To() != adminToAddr {
-		return nil, nil, errors.New("wrong admin tx to address")
-	}
-	args, err := decodeCallArgs(tx.Data())
+	sender, args, err := RecoverTransaction(tx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,6 +124,18 @@ func VerifyTransaction(tx *types.Transaction) (*common.Address, *CallArgs, error
 	if now+maxFutureSeconds < timestamp {
 		return nil, nil, errors.New("future admin tx timestamp")
 	}
+	return sender, args, nil
+}
+
+// RecoverTransaction get sender without checking the tx lifetime (eg. kept approval)
+func RecoverTransaction(tx *types.Transaction) (*common.Address, *CallArgs, error) {
+	if tx.To() == nil || *tx.To() != adminToAddr {
+		return nil, nil, errors.New("wrong admin tx to address")
+	}
+	args, err := decodeCallArgs(tx.Data())
+	if err != nil {
+		return nil, nil, err
+	}
 	sender, err := adminSigner.Sender(tx) // will verify signature
 	if err != nil {
 		return nil, nil, err
@@ -87,6 +87,26 @@ forbid specified swap
 				Flags:  swapKeyFlags,
 				Description: `
 pass forbidden swapout
+`,
+			},
+			{
+				Name:   "approverefund",
+				Usage:  "approve refund of undeliverable swap",
+				Action: approverefund,
+				Flags:  swapKeyFlags,
+				Description: `
+approve pending or failed refund of undeliverable swap,
+the refund of swap made fail manually needs the approval
+signed by one of 'ManualRefundApprovers' in refund config.
+`,
+			},
+			{
+				Name:      "confirmconfigchange",
+				Usage:     "confirm held dangerous config change",
+				Action:    confirmconfigchange,
+				ArgsUsage: "<changeKey|all>",
+				Description: `
+confirm held dangerous config change, it will be applied in reloading
 `,
 			},
 		},
@@ -259,3 +279,47 @@ func passforbiddenswapout(ctx *cli.Context) error {
 	log.Printf("result is '%v'", result)
 	return err
 }
+
+func approverefund(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	method := admin.ApproveRefundMethod
+	err := admin.Prepare(ctx)
+	if err != nil {
+		return err
+	}
+	chainID, txid, logIndex, err := getKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%v: %v %v %v", method, chainID, txid, logIndex)
+
+	params := []string{chainID, txid, logIndex}
+	result, err := admin.SwapAdmin(method, params)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
+
+func confirmconfigchange(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() == 0 {
+		return fmt.Errorf("confirmconfigchange: no change key is specified")
+	}
+
+	method := "confirmconfigchange"
+	err := admin.Prepare(ctx)
+	if err != nil {
+		return err
+	}
+
+	changeKey := ctx.Args().Get(0)
+
+	log.Printf("%v: %v", method, changeKey)
+
+	params := []string{changeKey}
+	result, err := admin.SwapAdmin(method, params)
+
+	log.Printf("result is '%v'", result)
+	return err
+}
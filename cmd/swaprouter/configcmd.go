@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"os"
 
 	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
 	"github.com/deltaswapio/swaprouter/v3/common"
@@ -186,6 +187,21 @@ query router config
 					gatewaysFlag,
 				},
 			},
+			{
+				Name:      "diffSnapshot",
+				Usage:     "diff local config snapshot with onchain contract",
+				Action:    diffSnapshot,
+				ArgsUsage: "<snapshotFile|snapshotDir>",
+				Description: `
+diff local config snapshot with onchain contract.
+if snapshot dir is specified, use the latest snapshot in it.
+if contract is not specified, use the contract in snapshot.
+`,
+				Flags: []cli.Flag{
+					onchainContractFlag,
+					gatewaysFlag,
+				},
+			},
 		},
 	}
 
@@ -471,6 +487,51 @@ func getMPCPubkey(ctx *cli.Context) error {
 	return nil
 }
 
+func diffSnapshot(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	if ctx.NArg() < 1 {
+		return fmt.Errorf("miss required position argument")
+	}
+	snapshotPath := ctx.Args().Get(0)
+	fileInfo, err := os.Stat(snapshotPath)
+	if err != nil {
+		return err
+	}
+	var snapshot *router.ConfigSnapshot
+	if fileInfo.IsDir() {
+		snapshot, err = router.LoadLatestConfigSnapshot(snapshotPath)
+	} else {
+		snapshot, err = router.LoadConfigSnapshot(snapshotPath)
+	}
+	if err != nil {
+		return err
+	}
+	contract := ctx.String(onchainContractFlag.Name)
+	if contract == "" {
+		contract = snapshot.Contract
+	}
+	router.InitRouterConfigClientsWithArgs(
+		contract,
+		ctx.StringSlice(gatewaysFlag.Name),
+	)
+	diffs, err := router.DiffConfigSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("snapshot block number is %v, timestamp is %v, calls count is %v\n",
+		snapshot.BlockNumber, snapshot.Timestamp, len(snapshot.Calls))
+	if len(diffs) == 0 {
+		fmt.Println("snapshot is same as onchain contract")
+		return nil
+	}
+	jsdata, err := json.MarshalIndent(diffs, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println("snapshot diffs are", string(jsdata))
+	return nil
+}
+
 //nolint:dupl // allow duplicate
 func getAllMultichainTokens(ctx *cli.Context) error {
 	utils.SetLogger(ctx)
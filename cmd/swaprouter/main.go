@@ -33,6 +33,9 @@ func initApp() {
 	app.Commands = []*cli.Command{
 		adminCommand,
 		configCommand,
+		remoteSignerCommand,
+		scanCommand,
+		signOfflineCommand,
 		toolsCommand,
 		utils.LicenseCommand,
 		utils.VersionCommand,
@@ -75,7 +78,7 @@ func swaprouter(ctx *cli.Context) error {
 	configFile := utils.GetConfigFilePath(ctx)
 	config := params.LoadRouterConfig(configFile, isServer, true)
 
-	tokens.InitRouterSwapType(config.SwapType)
+	tokens.InitRouterSwapTypes(config.GetSwapTypes())
 
 	if isServer {
 		appName := params.GetIdentifier()
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/keyprovider"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	remoteSignerCommand = &cli.Command{
+		Name:   "remote-signer",
+		Usage:  "serve remote sign requests with the keys of the key provider",
+		Action: remoteSigner,
+		Flags: append([]cli.Flag{
+			utils.ConfigFileFlag,
+			remoteSignerListenFlag,
+			remoteSignerAuthTokenFlag,
+		}, utils.CommonLogFlags...),
+		Description: `
+a stand-in remote signer for the 'remote' key provider.
+
+the keys are loaded from the '[MPC.KeyProvider]' section of the
+config file, which must be of type 'keystore' or 'pkcs11'.
+every request is answered with a signature of the requested
+sign pubkey, the server verifies the signature before using it.
+
+usage:
+
+swaprouter remote-signer --config <file> [--listen <address>] [--authtoken <file>]
+`,
+	}
+
+	remoteSignerListenFlag = &cli.StringFlag{
+		Name:  "listen",
+		Usage: "listen address of the remote signer",
+		Value: "127.0.0.1:8800",
+	}
+
+	remoteSignerAuthTokenFlag = &cli.StringFlag{
+		Name:  "authtoken",
+		Usage: "bearer auth token file of the remote signer",
+	}
+)
+
+func remoteSigner(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+
+	params.LoadRouterConfig(utils.GetConfigFilePath(ctx), true, false)
+
+	cfg := params.GetMPCConfig(false).KeyProvider
+	if cfg == nil {
+		return errors.New("remote signer must config '[MPC.KeyProvider]'")
+	}
+	if cfg.Type == keyprovider.TypeRemote {
+		return errors.New("remote signer can not use 'remote' key provider")
+	}
+	if err := cfg.CheckConfig(); err != nil {
+		return err
+	}
+	provider, err := keyprovider.NewKeyProvider(cfg)
+	if err != nil {
+		return err
+	}
+
+	authToken := ""
+	if authTokenFile := ctx.String(remoteSignerAuthTokenFlag.Name); authTokenFile != "" {
+		data, errf := tools.SafeReadFile(authTokenFile)
+		if errf != nil {
+			return fmt.Errorf("read auth token fail %w", errf)
+		}
+		authToken = strings.TrimSpace(string(data))
+	}
+
+	listen := ctx.String(remoteSignerListenFlag.Name)
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           keyprovider.NewRemoteSignerHandler(provider, authToken),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+	log.Info("remote signer is running", "type", cfg.Type, "listen", listen, "withAuthToken", authToken != "")
+	return server.ListenAndServe()
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/internal/swapapi"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/router/bridge"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	scanCommand = &cli.Command{
+		Name:   "scan",
+		Usage:  "scan block range to find missed swaps",
+		Action: scanSwaps,
+		Flags: append([]cli.Flag{
+			utils.DataDirFlag,
+			utils.ConfigFileFlag,
+			utils.GatewayConfigFlag,
+			scanChainFlag,
+			scanFromFlag,
+			scanToFlag,
+			scanStepFlag,
+			scanRegisterFlag,
+		}, utils.CommonLogFlags...),
+		Description: `
+scan block range [from, to] of the specified chain,
+verify every candidate tx with the chain's bridge,
+and report the swaps which are not registered in database.
+if '--register' is specified, register the missed swaps.
+
+usage:
+
+swaprouter scan -c config.toml --chain <chainID> --from <height> --to <height> [--register]
+`,
+	}
+
+	scanChainFlag = &cli.StringFlag{
+		Name:     "chain",
+		Usage:    "chain id to scan",
+		Required: true,
+	}
+
+	scanFromFlag = &cli.Uint64Flag{
+		Name:     "from",
+		Usage:    "start height (inclusive)",
+		Required: true,
+	}
+
+	scanToFlag = &cli.Uint64Flag{
+		Name:  "to",
+		Usage: "end height (inclusive), default to latest height",
+	}
+
+	scanStepFlag = &cli.Uint64Flag{
+		Name:  "step",
+		Usage: "count of blocks to scan in one request",
+		Value: 100,
+	}
+
+	scanRegisterFlag = &cli.BoolFlag{
+		Name:  "register",
+		Usage: "register missed swaps",
+	}
+)
+
+func scanSwaps(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+
+	chainID, err := common.GetBigIntFromStr(ctx.String(scanChainFlag.Name))
+	if err != nil {
+		return fmt.Errorf("wrong chain id '%v'", ctx.String(scanChainFlag.Name))
+	}
+	start := ctx.Uint64(scanFromFlag.Name)
+	end := ctx.Uint64(scanToFlag.Name)
+	step := ctx.Uint64(scanStepFlag.Name)
+	if step == 0 {
+		step = 1
+	}
+	doRegister := ctx.Bool(scanRegisterFlag.Name)
+
+	params.SetDataDir(utils.GetDataDir(ctx), true)
+	if ctx.IsSet(utils.GatewayConfigFlag.Name) {
+		params.GatewayConfigFile = ctx.String(utils.GatewayConfigFlag.Name)
+	}
+	config := params.LoadRouterConfig(utils.GetConfigFilePath(ctx), true, true)
+	tokens.InitRouterSwapTypes(config.GetSwapTypes())
+
+	dbConfig := config.Server.MongoDB
+	mongodb.MongoServerInit(
+		params.GetIdentifier(),
+		dbConfig.DBURLs,
+		dbConfig.DBName,
+		dbConfig.UserName,
+		dbConfig.Password,
+	)
+
+	bridge.InitRouterBridges(true)
+
+	br := router.GetBridgeByChainID(chainID.String())
+	if br == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	scanner, ok := br.(tokens.ISwapTxScanner)
+	if !ok {
+		return fmt.Errorf("chain %v does not support scanning", chainID)
+	}
+
+	if end == 0 {
+		end, err = br.GetLatestBlockNumber()
+		if err != nil {
+			return err
+		}
+	}
+	if start > end {
+		return fmt.Errorf("wrong block range [%v, %v]", start, end)
+	}
+
+	log.Info("start scan swaps", "chainID", chainID, "from", start, "to", end, "step", step, "register", doRegister)
+
+	missed := 0
+	err = scanBlockRanges(start, end, step, func(from, to uint64) error {
+		txHashes, errs := scanner.ScanSwapTxs(from, to)
+		if errs != nil {
+			return fmt.Errorf("scan blocks [%v, %v] failed: %w", from, to, errs)
+		}
+		log.Info("scan blocks finished", "chainID", chainID, "from", from, "to", to, "txs", len(txHashes))
+		for _, txHash := range txHashes {
+			missed += processScannedTx(br, chainID.String(), txHash, doRegister)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("scan swaps finished", "chainID", chainID, "from", start, "to", end, "missed", missed)
+	return nil
+}
+
+// scanBlockRanges split [start, end] into ranges of step blocks and scan them in order
+// (without overflow when end is near the max uint64)
+func scanBlockRanges(start, end, step uint64, scan func(from, to uint64) error) error {
+	for from := start; ; from += step {
+		if end-from < step {
+			return scan(from, end)
+		}
+		if err := scan(from, from+step-1); err != nil {
+			return err
+		}
+	}
+}
+
+// processScannedTx returns the count of missed swaps in the tx
+func processScannedTx(br tokens.IBridge, chainID, txHash string, doRegister bool) (missed int) {
+	swapInfos, errs := router.RegisterSwap(br, txHash, 0)
+	for i, swapInfo := range swapInfos {
+		verifyErr := errs[i]
+		if !tokens.ShouldRegisterRouterSwapForError(verifyErr) {
+			log.Trace("ignore scanned tx", "chainID", chainID, "txid", txHash, "err", verifyErr)
+			continue
+		}
+		logIndex := swapInfo.LogIndex
+		if _, registeredOk := mongodb.GetRegisteredRouterSwap(chainID, txHash, logIndex); registeredOk {
+			continue
+		}
+		missed++
+		log.Warn("found missed swap", "chainID", chainID, "txid", txHash, "logIndex", logIndex, "verifyErr", verifyErr)
+		if !doRegister {
+			continue
+		}
+		result, err := swapapi.RegisterRouterSwap(chainID, txHash, fmt.Sprint(logIndex))
+		if err != nil {
+			log.Warn("register missed swap failed", "chainID", chainID, "txid", txHash, "logIndex", logIndex, "err", err)
+		} else {
+			log.Info("register missed swap", "chainID", chainID, "txid", txHash, "logIndex", logIndex, "result", result)
+		}
+	}
+	return missed
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestScanBlockRanges(t *testing.T) {
+	tests := []struct {
+		start, end, step uint64
+		want             string
+	}{
+		{1, 10, 5, "[1 5] [6 10] "},
+		{1, 10, 4, "[1 4] [5 8] [9 10] "},
+		{7, 7, 3, "[7 7] "},
+		{0, 2, 1, "[0 0] [1 1] [2 2] "},
+		{math.MaxUint64 - 4, math.MaxUint64, 2, fmt.Sprintf("[%v %v] [%v %v] [%v %v] ",
+			uint64(math.MaxUint64-4), uint64(math.MaxUint64-3),
+			uint64(math.MaxUint64-2), uint64(math.MaxUint64-1),
+			uint64(math.MaxUint64), uint64(math.MaxUint64))},
+		{math.MaxUint64 - 1, math.MaxUint64, math.MaxUint64, fmt.Sprintf("[%v %v] ", uint64(math.MaxUint64-1), uint64(math.MaxUint64))},
+	}
+	for i, tt := range tests {
+		var have string
+		count := 0
+		err := scanBlockRanges(tt.start, tt.end, tt.step, func(from, to uint64) error {
+			if count++; count > 10 {
+				return errors.New("too many ranges")
+			}
+			have += fmt.Sprintf("[%v %v] ", from, to)
+			return nil
+		})
+		if err != nil || have != tt.want {
+			t.Errorf("case %d: scanned ranges %v (err %v), want %v", i, have, err, tt.want)
+		}
+	}
+
+	errScan := errors.New("scan failed")
+	var scanned int
+	err := scanBlockRanges(1, 10, 2, func(from, to uint64) error {
+		scanned++
+		if from == 3 {
+			return errScan
+		}
+		return nil
+	})
+	if !errors.Is(err, errScan) || scanned != 2 {
+		t.Errorf("scan error = %v after %d ranges, want %v after 2", err, scanned, errScan)
+	}
+}
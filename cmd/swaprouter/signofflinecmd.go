@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mpc"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router/bridge"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+	"github.com/deltaswapio/swaprouter/v3/tools/keystore"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	signOfflineCommand = &cli.Command{
+		Name:   "sign-offline",
+		Usage:  "sign exported tx bundles on an air-gapped machine",
+		Action: signOffline,
+		Flags: append([]cli.Flag{
+			utils.ConfigFileFlag,
+			signOfflineSpoolFlag,
+			utils.KeystoreFileFlag,
+			utils.PasswordFileFlag,
+			signOfflineEDKeyFlag,
+			signOfflineDryRunFlag,
+		}, utils.CommonLogFlags...),
+		Description: `
+sign tx bundles exported by the server in offline sign mode.
+every bundle is verified by the chain's bridge before signing,
+the raw tx must match the msg hash (VerifyMsgHash) and the build
+args (the tx is rebuilt on EVM chains), and the signature is
+written to the spool dir for the server to import.
+
+as the build args are untrusted, the router call in the tx input
+is decoded and checked against the claimed swap on EVM chains,
+its router contract and token must be in '[MPC.OfflineSignChains]'.
+
+the config file is the same as the server's, and is used to
+verify the chain specific settings (eg. solana compute budget).
+
+EC256K1 bundles are signed with '--keystore' and '--password',
+ED25519 bundles are signed with '--edkey'.
+
+usage:
+
+swaprouter sign-offline --config <file> --spool <dir> [--keystore <file> --password <file>] [--edkey <file>] [--dryrun]
+`,
+	}
+
+	signOfflineSpoolFlag = &cli.StringFlag{
+		Name:     "spool",
+		Usage:    "offline sign spool directory",
+		Required: true,
+	}
+
+	signOfflineEDKeyFlag = &cli.StringFlag{
+		Name:  "edkey",
+		Usage: "ED25519 private key file (hex encoded seed or private key)",
+	}
+
+	signOfflineDryRunFlag = &cli.BoolFlag{
+		Name:  "dryrun",
+		Usage: "verify bundles only, do not sign",
+	}
+
+	offlineBridges = make(map[string]tokens.IBridge)
+)
+
+type offlineSigner struct {
+	ecKey *keystore.Key
+	edKey ed25519.PrivateKey
+}
+
+func signOffline(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+
+	params.LoadRouterConfig(utils.GetConfigFilePath(ctx), true, false)
+
+	spoolDir := ctx.String(signOfflineSpoolFlag.Name)
+	dryRun := ctx.Bool(signOfflineDryRunFlag.Name)
+
+	signer, err := loadOfflineSigner(ctx)
+	if err != nil {
+		return err
+	}
+
+	bundleFiles, err := mpc.ListOfflineBundleFiles(spoolDir)
+	if err != nil {
+		return err
+	}
+	log.Info("start sign offline bundles", "spoolDir", spoolDir, "count", len(bundleFiles), "dryrun", dryRun)
+
+	processed, failed := 0, 0
+	for _, bundleFile := range bundleFiles {
+		err = signOfflineBundle(signer, spoolDir, bundleFile, dryRun)
+		if err != nil {
+			failed++
+			log.Warn("sign offline bundle failed", "file", bundleFile, "err", err)
+			continue
+		}
+		processed++
+	}
+
+	log.Info("sign offline bundles finished", "spoolDir", spoolDir, "processed", processed, "failed", failed, "dryrun", dryRun)
+	return nil
+}
+
+func loadOfflineSigner(ctx *cli.Context) (*offlineSigner, error) {
+	signer := &offlineSigner{}
+	if keyfile := ctx.String(utils.KeystoreFileFlag.Name); keyfile != "" {
+		key, err := tools.LoadKeyStore(keyfile, ctx.String(utils.PasswordFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		signer.ecKey = key
+		log.Info("load EC256K1 key success", "address", key.Address.String())
+	}
+	if edkeyfile := ctx.String(signOfflineEDKeyFlag.Name); edkeyfile != "" {
+		key, err := loadED25519Key(edkeyfile)
+		if err != nil {
+			return nil, err
+		}
+		signer.edKey = key
+		log.Info("load ED25519 key success", "pubkey", common.ToHex(key.Public().(ed25519.PublicKey)))
+	}
+	if signer.ecKey == nil && signer.edKey == nil {
+		return nil, errors.New("no signing key is specified")
+	}
+	return signer, nil
+}
+
+// loadED25519Key load hex encoded seed or private key from file
+func loadED25519Key(keyfile string) (ed25519.PrivateKey, error) {
+	data, err := tools.SafeReadFile(keyfile)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes := common.FromHex(strings.TrimSpace(string(data)))
+	switch len(keyBytes) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(keyBytes), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(keyBytes), nil
+	default:
+		return nil, fmt.Errorf("wrong ED25519 private key length %v", len(keyBytes))
+	}
+}
+
+func signOfflineBundle(signer *offlineSigner, spoolDir, bundleFile string, dryRun bool) error {
+	data, err := tools.SafeReadFile(bundleFile)
+	if err != nil {
+		return err
+	}
+	var bundle mpc.OfflineSignBundle
+	if err = json.Unmarshal(data, &bundle); err != nil {
+		return err
+	}
+
+	sigFile := mpc.GetOfflineSignatureFile(spoolDir, bundle.KeyID)
+	if _, err = os.Stat(sigFile); err == nil {
+		log.Info("ignore already signed bundle", "keyID", bundle.KeyID)
+		return nil
+	}
+
+	if bundle.KeyID != mpc.GetOfflineSignKeyID(bundle.SignPubkey, bundle.MsgHash) {
+		return errors.New("bundle keyID mismatch")
+	}
+
+	args := bundle.BuildTxArgs
+	if args == nil {
+		return errors.New("bundle without build tx args")
+	}
+
+	br, err := getOfflineBridge(bundle.ChainID)
+	if err != nil {
+		return err
+	}
+	offlineSigner := br.(tokens.IOfflineSigner)
+	rawTx, err := offlineSigner.DecodeOfflineRawTx(bundle.RawTx)
+	if err != nil {
+		return fmt.Errorf("decode raw tx failed: %w", err)
+	}
+	if err = br.VerifyMsgHash(rawTx, bundle.MsgHash); err != nil {
+		return fmt.Errorf("verify msg hash failed: %w", err)
+	}
+	if err = offlineSigner.VerifyOfflineRawTx(rawTx, args); err != nil {
+		return fmt.Errorf("verify raw tx by build args failed: %w", err)
+	}
+
+	log.Info("verify offline bundle success", "keyID", bundle.KeyID, "chainID", bundle.ChainID,
+		"signType", bundle.SignType, "msgHash", bundle.MsgHash, "swapID", args.SwapID,
+		"fromChainID", args.FromChainID, "bind", args.Bind, "swapValue", args.SwapValue,
+		"nonce", args.GetTxNonce())
+
+	if dryRun {
+		return nil
+	}
+
+	rsvs := make([]string, 0, len(bundle.MsgHash))
+	for _, msgHash := range bundle.MsgHash {
+		rsv, errs := signer.sign(&bundle, msgHash)
+		if errs != nil {
+			return errs
+		}
+		rsvs = append(rsvs, rsv)
+	}
+
+	sigData, err := json.MarshalIndent(&mpc.OfflineSignature{
+		KeyID: bundle.KeyID,
+		Rsvs:  rsvs,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(sigFile, sigData, 0o600); err != nil {
+		return err
+	}
+	log.Info("sign offline bundle success", "keyID", bundle.KeyID, "chainID", bundle.ChainID, "file", sigFile)
+	return nil
+}
+
+func getOfflineBridge(chainID string) (tokens.IBridge, error) {
+	if br, exist := offlineBridges[chainID]; exist {
+		return br, nil
+	}
+	biChainID, err := common.GetBigIntFromStr(chainID)
+	if err != nil || biChainID.Sign() <= 0 {
+		return nil, fmt.Errorf("wrong chain id '%v'", chainID)
+	}
+	br := bridge.NewCrossChainBridge(biChainID)
+	signer, ok := br.(tokens.IOfflineSigner)
+	if !ok {
+		return nil, fmt.Errorf("chain %v does not support offline sign", chainID)
+	}
+	br.SetChainConfig(&tokens.ChainConfig{ChainID: chainID})
+	if !signer.IsOfflineSignSupported() {
+		return nil, fmt.Errorf("chain %v does not support offline sign", chainID)
+	}
+	if err = signer.InitOfflineSigner(); err != nil {
+		return nil, err
+	}
+	offlineBridges[chainID] = br
+	return br, nil
+}
+
+func (s *offlineSigner) sign(bundle *mpc.OfflineSignBundle, msgHash string) (rsv string, err error) {
+	pubkey := common.FromHex(bundle.SignPubkey)
+	if mpc.IsECSignType(bundle.SignType) {
+		if s.ecKey == nil {
+			return "", errors.New("no EC256K1 key to sign")
+		}
+		if !bytes.Equal(pubkey, crypto.FromECDSAPub(&s.ecKey.PrivateKey.PublicKey)) {
+			return "", errors.New("EC256K1 key mismatch with sign pubkey")
+		}
+		sig, errs := crypto.Sign(common.FromHex(msgHash), s.ecKey.PrivateKey)
+		if errs != nil {
+			return "", errs
+		}
+		return hex.EncodeToString(sig), nil
+	}
+	if s.edKey == nil {
+		return "", errors.New("no ED25519 key to sign")
+	}
+	if len(pubkey) == ed25519.PublicKeySize+1 && pubkey[0] == 0xED {
+		pubkey = pubkey[1:]
+	}
+	if !s.edKey.Public().(ed25519.PublicKey).Equal(ed25519.PublicKey(pubkey)) {
+		return "", errors.New("ED25519 key mismatch with sign pubkey")
+	}
+	sig := ed25519.Sign(s.edKey, common.FromHex(msgHash))
+	return hex.EncodeToString(sig), nil
+}
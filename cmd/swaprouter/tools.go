@@ -1,12 +1,19 @@
 package main
 
 import (
+	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
 	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/keyprovider"
+	"github.com/deltaswapio/swaprouter/v3/tools/keystore"
 	"github.com/mr-tron/base58"
 	"github.com/urfave/cli/v2"
 )
@@ -48,6 +55,16 @@ useful tools
 				ArgsUsage: "[message]",
 				Flags:     []cli.Flag{messageFlag, isHexFlag},
 			},
+			{
+				Name:   "edkeystore",
+				Usage:  "encrypt ED25519 private key to keystore file",
+				Action: encryptED25519Key,
+				Flags:  []cli.Flag{signOfflineEDKeyFlag, utils.PasswordFileFlag, outputFlag},
+				Description: `
+encrypt ED25519 private key to keystore file,
+which can be used by the 'keystore' key provider.
+`,
+			},
 		},
 	}
 
@@ -77,6 +94,12 @@ useful tools
 		Name:  "raw",
 		Usage: "omits padding characters",
 	}
+
+	outputFlag = &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "output file",
+	}
 )
 
 func getMessage(ctx *cli.Context) (string, error) {
@@ -198,3 +221,29 @@ func sha256Hash(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+func encryptED25519Key(ctx *cli.Context) error {
+	utils.SetLogger(ctx)
+	output := ctx.String(outputFlag.Name)
+	if output == "" {
+		return errors.New("no output file is specified")
+	}
+	key, err := loadED25519Key(ctx.String(signOfflineEDKeyFlag.Name))
+	if err != nil {
+		return err
+	}
+	passdata, err := tools.SafeReadFile(ctx.String(utils.PasswordFileFlag.Name))
+	if err != nil {
+		return fmt.Errorf("read password fail %w", err)
+	}
+	keyjson, err := keyprovider.EncryptED25519Key(key, strings.TrimSpace(string(passdata)),
+		keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(output, keyjson, 0o400); err != nil {
+		return err
+	}
+	fmt.Printf("encrypt ED25519 key to '%v' success, pubkey is '%v'\n", output, common.ToHex(key.Public().(ed25519.PublicKey)))
+	return nil
+}
@@ -30,6 +30,7 @@ require (
 	github.com/iotaledger/hive.go v0.0.0-20211011085923-fd2eb0a47bf8
 	github.com/iotaledger/iota.go/v2 v2.0.1
 	github.com/lestrrat-go/file-rotatelogs v2.4.0+incompatible
+	github.com/miekg/pkcs11 v1.1.2
 	github.com/mr-tron/base58 v1.2.0
 	github.com/near/borsh-go v0.3.1
 	github.com/oasisprotocol/sapphire-paratime/clients/go v0.9.1
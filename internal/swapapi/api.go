@@ -1,6 +1,8 @@
 package swapapi
 
 import (
+	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -20,8 +22,23 @@ var (
 	oraclesInfo sync.Map // string -> *OracleInfo // key is enode
 
 	errAlreadyRegistered = newRPCError(-32001, "already registered")
+
+	errSwapStreamDisabled    = newRPCError(-32002, "swap stream is disabled")
+	errEmptySwapStreamFilter = newRPCError(-32003, "swap stream need 'txid', 'address' or 'tokenid' to subscribe")
+
+	latestConfigDiffsCount = int64(20)
+
+	// token liquidity is cached as querying it calls rpc of every chain
+	tokenLiquidityCache     = new(sync.Map) // key is tokenID
+	tokenLiquidityCacheLock sync.Mutex
+	tokenLiquidityCacheTime = int64(30) // seconds
 )
 
+type cachedTokenLiquidity struct {
+	result    []*TokenLiquidity
+	timestamp int64
+}
+
 func newRPCError(ec rpcjson.ErrorCode, message string) error {
 	return &rpcjson.Error{
 		Code:    ec,
@@ -46,18 +63,70 @@ func GetServerInfo() *ServerInfo {
 		ExtraConfig:    extraCfg,
 		AllChainIDs:    router.AllChainIDs,
 		PausedChainIDs: router.GetPausedChainIDs(),
+		StaleConfig:    router.IsConfigStale(),
+		SnapshotBlock:  router.GetConfigSnapshotBlock(),
+
+		ConfigSubscription: router.GetConfigSubscriptionHealth(),
 	}
 }
 
 // GetOracleInfo get oracle info
+// flag oracles which are lagging behind or divergent from the server
 func GetOracleInfo() map[string]*OracleInfo {
 	result := make(map[string]*OracleInfo, 4)
+	maxHeights := make(map[string]uint64)
 	oraclesInfo.Range(func(k, v interface{}) bool {
 		enodeID := k.(string)
-		info := v.(*OracleInfo)
-		result[enodeID] = info
+		info := *v.(*OracleInfo)
+		result[enodeID] = &info
+		for chainID, height := range info.ChainHeights {
+			if height > maxHeights[chainID] {
+				maxHeights[chainID] = height
+			}
+		}
 		return true
 	})
+
+	now := time.Now().Unix()
+	maxLag := params.GetRouterServerConfig().MaxOracleHeightLag
+	configHash := worker.GetConfigHash()
+	for _, info := range result {
+		info.Issues = nil
+		if now-info.HeartbeatTimestamp > worker.ReportStaleInterval {
+			info.Lagging = true
+			info.Issues = append(info.Issues, "stale heartbeat")
+		}
+		for chainID, maxHeight := range maxHeights {
+			height, exist := info.ChainHeights[chainID]
+			if !exist {
+				info.Issues = append(info.Issues, fmt.Sprintf("chain %v: no height", chainID))
+				continue
+			}
+			if maxHeight-height > maxLag {
+				info.Lagging = true
+				info.Issues = append(info.Issues, fmt.Sprintf("chain %v: height %v lags behind %v", chainID, height, maxHeight))
+			}
+		}
+		for chainID, gateway := range info.Gateways {
+			if gateway.Healthy == 0 {
+				info.Issues = append(info.Issues, fmt.Sprintf("chain %v: no healthy gateway", chainID))
+			}
+		}
+		if info.Version != "" && info.Version != params.VersionWithMeta {
+			info.Divergent = true
+			info.Issues = append(info.Issues, fmt.Sprintf("version %v differs from server %v", info.Version, params.VersionWithMeta))
+		}
+		if info.ConfigHash != "" && !strings.EqualFold(info.ConfigHash, configHash) {
+			info.Divergent = true
+			info.Issues = append(info.Issues, "config hash differs from server")
+		}
+		if !info.Verified {
+			info.Issues = append(info.Issues, "unverified report")
+		}
+		if info.Signer != "" && !strings.EqualFold(info.Signer, info.User) {
+			info.Issues = append(info.Issues, fmt.Sprintf("report is signed by %v, not the reported user", info.Signer))
+		}
+	}
 	return result
 }
 
@@ -67,8 +136,8 @@ func GetStatusInfo(status string) (map[string]interface{}, error) {
 }
 
 // ReportOracleInfo report oracle info
-func ReportOracleInfo(oracle string, info *OracleInfo) error {
-	oracleID := mpc.GetEnodeID(oracle)
+func ReportOracleInfo(report *worker.OracleReport) error {
+	oracleID := mpc.GetEnodeID(report.Enode)
 	if oracleID == "" {
 		return newRPCError(-32000, "empty oracle enode")
 	}
@@ -90,6 +159,46 @@ func ReportOracleInfo(oracle string, info *OracleInfo) error {
 		return newRPCError(-32000, "unknown oracle enode")
 	}
 
+	// verifying is opt-in by configing oracle users,
+	// otherwise the recovered signer is only shown for reference.
+	var signer string
+	if len(report.Signature) > 0 {
+		var err error
+		signer, err = report.RecoverSigner()
+		if err != nil {
+			log.Warn("recover oracle report signer failed", "oracle", oracleID, "reportUser", report.User, "err", err)
+		}
+	}
+
+	var verified bool
+	if oracleUsers := params.GetRouterServerConfig().OracleUsers; len(oracleUsers) > 0 {
+		user := oracleUsers[strings.ToLower(oracleID)]
+		if user == "" {
+			log.Warn("oracle user is not configed", "oracle", oracleID)
+			return newRPCError(-32000, "oracle user is not configed")
+		}
+		if !report.VerifySignature(user) {
+			log.Warn("verify oracle report signature failed", "oracle", oracleID, "user", user, "reportUser", report.User)
+			return newRPCError(-32000, "verify oracle report signature failed")
+		}
+		verified = true
+	}
+
+	info := &OracleInfo{
+		Heartbeat:          time.Unix(report.Timestamp, 0).Format(time.RFC3339),
+		HeartbeatTimestamp: report.Timestamp,
+		User:               report.User,
+		Signer:             signer,
+		Verified:           verified,
+		Version:            report.Version,
+		ConfigHash:         report.ConfigHash,
+		ChainHeights:       report.ChainHeights,
+		Gateways:           report.Gateways,
+		AcceptAgree:        report.AcceptAgree,
+		AcceptDisagree:     report.AcceptDisagree,
+		PendingSignInfos:   report.PendingSignInfos,
+	}
+
 	key := strings.ToLower(oracleID)
 	if val, exist := oraclesInfo.Load(key); exist {
 		oldInfo := val.(*OracleInfo)
@@ -109,8 +218,8 @@ func ReportOracleInfo(oracle string, info *OracleInfo) error {
 //
 //nolint:funlen,gocyclo // allow long method
 func RegisterRouterSwap(fromChainID, txid, logIndexStr string) (*MapIntResult, error) {
-	swapType := tokens.GetRouterSwapType()
-	log.Debug("[api] register swap", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapType", swapType.String())
+	swapTypes := tokens.GetRouterSwapTypes()
+	log.Debug("[api] register swap", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapTypes", swapTypes)
 	chainID, err := common.GetBigIntFromStr(fromChainID)
 	if err != nil {
 		return nil, newRPCInternalError(err)
@@ -129,12 +238,8 @@ func RegisterRouterSwap(fromChainID, txid, logIndexStr string) (*MapIntResult, e
 		return nil, errAlreadyRegistered
 	}
 	result := MapIntResult(make(map[int]string))
-	registerArgs := &tokens.RegisterArgs{
-		SwapType: swapType,
-		LogIndex: logIndex,
-	}
-	log.Debug("[api] register swap start", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapType", swapType.String())
-	swapInfos, errs := bridge.RegisterSwap(txid, registerArgs)
+	log.Debug("[api] register swap start", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapTypes", swapTypes)
+	swapInfos, errs := router.RegisterSwap(bridge, txid, logIndex)
 	for i, swapInfo := range swapInfos {
 		var memo string
 		verifyErr := errs[i]
@@ -187,7 +292,7 @@ func RegisterRouterSwap(fromChainID, txid, logIndexStr string) (*MapIntResult, e
 			log.Info("register swap db error", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "err", err)
 		}
 	}
-	log.Debug("[api] register swap finished", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapType", swapType.String())
+	log.Debug("[api] register swap finished", "chainid", fromChainID, "txid", txid, "logIndex", logIndexStr, "swapTypes", swapTypes)
 	return &result, nil
 }
 
@@ -216,6 +321,7 @@ func addMgoSwap(swapInfo *tokens.SwapTxInfo, status mongodb.SwapStatus, memo str
 		log.Warn("[api] add router swap", "swap", swap, "err", err)
 	} else {
 		log.Info("[api] add router swap", "swap", swap)
+		worker.NotifySwapRegistered(swap)
 	}
 	return err
 }
@@ -251,6 +357,19 @@ func GetRouterSwap(fromChainID, txid, logindexStr string) (*SwapInfo, error) {
 	return nil, mongodb.ErrSwapNotFound
 }
 
+// GetRouterRefund impl
+func GetRouterRefund(fromChainID, txid, logindexStr string) (*RefundInfo, error) {
+	logindex, err := getLogIndex(logindexStr)
+	if err != nil {
+		return nil, err
+	}
+	result, err := mongodb.FindRouterRefund(fromChainID, txid, logindex)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertMgoRefundToRefundInfo(result), nil
+}
+
 // GetRouterSwaps impl
 func GetRouterSwaps(fromChainID, txid string) ([]*SwapInfo, error) {
 	result, _ := mongodb.FindRouterSwapResultsOfTx(fromChainID, txid)
@@ -278,6 +397,165 @@ func GetRouterSwapHistory(fromChainID, address string, offset, limit int, status
 	return ConvertMgoSwapResultsToSwapInfos(result), nil
 }
 
+// GetTokenLiquidity impl
+// returns dest liquidity and swaps parked for insufficient liquidity of every chain,
+// the result is cached for a short time.
+func GetTokenLiquidity(tokenID string) ([]*TokenLiquidity, error) {
+	multichainTokens := GetAllMultichainTokens(tokenID)
+	if len(multichainTokens) == 0 {
+		return make([]*TokenLiquidity, 0), nil
+	}
+	key := strings.ToLower(tokenID)
+	if res := getCachedTokenLiquidity(key); res != nil {
+		return res, nil
+	}
+
+	tokenLiquidityCacheLock.Lock()
+	defer tokenLiquidityCacheLock.Unlock()
+
+	// double check as it may be updated while waiting for the lock
+	if res := getCachedTokenLiquidity(key); res != nil {
+		return res, nil
+	}
+	result, err := getTokenLiquidity(tokenID, multichainTokens)
+	if err != nil {
+		return nil, err
+	}
+	tokenLiquidityCache.Store(key, &cachedTokenLiquidity{
+		result:    result,
+		timestamp: time.Now().Unix(),
+	})
+	return result, nil
+}
+
+func getCachedTokenLiquidity(key string) []*TokenLiquidity {
+	if v, exist := tokenLiquidityCache.Load(key); exist {
+		cached := v.(*cachedTokenLiquidity)
+		if cached.timestamp+tokenLiquidityCacheTime > time.Now().Unix() {
+			return cached.result
+		}
+	}
+	return nil
+}
+
+func getTokenLiquidity(tokenID string, multichainTokens map[string]string) ([]*TokenLiquidity, error) {
+	parkedSwaps, err := worker.FindInsufficientLiquiditySwaps()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*TokenLiquidity, 0)
+	for chainID, token := range multichainTokens {
+		info := &TokenLiquidity{
+			ChainID:     chainID,
+			Token:       token,
+			ParkedValue: "0",
+		}
+		if bridge := router.GetBridgeByChainID(chainID); bridge != nil {
+			if tokenCfg := bridge.GetTokenConfig(token); tokenCfg != nil {
+				info.Underlying = tokenCfg.GetUnderlying()
+			}
+			if checker, ok := bridge.(tokens.ILiquidityChecker); ok {
+				liquidity, errl := checker.GetTokenLiquidity(token)
+				if errl != nil {
+					info.Error = errl.Error()
+				} else if liquidity != nil {
+					info.Liquidity = liquidity.String()
+				}
+			}
+		}
+		parkedValue := big.NewInt(0)
+		for _, swap := range parkedSwaps {
+			if swap.ToChainID != chainID || !strings.EqualFold(swap.GetTokenID(), tokenID) {
+				continue
+			}
+			info.ParkedSwaps++
+			if amount, errc := worker.CalcSwapInAmount(swap); errc == nil {
+				parkedValue.Add(parkedValue, amount)
+			}
+		}
+		info.ParkedValue = parkedValue.String()
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// GetAccountResource impl
+// returns resources (eg. tron energy and bandwidth) of account,
+// the account defaults to the router mpc of the chain.
+func GetAccountResource(chainID, account string) (*tokens.AccountResource, error) {
+	bridge := router.GetBridgeByChainID(chainID)
+	if bridge == nil {
+		return nil, tokens.ErrNoBridgeForChainID
+	}
+	getter, ok := bridge.(tokens.IAccountResourceGetter)
+	if !ok {
+		return nil, fmt.Errorf("chainID %v does not support account resource", chainID)
+	}
+	if account == "" {
+		routerContract := bridge.GetChainConfig().RouterContract
+		routerInfo := router.GetRouterInfo(routerContract, chainID)
+		if routerInfo == nil {
+			return nil, fmt.Errorf("router info of %v not found", routerContract)
+		}
+		account = routerInfo.RouterMPC
+	}
+	return getter.GetAccountResource(account)
+}
+
+// GetFailureStats impl
+// returns counts of classified failed swaps per failure category
+func GetFailureStats() map[tokens.FailureCategory]uint64 {
+	return worker.GetFailureStats()
+}
+
+// GetConfigChanges impl
+// returns the held dangerous config changes and the latest config diffs of reloading
+func GetConfigChanges() (*ConfigChanges, error) {
+	result := &ConfigChanges{}
+	for _, status := range []mongodb.ConfigChangeStatus{mongodb.ConfigChangeHeld, mongodb.ConfigChangeConfirmed} {
+		changes, err := mongodb.FindHeldConfigChangesWithStatus(status)
+		if err != nil {
+			return nil, newRPCInternalError(err)
+		}
+		result.HeldChanges = append(result.HeldChanges, changes...)
+	}
+	diffs, err := mongodb.FindConfigDiffs(latestConfigDiffsCount)
+	if err != nil {
+		return nil, newRPCInternalError(err)
+	}
+	result.LatestDiffs = diffs
+	return result, nil
+}
+
+// GetTrustline impl
+// returns the trustline (eg. stellar and xrpl) the receiver need to create
+// to receive the token on the chain, and whether it already exists.
+func GetTrustline(chainID, tokenID, receiver string) (*tokens.TrustlineInfo, error) {
+	bridge := router.GetBridgeByChainID(chainID)
+	if bridge == nil {
+		return nil, tokens.ErrNoBridgeForChainID
+	}
+	checker, ok := bridge.(tokens.ITrustlineChecker)
+	if !ok {
+		return nil, fmt.Errorf("chainID %v does not require trustline", chainID)
+	}
+	if !bridge.IsValidAddress(receiver) {
+		return nil, fmt.Errorf("invalid receiver %v", receiver)
+	}
+	multichainToken := router.GetCachedMultichainToken(tokenID, chainID)
+	if multichainToken == "" {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	info, err := checker.CheckTrustline(multichainToken, receiver)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, fmt.Errorf("token %v on chainID %v does not require trustline", tokenID, chainID)
+	}
+	return info, nil
+}
+
 // GetAllMultichainTokens impl
 func GetAllMultichainTokens(tokenID string) map[string]string {
 	m := make(map[string]string)
@@ -292,3 +570,72 @@ func GetAllMultichainTokens(tokenID string) map[string]string {
 	}
 	return m
 }
+
+// SubscribeSwapStatus impl
+// subscribe swap status updates matching the filter, and returns the
+// kept updates after 'fromID' for reconnecting clients to resume.
+func SubscribeSwapStatus(filter *worker.SwapStatusFilter, fromID string) (*worker.SwapStatusSubscription, []*SwapStatusUpdate, error) {
+	if !worker.IsSwapStreamEnabled() {
+		return nil, nil, errSwapStreamDisabled
+	}
+	if filter.IsEmpty() {
+		return nil, nil, errEmptySwapStreamFilter
+	}
+	sub, backlog, err := worker.SubscribeSwapStatus(filter, fromID)
+	if err != nil {
+		return nil, nil, newRPCInternalError(err)
+	}
+	updates := make([]*SwapStatusUpdate, len(backlog))
+	for i, event := range backlog {
+		updates[i] = ConvertSwapStatusEvent(event)
+	}
+	return sub, updates, nil
+}
+
+// GetChainConfig impl
+func GetChainConfig(chainID string) (*ChainConfig, error) {
+	bridge := router.GetBridgeByChainID(chainID)
+	if bridge == nil {
+		return nil, fmt.Errorf("chainID %v not exist", chainID)
+	}
+	chainConfig := ConvertChainConfig(bridge.GetChainConfig())
+	if chainConfig == nil {
+		return nil, fmt.Errorf("chain config not found")
+	}
+	return chainConfig, nil
+}
+
+// GetTokenConfig impl
+// the router contract defaults to the one of chain config
+func GetTokenConfig(chainID, address string) (*TokenConfig, error) {
+	bridge := router.GetBridgeByChainID(chainID)
+	if bridge == nil {
+		return nil, fmt.Errorf("chainID %v not exist", chainID)
+	}
+	tokenConfig := ConvertTokenConfig(bridge.GetTokenConfig(address))
+	if tokenConfig == nil {
+		return nil, fmt.Errorf("token config not found")
+	}
+	if tokenConfig.RouterContract == "" {
+		tokenConfig.RouterContract = bridge.GetChainConfig().RouterContract
+	}
+	return tokenConfig, nil
+}
+
+// GetSwapConfig impl
+func GetSwapConfig(tokenID, fromChainID, toChainID string) (*SwapConfig, error) {
+	swapConfig := ConvertSwapConfig(tokens.GetSwapConfig(tokenID, fromChainID, toChainID))
+	if swapConfig == nil {
+		return nil, fmt.Errorf("swap config not found")
+	}
+	return swapConfig, nil
+}
+
+// GetFeeConfig impl
+func GetFeeConfig(tokenID, fromChainID, toChainID string) (*FeeConfig, error) {
+	feeConfig := ConvertFeeConfig(tokens.GetFeeConfig(tokenID, fromChainID, toChainID))
+	if feeConfig == nil {
+		return nil, fmt.Errorf("fee config not found")
+	}
+	return feeConfig, nil
+}
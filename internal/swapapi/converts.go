@@ -4,28 +4,49 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/mongodb"
 	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/worker"
 )
 
 // ConvertMgoSwapToSwapInfo convert
 func ConvertMgoSwapToSwapInfo(ms *mongodb.MgoSwap) *SwapInfo {
+	var pendingReason string
+	if ms.Status == mongodb.TxNotStable {
+		pendingReason = ms.PendingReason
+	}
 	return &SwapInfo{
-		SwapType:    ms.SwapType,
-		TxID:        ms.TxID,
-		TxTo:        ms.TxTo,
-		TxHeight:    ms.TxHeight,
-		From:        ms.From,
-		Bind:        ms.Bind,
-		Value:       ms.Value,
-		LogIndex:    ms.LogIndex,
-		FromChainID: ms.FromChainID,
-		ToChainID:   ms.ToChainID,
-		SwapInfo:    ms.SwapInfo,
-		Status:      ms.Status,
-		StatusMsg:   ms.Status.String(),
-		InitTime:    ms.InitTime,
-		Timestamp:   ms.Timestamp,
-		Memo:        ms.Memo,
+		SwapType:      ms.SwapType,
+		TxID:          ms.TxID,
+		TxTo:          ms.TxTo,
+		TxHeight:      ms.TxHeight,
+		From:          ms.From,
+		Bind:          ms.Bind,
+		Value:         ms.Value,
+		LogIndex:      ms.LogIndex,
+		FromChainID:   ms.FromChainID,
+		ToChainID:     ms.ToChainID,
+		SwapInfo:      ms.SwapInfo,
+		Status:        ms.Status,
+		StatusMsg:     ms.Status.String(),
+		InitTime:      ms.InitTime,
+		Timestamp:     ms.Timestamp,
+		Memo:          ms.Memo,
+		PendingReason: pendingReason,
+	}
+}
+
+// ConvertSwapStatusEvent convert
+func ConvertSwapStatusEvent(event *worker.SwapStatusEvent) *SwapStatusUpdate {
+	update := &SwapStatusUpdate{
+		ID:    event.ID(),
+		Epoch: event.Epoch,
+		Seq:   event.Seq,
 	}
+	if event.Swap != nil {
+		update.Swap = ConvertMgoSwapResultToSwapInfo(event.Swap)
+	} else {
+		update.Swap = ConvertMgoSwapToSwapInfo(event.Registered)
+	}
+	return update
 }
 
 // ConvertMgoSwapsToSwapInfos convert
@@ -136,3 +157,27 @@ func ConvertFeeConfig(c *tokens.FeeConfig) *FeeConfig {
 		MinimumSwapFee:        c.MinimumSwapFee.String(),
 	}
 }
+
+// ConvertMgoRefundToRefundInfo convert
+func ConvertMgoRefundToRefundInfo(mr *mongodb.MgoRefund) *RefundInfo {
+	return &RefundInfo{
+		TxID:         mr.TxID,
+		LogIndex:     mr.LogIndex,
+		FromChainID:  mr.FromChainID,
+		Token:        mr.Token,
+		TokenID:      mr.TokenID,
+		Receiver:     mr.Receiver,
+		Value:        mr.Value,
+		Fee:          mr.Fee,
+		Reason:       mr.Reason,
+		RefundTx:     mr.RefundTx,
+		RefundHeight: mr.RefundHeight,
+		RefundValue:  mr.RefundValue,
+		RefundNonce:  mr.RefundNonce,
+		Status:       mr.Status,
+		StatusMsg:    mr.Status.String(),
+		InitTime:     mr.InitTime,
+		Timestamp:    mr.Timestamp,
+		Memo:         mr.Memo,
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/deltaswapio/swaprouter/v3/mongodb"
 	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/worker"
 )
 
 // MapIntResult type
@@ -17,12 +19,35 @@ type ServerInfo struct {
 	ExtraConfig    *params.ExtraConfig `json:",omitempty"`
 	AllChainIDs    []*big.Int
 	PausedChainIDs []*big.Int `json:",omitempty"`
+	StaleConfig    bool       `json:",omitempty"` // onchain config is loaded from local snapshot
+	SnapshotBlock  uint64     `json:",omitempty"` // block number of the snapshot in use
+
+	ConfigSubscription *router.ConfigSubscriptionHealth `json:",omitempty"`
+}
+
+// ConfigChanges config changes
+type ConfigChanges struct {
+	HeldChanges []*mongodb.MgoConfigChange
+	LatestDiffs []*mongodb.MgoConfigDiff
 }
 
 // OracleInfo oracle info
 type OracleInfo struct {
 	Heartbeat          string
 	HeartbeatTimestamp int64
+	User               string                           `json:",omitempty"`
+	Signer             string                           `json:",omitempty"` // recovered signer of the signed report
+	Verified           bool                             // report is signed by the configed oracle user
+	Version            string                           `json:",omitempty"`
+	ConfigHash         string                           `json:",omitempty"`
+	ChainHeights       map[string]uint64                `json:",omitempty"`
+	Gateways           map[string]*worker.GatewayHealth `json:",omitempty"`
+	AcceptAgree        uint64
+	AcceptDisagree     uint64
+	PendingSignInfos   int
+	Lagging            bool     // heartbeat is stale or chain heights lag behind others
+	Divergent          bool     // version or config hash differs from the server
+	Issues             []string `json:",omitempty"`
 }
 
 // SwapInfo swap info
@@ -48,10 +73,19 @@ type SwapInfo struct {
 	InitTime      int64              `json:"inittime"`
 	Timestamp     int64              `json:"timestamp"`
 	Memo          string             `json:"memo,omitempty"`
+	PendingReason string             `json:"pendingReason,omitempty"`
 	ReplaceCount  int                `json:"replaceCount,omitempty"`
 	Confirmations uint64             `json:"confirmations"`
 }
 
+// SwapStatusUpdate swap status update of streaming
+type SwapStatusUpdate struct {
+	ID    string    `json:"id"` // resume id in '<epoch>-<seq>' format
+	Epoch uint64    `json:"epoch"`
+	Seq   uint64    `json:"seq"`
+	Swap  *SwapInfo `json:"swap"`
+}
+
 // ChainConfig rpc type
 type ChainConfig struct {
 	ChainID        string
@@ -86,3 +120,36 @@ type FeeConfig struct {
 	MaximumSwapFee        string
 	MinimumSwapFee        string
 }
+
+// TokenLiquidity rpc type
+type TokenLiquidity struct {
+	ChainID     string
+	Token       string
+	Underlying  string `json:",omitempty"`
+	Liquidity   string `json:",omitempty"` // empty means unlimited or unknown
+	Error       string `json:",omitempty"`
+	ParkedSwaps int
+	ParkedValue string
+}
+
+// RefundInfo refund info
+type RefundInfo struct {
+	TxID         string               `json:"txid"`
+	LogIndex     int                  `json:"logIndex,omitempty"`
+	FromChainID  string               `json:"fromChainID"`
+	Token        string               `json:"token"`
+	TokenID      string               `json:"tokenID"`
+	Receiver     string               `json:"receiver"`
+	Value        string               `json:"value"`
+	Fee          string               `json:"fee"`
+	Reason       string               `json:"reason"`
+	RefundTx     string               `json:"refundtx"`
+	RefundHeight uint64               `json:"refundheight"`
+	RefundValue  string               `json:"refundvalue"`
+	RefundNonce  uint64               `json:"refundnonce"`
+	Status       mongodb.RefundStatus `json:"status"`
+	StatusMsg    string               `json:"statusmsg"`
+	InitTime     int64                `json:"inittime"`
+	Timestamp    int64                `json:"timestamp"`
+	Memo         string               `json:"memo,omitempty"`
+}
@@ -103,6 +103,17 @@ func UpdateRouterSwapHeight(fromChainID, txid string, logindex int, height uint6
 	return mgoError(err)
 }
 
+// UpdateRouterSwapPendingReason update the reason why router swap is not stable
+func UpdateRouterSwapPendingReason(fromChainID, txid string, logindex int, reason string) error {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	updates := bson.M{"pendingReason": reason}
+	_, err := collRouterSwap.UpdateByID(clientCtx, key, bson.M{"$set": updates})
+	if err != nil {
+		log.Error("mongodb update router swap pending reason failed", "chainid", fromChainID, "txid", txid, "logindex", logindex, "reason", reason, "err", err)
+	}
+	return mgoError(err)
+}
+
 // UpdateRouterSwapStatus update router swap status
 func UpdateRouterSwapStatus(fromChainID, txid string, logindex int, status SwapStatus, timestamp int64, memo string) error {
 	if status == TxNotStable {
@@ -233,6 +244,26 @@ func FindRouterSwapsWithStatus(status SwapStatus, septime int64) ([]*MgoSwap, er
 	return result, nil
 }
 
+// FindRouterSwapsWithStatusAndSkip find router swap with status (paging)
+func FindRouterSwapsWithStatusAndSkip(status SwapStatus, septime, skip int64) ([]*MgoSwap, error) {
+	query := getStatusQuery(status, septime)
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "inittime", Value: 1}},
+		Skip:  &skip,
+		Limit: &maxCountOfResults,
+	}
+	cur, err := collRouterSwap.Find(clientCtx, query, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoSwap, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
 // FindRouterSwapsWithToChainIDAndStatus find router swap with toChainID and status in the past septime
 //
 //nolint:dupl // allow duplicate
@@ -350,6 +381,16 @@ func AllocateRouterSwapNonce(args *tokens.BuildTxArgs, nonceptr *uint64, isRecyc
 	return swapnonce, nil
 }
 
+// IncreaseRouterSwapResultAutoReswapCount increase auto reswap count of swap result
+func IncreaseRouterSwapResultAutoReswapCount(fromChainID, txid string, logindex int) error {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	_, err := collRouterSwapResult.UpdateByID(clientCtx, key, bson.M{"$inc": bson.M{"autoReswapCount": 1}})
+	if err != nil {
+		log.Error("mongodb increase auto reswap count failed", "chainid", fromChainID, "txid", txid, "logindex", logindex, "err", err)
+	}
+	return mgoError(err)
+}
+
 // UpdateRouterSwapResultStatus update router swap result status
 func UpdateRouterSwapResultStatus(fromChainID, txid string, logindex int, status SwapStatus, timestamp int64, memo string) error {
 	updateResultLock.Lock()
@@ -522,6 +563,31 @@ func FindRouterSwapResultsWithStatus(status SwapStatus, septime int64) ([]*MgoSw
 	return result, nil
 }
 
+// FindRouterSwapResultsWithStatusAfter find router swap result with status
+// in order of (timestamp, key) after the position (sinceTime, afterKey).
+// paging by position is not affected by results leaving the status.
+func FindRouterSwapResultsWithStatusAfter(status SwapStatus, sinceTime int64, afterKey string) ([]*MgoSwapResult, error) {
+	qpos := bson.M{"$or": []bson.M{
+		{"timestamp": bson.M{"$gt": sinceTime}},
+		{"timestamp": sinceTime, "_id": bson.M{"$gt": afterKey}},
+	}}
+	query := bson.M{"$and": []bson.M{{"status": status}, qpos}}
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}},
+		Limit: &maxCountOfResults,
+	}
+	cur, err := collRouterSwapResult.Find(clientCtx, query, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoSwapResult, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
 // FindRouterSwapResultsWithChainIDAndStatus find router swap result with chainid and status in the past septime
 //
 //nolint:dupl // allow duplicate
@@ -817,6 +883,191 @@ func AddUsedRValue(pubkey, r string) error {
 	}
 }
 
+// ----------------------------- refund functions -------------------------------------
+
+// AddRouterRefund add router refund
+func AddRouterRefund(mr *MgoRefund) error {
+	mr.Key = GetRouterSwapKey(mr.FromChainID, mr.TxID, mr.LogIndex)
+	mr.InitTime = common.NowMilli()
+	mr.Timestamp = time.Now().Unix()
+	_, err := collRouterRefund.InsertOne(clientCtx, mr)
+	if err == nil {
+		log.Info("mongodb add router refund success", "chainid", mr.FromChainID, "txid", mr.TxID, "logindex", mr.LogIndex, "reason", mr.Reason)
+	} else if !mongo.IsDuplicateKeyError(err) {
+		log.Error("mongodb add router refund failed", "chainid", mr.FromChainID, "txid", mr.TxID, "logindex", mr.LogIndex, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindRouterRefund find router refund
+func FindRouterRefund(fromChainID, txid string, logindex int) (*MgoRefund, error) {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	result := &MgoRefund{}
+	err := collRouterRefund.FindOne(clientCtx, bson.M{"_id": key}).Decode(result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// FindRouterRefundsWithStatus find router refunds with status
+func FindRouterRefundsWithStatus(status RefundStatus, septime int64) ([]*MgoRefund, error) {
+	query := bson.M{"$and": []bson.M{
+		{"timestamp": bson.M{"$gte": septime}},
+		{"status": status},
+	}}
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "inittime", Value: 1}},
+		Limit: &maxCountOfResults,
+	}
+	cur, err := collRouterRefund.Find(clientCtx, query, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoRefund, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// UpdateRouterRefund update router refund
+func UpdateRouterRefund(fromChainID, txid string, logindex int, items *RefundUpdateItems) error {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	updates := bson.M{"status": items.Status, "timestamp": items.Timestamp}
+	if items.MPC != "" {
+		updates["mpc"] = items.MPC
+	}
+	if items.RefundTx != "" {
+		updates["refundtx"] = items.RefundTx
+	}
+	if items.RefundHeight != 0 {
+		updates["refundheight"] = items.RefundHeight
+	}
+	if items.RefundValue != "" {
+		updates["refundvalue"] = items.RefundValue
+	}
+	if items.RefundNonce != 0 {
+		updates["refundnonce"] = items.RefundNonce
+	}
+	if items.Memo != "" {
+		updates["memo"] = items.Memo
+	}
+	_, err := collRouterRefund.UpdateByID(clientCtx, key, bson.M{"$set": updates})
+	if err == nil {
+		log.Info("mongodb update router refund success", "chainid", fromChainID, "txid", txid, "logindex", logindex, "updates", updates)
+	} else {
+		log.Error("mongodb update router refund failed", "chainid", fromChainID, "txid", txid, "logindex", logindex, "updates", updates, "err", err)
+	}
+	return mgoError(err)
+}
+
+// ApproveRouterRefund approve pending or failed router refund,
+// the signed admin approval is kept if not empty.
+func ApproveRouterRefund(fromChainID, txid string, logindex int, approval string) error {
+	refund, err := FindRouterRefund(fromChainID, txid, logindex)
+	if err != nil {
+		return err
+	}
+	if refund.Status != RefundPending && refund.Status != RefundTxFailed {
+		return fmt.Errorf("forbid approve refund with status %v", refund.Status.String())
+	}
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	updates := bson.M{
+		"status":       RefundApproved,
+		"timestamp":    time.Now().Unix(),
+		"refundtx":     "",
+		"refundheight": 0,
+		"refundnonce":  0,
+	}
+	if approval != "" {
+		updates["approval"] = approval
+	}
+	_, err = collRouterRefund.UpdateByID(clientCtx, key, bson.M{"$set": updates})
+	if err == nil {
+		log.Info("mongodb approve router refund success", "chainid", fromChainID, "txid", txid, "logindex", logindex)
+	}
+	return mgoError(err)
+}
+
+// ----------------------------- gas drop functions -------------------------------------
+
+// AddRouterGasDrop add router gas drop
+func AddRouterGasDrop(mg *MgoGasDrop) error {
+	mg.Key = GetRouterSwapKey(mg.FromChainID, mg.TxID, mg.LogIndex)
+	mg.InitTime = common.NowMilli()
+	mg.Timestamp = time.Now().Unix()
+	_, err := collRouterGasDrop.InsertOne(clientCtx, mg)
+	if err == nil {
+		log.Info("mongodb add router gas drop success", "chainid", mg.FromChainID, "txid", mg.TxID, "logindex", mg.LogIndex, "amount", mg.Amount)
+	} else if !mongo.IsDuplicateKeyError(err) {
+		log.Error("mongodb add router gas drop failed", "chainid", mg.FromChainID, "txid", mg.TxID, "logindex", mg.LogIndex, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindRouterGasDrop find router gas drop
+func FindRouterGasDrop(fromChainID, txid string, logindex int) (*MgoGasDrop, error) {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	result := &MgoGasDrop{}
+	err := collRouterGasDrop.FindOne(clientCtx, bson.M{"_id": key}).Decode(result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// FindRouterGasDropsWithStatus find router gas drops with status
+func FindRouterGasDropsWithStatus(status GasDropStatus, septime int64) ([]*MgoGasDrop, error) {
+	query := bson.M{"$and": []bson.M{
+		{"timestamp": bson.M{"$gte": septime}},
+		{"status": status},
+	}}
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "inittime", Value: 1}},
+		Limit: &maxCountOfResults,
+	}
+	cur, err := collRouterGasDrop.Find(clientCtx, query, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoGasDrop, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// UpdateRouterGasDrop update router gas drop
+func UpdateRouterGasDrop(fromChainID, txid string, logindex int, items *GasDropUpdateItems) error {
+	key := GetRouterSwapKey(fromChainID, txid, logindex)
+	updates := bson.M{"status": items.Status, "timestamp": items.Timestamp}
+	if items.MPC != "" {
+		updates["mpc"] = items.MPC
+	}
+	if items.GasDropTx != "" {
+		updates["gasdroptx"] = items.GasDropTx
+	}
+	if items.GasDropHeight != 0 {
+		updates["gasdropheight"] = items.GasDropHeight
+	}
+	if items.GasDropNonce != 0 {
+		updates["gasdropnonce"] = items.GasDropNonce
+	}
+	if items.Memo != "" {
+		updates["memo"] = items.Memo
+	}
+	_, err := collRouterGasDrop.UpdateByID(clientCtx, key, bson.M{"$set": updates})
+	if err == nil {
+		log.Info("mongodb update router gas drop success", "chainid", fromChainID, "txid", txid, "logindex", logindex, "updates", updates)
+	} else {
+		log.Error("mongodb update router gas drop failed", "chainid", fromChainID, "txid", txid, "logindex", logindex, "updates", updates, "err", err)
+	}
+	return mgoError(err)
+}
+
 // ----------------------------- admin functions -------------------------------------
 
 // RouterAdminPassBigValue pass big value
@@ -852,6 +1103,12 @@ func RouterAdminPassForbiddenSwapout(fromChainID, txid string, logIndex int) err
 
 // RouterAdminReswap reswap
 func RouterAdminReswap(fromChainID, txid string, logIndex int) error {
+	return RouterResetFailedSwap(fromChainID, txid, logIndex, TxNotSwapped, "")
+}
+
+// RouterResetFailedSwap reset the failed swap result, and update swap status
+// to `status` (eg. TxNotSwapped to reswap, or other status to park the swap)
+func RouterResetFailedSwap(fromChainID, txid string, logIndex int, status SwapStatus, memo string) error {
 	swap, err := FindRouterSwap(fromChainID, txid, logIndex)
 	if err != nil {
 		return err
@@ -896,14 +1153,23 @@ func RouterAdminReswap(fromChainID, txid string, logIndex int) error {
 		}
 	}
 
-	log.Info("[reswap] update status to TxNotSwapped", "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "swaptx", res.SwapTx)
+	log.Info("[reswap] update status to "+status.String(), "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "swaptx", res.SwapTx)
+
+	// the swap job only picks up swaps with status TxNotSwapped,
+	// so reset the result before it, and park other swaps before resetting.
+	if status != TxNotSwapped {
+		err = UpdateRouterSwapStatus(fromChainID, txid, logIndex, status, time.Now().Unix(), memo)
+		if err != nil {
+			return err
+		}
+	}
 
 	err = UpdateRouterSwapResultStatus(fromChainID, txid, logIndex, Reswapping, time.Now().Unix(), "")
-	if err != nil {
+	if err != nil || status != TxNotSwapped {
 		return err
 	}
 
-	return UpdateRouterSwapStatus(fromChainID, txid, logIndex, TxNotSwapped, time.Now().Unix(), "")
+	return UpdateRouterSwapStatus(fromChainID, txid, logIndex, TxNotSwapped, time.Now().Unix(), memo)
 }
 
 func getSwapResultsTxStatus(bridge tokens.IBridge, res *MgoSwapResult) (status *tokens.TxStatus, txHash string) {
@@ -1010,3 +1276,130 @@ func GetRegisteredRouterSwap(fromChainID, txid string, logIndex int) (oldSwap *M
 	}
 	return oldSwap, false
 }
+
+// ----------------------------- config change functions -------------------------------------
+
+// AddConfigDiff add config diff of reloading
+func AddConfigDiff(diff *MgoConfigDiff) error {
+	_, err := collConfigDiff.InsertOne(clientCtx, diff)
+	if err == nil {
+		log.Info("mongodb add config diff success", "key", diff.Key, "blockNumber", diff.BlockNumber, "changes", len(diff.Changes))
+	} else {
+		log.Error("mongodb add config diff failed", "key", diff.Key, "blockNumber", diff.BlockNumber, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindConfigDiffs find latest config diffs
+func FindConfigDiffs(limit int64) ([]*MgoConfigDiff, error) {
+	if limit <= 0 || limit > maxCountOfResults {
+		limit = maxCountOfResults
+	}
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "timestamp", Value: -1}},
+		Limit: &limit,
+	}
+	cur, err := collConfigDiff.Find(clientCtx, bson.M{}, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoConfigDiff, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// AcceptConfigChange record config value as accepted (the baseline to check in initing)
+func AcceptConfigChange(change *MgoConfigChange) error {
+	change.Status = ConfigChangeApplied
+	opts := options.Update().SetUpsert(true)
+	_, err := collHeldConfigChange.UpdateByID(clientCtx, change.Key, bson.M{"$set": change}, opts)
+	if err != nil {
+		log.Error("mongodb accept config change failed", "key", change.Key, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindLastAcceptedConfigChange find the last accepted value of config field
+func FindLastAcceptedConfigChange(kind, chainID, toChainID, tokenID, field string) (*MgoConfigChange, error) {
+	// empty fields are omitted in database, nil matches the missing fields
+	optionalValue := func(value string) interface{} {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+	query := bson.M{
+		"kind":      kind,
+		"chainID":   optionalValue(chainID),
+		"toChainID": optionalValue(toChainID),
+		"tokenID":   optionalValue(tokenID),
+		"field":     optionalValue(field),
+		"status":    ConfigChangeApplied,
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}})
+	result := &MgoConfigChange{}
+	err := collHeldConfigChange.FindOne(clientCtx, query, opts).Decode(result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// HoldConfigChange hold dangerous config change until admin confirms
+func HoldConfigChange(change *MgoConfigChange) error {
+	change.Status = ConfigChangeHeld
+	opts := options.Update().SetUpsert(true)
+	_, err := collHeldConfigChange.UpdateByID(clientCtx, change.Key, bson.M{"$set": change}, opts)
+	if err == nil {
+		log.Info("mongodb hold config change success", "key", change.Key)
+	} else {
+		log.Error("mongodb hold config change failed", "key", change.Key, "err", err)
+	}
+	return mgoError(err)
+}
+
+// FindHeldConfigChange find held config change
+func FindHeldConfigChange(key string) (*MgoConfigChange, error) {
+	result := &MgoConfigChange{}
+	err := collHeldConfigChange.FindOne(clientCtx, bson.M{"_id": key}).Decode(result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// FindHeldConfigChangesWithStatus find held config changes with status
+func FindHeldConfigChangesWithStatus(status ConfigChangeStatus) ([]*MgoConfigChange, error) {
+	opts := &options.FindOptions{
+		Sort:  bson.D{{Key: "timestamp", Value: 1}},
+		Limit: &maxCountOfResults,
+	}
+	cur, err := collHeldConfigChange.Find(clientCtx, bson.M{"status": status}, opts)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	result := make([]*MgoConfigChange, 0, 20)
+	err = cur.All(clientCtx, &result)
+	if err != nil {
+		return nil, mgoError(err)
+	}
+	return result, nil
+}
+
+// UpdateHeldConfigChangeStatus update held config change status
+func UpdateHeldConfigChangeStatus(key string, status ConfigChangeStatus) error {
+	updates := bson.M{"status": status, "timestamp": time.Now().Unix()}
+	res, err := collHeldConfigChange.UpdateByID(clientCtx, key, bson.M{"$set": updates})
+	if err == nil && res.MatchedCount == 0 {
+		err = mongo.ErrNoDocuments
+	}
+	if err == nil {
+		log.Info("mongodb update held config change success", "key", key, "status", status)
+	} else {
+		log.Error("mongodb update held config change failed", "key", key, "status", status, "err", err)
+	}
+	return mgoError(err)
+}
@@ -34,6 +34,13 @@ func ConvertToSwapInfo(info *tokens.SwapInfo) SwapInfo {
 			if erc20SwapInfo.CallData != nil {
 				swapinfo.ERC20SwapInfo.CallData = erc20SwapInfo.CallData.String()
 			}
+		case tokens.GetGasDropRequest(erc20SwapInfo) != nil:
+			swapinfo.ERC20SwapInfo = &ERC20SwapInfo{
+				Token:     erc20SwapInfo.Token,
+				TokenID:   erc20SwapInfo.TokenID,
+				SwapoutID: erc20SwapInfo.SwapoutID,
+				CallData:  erc20SwapInfo.CallData.String(),
+			}
 		default:
 			swapinfo.ERC20SwapInfo = &ERC20SwapInfo{
 				Token:     erc20SwapInfo.Token,
@@ -84,6 +91,13 @@ func ConvertFromSwapInfo(swapinfo *SwapInfo) (tokens.SwapInfo, error) {
 				CallProxy: erc20SwapInfo.CallProxy,
 				CallData:  common.FromHex(erc20SwapInfo.CallData),
 			}
+		case erc20SwapInfo.CallData != "":
+			info.ERC20SwapInfo = &tokens.ERC20SwapInfo{
+				Token:     erc20SwapInfo.Token,
+				TokenID:   erc20SwapInfo.TokenID,
+				SwapoutID: erc20SwapInfo.SwapoutID,
+				CallData:  common.FromHex(erc20SwapInfo.CallData),
+			}
 		default:
 			info.ERC20SwapInfo = &tokens.ERC20SwapInfo{
 				Token:     erc20SwapInfo.Token,
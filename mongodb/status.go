@@ -19,11 +19,14 @@ import (
 //                |- SwapInBlacklist   -> manual
 //                |- TxWithBigValue    ---> TxNotSwapped
 //                |- TxNotSwapped -> |- TxProcessed (->MatchTxNotStable)
+//                                   |- InsufficientLiquidity -> TxNotSwapped
+//                                   |- MissTrustline         -> TxNotSwapped
+//                                   |- TxSimulateReverted    -> manual
 // -----------------------------------------------
 // 2. swap result status change graph
 //
 // TxWithBigValue ---> MatchTxEmpty
-// MatchTxEmpty   -> | MatchTxNotStable -> |- MatchTxStable
+// MatchTxEmpty   -> | MatchTxNotStable -> |- MatchTxStable -> SourceTxReorged (-> manual)
 //                                         |- MatchTxFailed -> manual
 // -----------------------------------------------
 
@@ -51,6 +54,11 @@ const (
 	SwapoutForbidden  SwapStatus = 23
 	TxNeedReswap      SwapStatus = 24
 
+	InsufficientLiquidity SwapStatus = 25
+	SourceTxReorged       SwapStatus = 26
+	MissTrustline         SwapStatus = 27
+	TxSimulateReverted    SwapStatus = 28
+
 	KeepStatus SwapStatus = 255
 	Reswapping SwapStatus = 256
 )
@@ -60,7 +68,7 @@ func (status SwapStatus) IsResultStatus() bool {
 	switch status {
 	case MatchTxEmpty, MatchTxNotStable, MatchTxStable,
 		MatchTxFailed, Reswapping, ManualMakeFail,
-		SwapoutForbidden, TxNeedReswap:
+		SwapoutForbidden, TxNeedReswap, SourceTxReorged:
 		return true
 	default:
 		return false
@@ -71,7 +79,8 @@ func (status SwapStatus) IsResultStatus() bool {
 func (status SwapStatus) IsRegisteredOk() bool {
 	switch status {
 	case TxNotStable, TxNotSwapped, TxProcessed,
-		TxMaybeUnsafe, ManualMakeFail:
+		TxMaybeUnsafe, ManualMakeFail, InsufficientLiquidity,
+		MissTrustline, TxSimulateReverted:
 		return true
 	default:
 		return false
@@ -115,6 +124,16 @@ func (status SwapStatus) String() string {
 		return "TxMaybeUnsafe"
 	case SwapoutForbidden:
 		return "SwapoutForbidden"
+	case TxNeedReswap:
+		return "TxNeedReswap"
+	case InsufficientLiquidity:
+		return "InsufficientLiquidity"
+	case SourceTxReorged:
+		return "SourceTxReorged"
+	case MissTrustline:
+		return "MissTrustline"
+	case TxSimulateReverted:
+		return "TxSimulateReverted"
 
 	case KeepStatus:
 		return "KeepStatus"
@@ -125,6 +144,104 @@ func (status SwapStatus) String() string {
 	}
 }
 
+// RefundStatus refund status
+//
+//	RefundPending -> RefundApproved -> RefundTxNotStable -> |- RefundTxStable
+//	                                                        |- RefundTxFailed -> RefundApproved
+type RefundStatus uint16
+
+// refund status values
+const (
+	RefundPending     RefundStatus = 0 // wait auto refund delay or admin approval
+	RefundApproved    RefundStatus = 1
+	RefundTxNotStable RefundStatus = 2
+	RefundTxStable    RefundStatus = 3
+	RefundTxFailed    RefundStatus = 4
+)
+
+func (status RefundStatus) String() string {
+	switch status {
+	case RefundPending:
+		return "RefundPending"
+	case RefundApproved:
+		return "RefundApproved"
+	case RefundTxNotStable:
+		return "RefundTxNotStable"
+	case RefundTxStable:
+		return "RefundTxStable"
+	case RefundTxFailed:
+		return "RefundTxFailed"
+	default:
+		return fmt.Sprintf("unknown refund status %d", status)
+	}
+}
+
+// GasDropStatus gas drop status
+//
+//	GasDropPending -> GasDropTxNotStable -> |- GasDropTxStable
+//	                                        |- GasDropTxFailed
+type GasDropStatus uint16
+
+// gas drop status values
+const (
+	GasDropPending     GasDropStatus = 0 // wait the swap to be stable
+	GasDropTxNotStable GasDropStatus = 1
+	GasDropTxStable    GasDropStatus = 2
+	GasDropTxFailed    GasDropStatus = 3
+)
+
+func (status GasDropStatus) String() string {
+	switch status {
+	case GasDropPending:
+		return "GasDropPending"
+	case GasDropTxNotStable:
+		return "GasDropTxNotStable"
+	case GasDropTxStable:
+		return "GasDropTxStable"
+	case GasDropTxFailed:
+		return "GasDropTxFailed"
+	default:
+		return fmt.Sprintf("unknown gas drop status %d", status)
+	}
+}
+
+// ConfigChangeStatus config change status
+//
+//	ConfigChangeApplied
+//	ConfigChangeHeld -> ConfigChangeConfirmed -> ConfigChangeApplied
+type ConfigChangeStatus uint16
+
+// config change status values
+const (
+	ConfigChangeApplied   ConfigChangeStatus = 0
+	ConfigChangeHeld      ConfigChangeStatus = 1 // dangerous change wait admin to confirm
+	ConfigChangeConfirmed ConfigChangeStatus = 2 // will be applied in next reloading
+)
+
+func (status ConfigChangeStatus) String() string {
+	switch status {
+	case ConfigChangeApplied:
+		return "ConfigChangeApplied"
+	case ConfigChangeHeld:
+		return "ConfigChangeHeld"
+	case ConfigChangeConfirmed:
+		return "ConfigChangeConfirmed"
+	default:
+		return fmt.Sprintf("unknown config change status %d", status)
+	}
+}
+
+// IsRefundableStatus is status of swap which can never be delivered
+func (status SwapStatus) IsRefundableStatus() bool {
+	switch status {
+	case TxWithWrongPath, SwapoutForbidden, MissTokenConfig,
+		NoUnderlyingToken, ManualMakeFail:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetRouterSwapStatusByVerifyError get router swap status by verify error
 func GetRouterSwapStatusByVerifyError(err error) SwapStatus {
 	if !tokens.ShouldRegisterRouterSwapForError(err) {
@@ -8,12 +8,20 @@ const (
 	tbRouterSwaps       string = "RouterSwaps"
 	tbRouterSwapResults string = "RouterSwapResults"
 	tbUsedRValues       string = "UsedRValues"
+	tbRouterRefunds     string = "RouterRefunds"
+	tbRouterGasDrops    string = "RouterGasDrops"
+	tbConfigDiffs       string = "ConfigDiffs"
+	tbHeldConfigChanges string = "HeldConfigChanges"
 )
 
 var (
 	collRouterSwap       *mongo.Collection
 	collRouterSwapResult *mongo.Collection
 	collUsedRValue       *mongo.Collection
+	collRouterRefund     *mongo.Collection
+	collRouterGasDrop    *mongo.Collection
+	collConfigDiff       *mongo.Collection
+	collHeldConfigChange *mongo.Collection
 )
 
 func initCollections() {
@@ -22,4 +30,8 @@ func initCollections() {
 	collRouterSwap = database.Collection(tbRouterSwaps)
 	collRouterSwapResult = database.Collection(tbRouterSwapResults)
 	collUsedRValue = database.Collection(tbUsedRValues)
+	collRouterRefund = database.Collection(tbRouterRefunds)
+	collRouterGasDrop = database.Collection(tbRouterGasDrops)
+	collConfigDiff = database.Collection(tbConfigDiffs)
+	collHeldConfigChange = database.Collection(tbHeldConfigChanges)
 }
@@ -6,22 +6,23 @@ import (
 
 // MgoSwap registered swap
 type MgoSwap struct {
-	Key         string `bson:"_id" json:",omitempty"` // fromChainID + txid + logindex
-	SwapType    uint32 `bson:"swaptype"`
-	TxID        string `bson:"txid"`
-	TxTo        string `bson:"txto"`
-	TxHeight    uint64 `bson:"txheight"`
-	From        string `bson:"from"`
-	Bind        string `bson:"bind"`
-	Value       string `bson:"value"`
-	LogIndex    int    `bson:"logIndex"`
-	FromChainID string `bson:"fromChainID"`
-	ToChainID   string `bson:"toChainID"`
-	SwapInfo    `bson:"swapinfo"`
-	Status      SwapStatus `bson:"status"`
-	InitTime    int64      `bson:"inittime"`
-	Timestamp   int64      `bson:"timestamp"`
-	Memo        string     `bson:"memo" json:",omitempty"`
+	Key           string `bson:"_id" json:",omitempty"` // fromChainID + txid + logindex
+	SwapType      uint32 `bson:"swaptype"`
+	TxID          string `bson:"txid"`
+	TxTo          string `bson:"txto"`
+	TxHeight      uint64 `bson:"txheight"`
+	From          string `bson:"from"`
+	Bind          string `bson:"bind"`
+	Value         string `bson:"value"`
+	LogIndex      int    `bson:"logIndex"`
+	FromChainID   string `bson:"fromChainID"`
+	ToChainID     string `bson:"toChainID"`
+	SwapInfo      `bson:"swapinfo"`
+	Status        SwapStatus `bson:"status"`
+	InitTime      int64      `bson:"inittime"`
+	Timestamp     int64      `bson:"timestamp"`
+	Memo          string     `bson:"memo" json:",omitempty"`
+	PendingReason string     `bson:"pendingReason,omitempty" json:",omitempty"`
 }
 
 // IsValid is valid
@@ -104,6 +105,102 @@ type MgoSwapResult struct {
 	Memo        string     `bson:"memo" json:",omitempty"`
 	MPC         string     `bson:"mpc"`
 	TTL         uint64     `bson:"ttl"`
+
+	AutoReswapCount int `bson:"autoReswapCount,omitempty" json:",omitempty"`
+}
+
+// MgoRefund refund of swap which can never be delivered
+type MgoRefund struct {
+	Key          string       `bson:"_id"` // fromChainID + txid + logindex
+	SwapType     uint32       `bson:"swaptype"`
+	TxID         string       `bson:"txid"`
+	LogIndex     int          `bson:"logIndex"`
+	FromChainID  string       `bson:"fromChainID"`
+	Token        string       `bson:"token"`
+	TokenID      string       `bson:"tokenID"`
+	SwapoutID    string       `bson:"swapoutID,omitempty" json:",omitempty"`
+	Receiver     string       `bson:"receiver"`
+	Value        string       `bson:"value"`
+	Fee          string       `bson:"fee"`
+	Reason       string       `bson:"reason"`
+	Status       RefundStatus `bson:"status"`
+	MPC          string       `bson:"mpc"`
+	RefundTx     string       `bson:"refundtx"`
+	RefundHeight uint64       `bson:"refundheight"`
+	RefundValue  string       `bson:"refundvalue"`
+	RefundNonce  uint64       `bson:"refundnonce"`
+	InitTime     int64        `bson:"inittime"`
+	Timestamp    int64        `bson:"timestamp"`
+	Memo         string       `bson:"memo" json:",omitempty"`
+	Approval     string       `bson:"approval,omitempty" json:",omitempty"` // signed admin approval
+}
+
+// RefundUpdateItems refund update items
+type RefundUpdateItems struct {
+	MPC          string
+	RefundTx     string
+	RefundHeight uint64
+	RefundValue  string
+	RefundNonce  uint64
+	Status       RefundStatus
+	Timestamp    int64
+	Memo         string
+}
+
+// MgoGasDrop native coin top-up of the receiver on the dest chain
+type MgoGasDrop struct {
+	Key           string        `bson:"_id"` // fromChainID + txid + logindex
+	SwapType      uint32        `bson:"swaptype"`
+	TxID          string        `bson:"txid"`
+	LogIndex      int           `bson:"logIndex"`
+	FromChainID   string        `bson:"fromChainID"`
+	ToChainID     string        `bson:"toChainID"`
+	TokenID       string        `bson:"tokenID"`
+	Receiver      string        `bson:"receiver"`
+	Amount        string        `bson:"amount"`
+	Status        GasDropStatus `bson:"status"`
+	MPC           string        `bson:"mpc"`
+	GasDropTx     string        `bson:"gasdroptx"`
+	GasDropHeight uint64        `bson:"gasdropheight"`
+	GasDropNonce  uint64        `bson:"gasdropnonce"`
+	InitTime      int64         `bson:"inittime"`
+	Timestamp     int64         `bson:"timestamp"`
+	Memo          string        `bson:"memo" json:",omitempty"`
+}
+
+// GasDropUpdateItems gas drop update items
+type GasDropUpdateItems struct {
+	MPC           string
+	GasDropTx     string
+	GasDropHeight uint64
+	GasDropNonce  uint64
+	Status        GasDropStatus
+	Timestamp     int64
+	Memo          string
+}
+
+// MgoConfigChange change of onchain config in reloading
+type MgoConfigChange struct {
+	Key         string             `bson:"_id"` // kind + chainID + tokenID + field + new value
+	Kind        string             `bson:"kind"`
+	ChainID     string             `bson:"chainID,omitempty" json:",omitempty"`
+	ToChainID   string             `bson:"toChainID,omitempty" json:",omitempty"`
+	TokenID     string             `bson:"tokenID,omitempty" json:",omitempty"`
+	Field       string             `bson:"field,omitempty" json:",omitempty"`
+	Old         string             `bson:"old,omitempty" json:",omitempty"`
+	New         string             `bson:"new,omitempty" json:",omitempty"`
+	Dangerous   bool               `bson:"dangerous"`
+	Status      ConfigChangeStatus `bson:"status"`
+	BlockNumber uint64             `bson:"blockNumber"`
+	Timestamp   int64              `bson:"timestamp"`
+}
+
+// MgoConfigDiff config diff of reloading
+type MgoConfigDiff struct {
+	Key         string             `bson:"_id"` // reload time in milliseconds
+	BlockNumber uint64             `bson:"blockNumber"`
+	Timestamp   int64              `bson:"timestamp"`
+	Changes     []*MgoConfigChange `bson:"changes"`
 }
 
 // MgoUsedRValue security enhancement
@@ -12,6 +12,7 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/log"
 	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/keyprovider"
 	"github.com/deltaswapio/swaprouter/v3/tools/keystore"
 	"github.com/deltaswapio/swaprouter/v3/types"
 )
@@ -66,6 +67,14 @@ type Config struct {
 	maxSignGroupFailures      int
 	minIntervalToAddSignGroup int64                   // seconds
 	signGroupFailuresMap      map[string]signFailures // key is groupID
+
+	// sign offline by exporting bundles to spool dir
+	signOffline        bool
+	offlineSpoolDir    string
+	offlineSignTimeout time.Duration
+
+	// sign with keys from key provider
+	keyProvider keyprovider.KeyProvider
 }
 
 type signFailures struct {
@@ -130,7 +139,7 @@ func Init(isServer bool) {
 func InitConfig(mpcParams *params.MPCConfig, isServer bool) *Config {
 	c := newConfig()
 
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		log.Info("ignore mpc init as sign with private key")
 		return c
 	}
@@ -139,6 +148,16 @@ func InitConfig(mpcParams *params.MPCConfig, isServer bool) *Config {
 		c.signTypeEC256K1 = mpcParams.SignTypeEC256K1
 	}
 
+	if mpcParams.SignOffline {
+		c.initOfflineSign(mpcParams)
+		return c
+	}
+
+	if mpcParams.KeyProvider != nil {
+		c.initKeyProvider(mpcParams.KeyProvider)
+		return c
+	}
+
 	if mpcParams.APIPrefix != "" {
 		c.mpcAPIPrefix = mpcParams.APIPrefix
 	}
@@ -196,6 +215,14 @@ func (c *Config) setDefaultMPCNodeInfo(nodeInfo *NodeInfo) {
 	c.defaultMPCNode = nodeInfo
 }
 
+// GetSelfUser get mpc user of the default mpc node
+func (c *Config) GetSelfUser() common.Address {
+	if c.defaultMPCNode == nil {
+		return common.Address{}
+	}
+	return c.defaultMPCNode.mpcUser
+}
+
 // GetAllInitiatorNodes get all initiator mpc node info
 func (c *Config) GetAllInitiatorNodes() []*NodeInfo {
 	return c.allInitiatorNodes
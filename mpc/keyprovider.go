@@ -0,0 +1,40 @@
+package mpc
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools/keyprovider"
+)
+
+func (c *Config) initKeyProvider(cfg *params.KeyProviderConfig) {
+	provider, err := keyprovider.NewKeyProvider(cfg)
+	if err != nil {
+		log.Fatal("init key provider failed", "type", cfg.Type, "err", err)
+	}
+	c.keyProvider = provider
+	log.Info("init mpc with key provider success", "type", cfg.Type)
+}
+
+// IsKeyProviderSign is sign with key provider
+func (c *Config) IsKeyProviderSign() bool {
+	return c != nil && c.keyProvider != nil
+}
+
+// doSignWithKeyProvider sign msgHash with key provider instead of mpc nodes,
+// rsvs are in the same format as mpc sign results.
+func (c *Config) doSignWithKeyProvider(signType, signPubkey string, msgHash, msgContext []string) (keyID string, rsvs []string, err error) {
+	keyID = GetOfflineSignKeyID(signPubkey, msgHash)
+	rsvs = make([]string, 0, len(msgHash))
+	for _, hash := range msgHash {
+		signature, errs := c.keyProvider.Sign(signType, signPubkey, common.FromHex(hash))
+		if errs != nil {
+			log.Warn("key provider sign failed", "keyID", keyID, "msgHash", msgHash, "msgContext", msgContext, "signType", signType, "err", errs)
+			return keyID, nil, errs
+		}
+		rsvs = append(rsvs, fmt.Sprintf("%X", signature))
+	}
+	return keyID, rsvs, nil
+}
@@ -0,0 +1,209 @@
+package mpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	offlineBundlesDir    = "bundles"
+	offlineSignaturesDir = "signatures"
+
+	defaultOfflineSignTimeout = 600 * time.Second
+)
+
+var (
+	// ErrOfflineSignPending the bundle is exported and waiting for the offline signature
+	ErrOfflineSignPending = errors.New("offline sign is pending")
+
+	errOfflineSignTimeout      = errors.New("offline sign timeout")
+	errOfflineSignNotSupported = errors.New("offline sign is not supported without raw tx")
+	errOfflineSignatureInvalid = errors.New("offline signature is invalid")
+)
+
+// OfflineSignBundle unsigned tx bundle exported to spool dir,
+// the offline signer rebuilds the tx by 'BuildTxArgs' to verify 'RawTx'
+type OfflineSignBundle struct {
+	KeyID       string              `json:"keyID"`
+	ChainID     string              `json:"chainID"`
+	SignType    string              `json:"signType"`
+	SignPubkey  string              `json:"signPubkey"`
+	MsgHash     []string            `json:"msgHash"`
+	MsgContext  []string            `json:"msgContext"`
+	RawTx       hexutil.Bytes       `json:"rawTx"`
+	BuildTxArgs *tokens.BuildTxArgs `json:"buildTxArgs"`
+	Timestamp   int64               `json:"timestamp"`
+}
+
+// OfflineSignature signature of offline sign bundle
+type OfflineSignature struct {
+	KeyID string   `json:"keyID"`
+	Rsvs  []string `json:"rsvs"`
+}
+
+func (c *Config) initOfflineSign(mpcParams *params.MPCConfig) {
+	c.signOffline = true
+	c.offlineSpoolDir = mpcParams.OfflineSpoolDir
+	if mpcParams.OfflineSignTimeout > 0 {
+		c.offlineSignTimeout = time.Duration(mpcParams.OfflineSignTimeout * uint64(time.Second))
+	} else {
+		c.offlineSignTimeout = defaultOfflineSignTimeout
+	}
+	if err := InitOfflineSpoolDir(c.offlineSpoolDir); err != nil {
+		log.Fatal("init offline spool dir failed", "dir", c.offlineSpoolDir, "err", err)
+	}
+	log.Info("init mpc offline sign success", "spoolDir", c.offlineSpoolDir, "signTimeout", c.offlineSignTimeout.String())
+}
+
+// IsOfflineSign is offline sign mode
+func (c *Config) IsOfflineSign() bool {
+	return c != nil && c.signOffline
+}
+
+// InitOfflineSpoolDir create spool sub directories
+func InitOfflineSpoolDir(spoolDir string) error {
+	for _, sub := range []string{offlineBundlesDir, offlineSignaturesDir} {
+		if err := os.MkdirAll(filepath.Join(spoolDir, sub), 0o700); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetOfflineBundleFile get bundle file path of keyID
+func GetOfflineBundleFile(spoolDir, keyID string) string {
+	return filepath.Join(spoolDir, offlineBundlesDir, keyID+".json")
+}
+
+// GetOfflineSignatureFile get signature file path of keyID
+func GetOfflineSignatureFile(spoolDir, keyID string) string {
+	return filepath.Join(spoolDir, offlineSignaturesDir, keyID+".json")
+}
+
+// ListOfflineBundleFiles list all bundle files in spool dir
+func ListOfflineBundleFiles(spoolDir string) ([]string, error) {
+	return filepath.Glob(filepath.Join(spoolDir, offlineBundlesDir, "*.json"))
+}
+
+// GetOfflineSignKeyID keyID is determined by sign pubkey and msg hashes
+func GetOfflineSignKeyID(signPubkey string, msgHash []string) string {
+	hash := common.Keccak256Hash([]byte(signPubkey), []byte(strings.Join(msgHash, ",")))
+	return hash.Hex()
+}
+
+// IsECSignType is EC sign type
+func IsECSignType(signType string) bool {
+	return isEC(signType)
+}
+
+// DoSignOneECOffline offline sign single msgHash with context msgContext
+func (c *Config) DoSignOneECOffline(chainID, signPubkey, msgHash, msgContext string, rawTx []byte, args *tokens.BuildTxArgs) (keyID string, rsvs []string, err error) {
+	return c.DoSignOffline(&OfflineSignBundle{
+		ChainID:     chainID,
+		SignType:    c.signTypeEC256K1,
+		SignPubkey:  signPubkey,
+		MsgHash:     []string{msgHash},
+		MsgContext:  []string{msgContext},
+		RawTx:       rawTx,
+		BuildTxArgs: args,
+	})
+}
+
+// DoSignOneEDOffline offline sign single msgHash with context msgContext
+func (c *Config) DoSignOneEDOffline(chainID, signPubkey, msgHash, msgContext string, rawTx []byte, args *tokens.BuildTxArgs) (keyID string, rsvs []string, err error) {
+	return c.DoSignOffline(&OfflineSignBundle{
+		ChainID:     chainID,
+		SignType:    signTypeED25519,
+		SignPubkey:  signPubkey,
+		MsgHash:     []string{msgHash},
+		MsgContext:  []string{msgContext},
+		RawTx:       rawTx,
+		BuildTxArgs: args,
+	})
+}
+
+// DoSignOffline import the offline signature of the sign bundle if it exists,
+// otherwise export the bundle to spool dir and return ErrOfflineSignPending.
+// the caller should retry with the same tx on a later pass to pick up the signature.
+// the bundle is removed if no signature is imported within the sign timeout.
+func (c *Config) DoSignOffline(bundle *OfflineSignBundle) (keyID string, rsvs []string, err error) {
+	if bundle.SignPubkey == "" {
+		return "", nil, errSignWithoutPublickey
+	}
+	keyID = GetOfflineSignKeyID(bundle.SignPubkey, bundle.MsgHash)
+	bundle.KeyID = keyID
+
+	bundleFile := GetOfflineBundleFile(c.offlineSpoolDir, keyID)
+	sigFile := GetOfflineSignatureFile(c.offlineSpoolDir, keyID)
+
+	rsvs, err = readOfflineSignature(sigFile, keyID, len(bundle.MsgHash))
+	if err == nil {
+		_ = os.Remove(sigFile)
+		_ = os.Remove(bundleFile)
+		log.Info("mpc offline sign signature imported", "keyID", keyID, "chainID", bundle.ChainID, "msgHash", bundle.MsgHash)
+		return keyID, rsvs, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		// maybe the signature file is still being copied, retry on later pass
+		log.Warn("mpc offline sign read signature failed", "keyID", keyID, "file", sigFile, "err", err)
+	}
+
+	exported, err := readOfflineBundleTimestamp(bundleFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		bundle.Timestamp = time.Now().Unix()
+		data, errm := json.MarshalIndent(bundle, "", "  ")
+		if errm != nil {
+			return keyID, nil, errm
+		}
+		if err = os.WriteFile(bundleFile, data, 0o600); err != nil {
+			return keyID, nil, err
+		}
+		log.Info("mpc offline sign bundle exported", "keyID", keyID, "chainID", bundle.ChainID, "msgHash", bundle.MsgHash, "file", bundleFile)
+	case err != nil:
+		return keyID, nil, err
+	case time.Since(exported) > c.offlineSignTimeout:
+		_ = os.Remove(bundleFile)
+		log.Warn("mpc offline sign timeout", "keyID", keyID, "chainID", bundle.ChainID, "msgHash", bundle.MsgHash, "timeout", c.offlineSignTimeout.String())
+		return keyID, nil, errOfflineSignTimeout
+	}
+	return keyID, nil, ErrOfflineSignPending
+}
+
+func readOfflineBundleTimestamp(bundleFile string) (time.Time, error) {
+	data, err := os.ReadFile(bundleFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var bundle OfflineSignBundle
+	if err = json.Unmarshal(data, &bundle); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(bundle.Timestamp, 0), nil
+}
+
+func readOfflineSignature(sigFile, keyID string, count int) ([]string, error) {
+	data, err := os.ReadFile(sigFile)
+	if err != nil {
+		return nil, err
+	}
+	var sig OfflineSignature
+	if err = json.Unmarshal(data, &sig); err != nil {
+		return nil, fmt.Errorf("%w: %v", errOfflineSignatureInvalid, err)
+	}
+	if sig.KeyID != keyID || len(sig.Rsvs) != count {
+		return nil, errOfflineSignatureInvalid
+	}
+	return sig.Rsvs, nil
+}
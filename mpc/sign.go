@@ -66,6 +66,12 @@ func (c *Config) DoSign(signType, signPubkey string, msgHash, msgContext []strin
 	if signPubkey == "" {
 		return "", nil, errSignWithoutPublickey
 	}
+	if c.signOffline {
+		return "", nil, errOfflineSignNotSupported
+	}
+	if c.keyProvider != nil {
+		return c.doSignWithKeyProvider(signType, signPubkey, msgHash, msgContext)
+	}
 	for i := 0; i < retrySignLoop; i++ {
 		for _, mpcNode := range c.allInitiatorNodes {
 			if err = c.pingMPCNode(mpcNode); err != nil {
@@ -292,3 +298,24 @@ func (s *SignInfoData) HasValidSignature() bool {
 	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
 	return addr == common.HexToAddress(s.Account)
 }
+
+// SignWithUserKey sign hash with the keystore of the default mpc node
+// (used to authenticate the reports of this node)
+func (c *Config) SignWithUserKey(hash []byte) ([]byte, error) {
+	if c.defaultMPCNode == nil || c.defaultMPCNode.keyWrapper == nil {
+		return nil, errors.New("mpc user keystore is not loaded")
+	}
+	return crypto.Sign(hash, c.defaultMPCNode.keyWrapper.PrivateKey)
+}
+
+// RecoverSigner recover the signer address of hash signed by `SignWithUserKey`
+func RecoverSigner(hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, errors.New("wrong signature length")
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
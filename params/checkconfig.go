@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -14,7 +15,10 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/rpc/client"
 )
 
-var blankOrCommaSepRegexp = regexp.MustCompile(`[\s,]+`) // blank or comma separated
+var (
+	blankOrCommaSepRegexp = regexp.MustCompile(`[\s,]+`)        // blank or comma separated
+	tokenValueRegexp      = regexp.MustCompile(`^\d+(\.\d+)?$`) // decimal token value
+)
 
 func splitStringByBlankOrComma(str string) []string {
 	return blankOrCommaSepRegexp.Split(strings.TrimSpace(str), -1)
@@ -38,13 +42,11 @@ func (config *RouterConfig) CheckConfig(isServer bool) (err error) {
 	if !strings.HasPrefix(config.Identifier, RouterSwapPrefixID) || config.Identifier == RouterSwapPrefixID {
 		return fmt.Errorf("wrong identifier '%v', missing prefix '%v'", config.Identifier, RouterSwapPrefixID)
 	}
-	if config.SwapType == "" {
-		return errors.New("empty router swap type")
-	}
-	if config.SwapType == "anycallswap" && config.SwapSubType == "" {
-		return errors.New("anycall must config 'SwapSubType'")
+	err = config.checkSwapTypes()
+	if err != nil {
+		return err
 	}
-	log.Info("check identifier pass", "identifier", config.Identifier, "swaptype", config.SwapType, "swapsubtype", config.SwapSubType, "isServer", isServer)
+	log.Info("check identifier pass", "identifier", config.Identifier, "swaptypes", config.GetSwapTypes(), "swapsubtype", config.GetSwapSubType(), "isServer", isServer)
 
 	err = config.CheckBlacklistConfig()
 	if err != nil {
@@ -94,6 +96,43 @@ func (config *RouterConfig) CheckConfig(isServer bool) (err error) {
 	return nil
 }
 
+func (config *RouterConfig) checkSwapTypes() error {
+	config.SwapType = strings.ToLower(config.SwapType)
+	swapTypes := make(map[string]*SwapTypeConfig, len(config.SwapTypes))
+	for swapType, c := range config.SwapTypes {
+		if c == nil {
+			c = &SwapTypeConfig{}
+		}
+		routerContracts := make(map[string]string, len(c.RouterContracts))
+		for chainID, routerContract := range c.RouterContracts {
+			if _, err := common.GetBigIntFromStr(chainID); err != nil {
+				return fmt.Errorf("wrong chain ID '%v' in router contracts of swap type '%v'", chainID, swapType)
+			}
+			routerContracts[chainID] = routerContract
+		}
+		if len(routerContracts) > 0 && strings.EqualFold(swapType, "erc20swap") {
+			return errors.New("erc20swap use router contracts in chain and token configs")
+		}
+		c.RouterContracts = routerContracts
+		swapTypes[strings.ToLower(swapType)] = c
+	}
+	config.SwapTypes = swapTypes
+	if config.SwapType == "" {
+		if len(swapTypes) == 0 {
+			return errors.New("empty router swap type")
+		}
+		config.SwapType = config.GetSwapTypes()[0]
+	}
+	if len(swapTypes) > 0 && swapTypes[config.SwapType] == nil {
+		return fmt.Errorf("swap type '%v' is not in 'SwapTypes'", config.SwapType)
+	}
+	isAnyCallEnabled := config.SwapType == "anycallswap" || swapTypes["anycallswap"] != nil
+	if isAnyCallEnabled && config.GetSwapSubType() == "" {
+		return errors.New("anycall must config 'SwapSubType'")
+	}
+	return nil
+}
+
 // CheckBlacklistConfig check black list config
 func (config *RouterConfig) CheckBlacklistConfig() (err error) {
 	tempCidMap := make(map[string]struct{})
@@ -215,6 +254,9 @@ func (s *RouterServerConfig) CheckConfig() error {
 	if s.APIServer == nil {
 		return errors.New("server must config 'APIServer'")
 	}
+	if s.APIServer.GRPCPort != 0 && s.APIServer.GRPCPort == s.APIServer.Port {
+		return errors.New("'GRPCPort' is same as 'Port' of 'APIServer'")
+	}
 	if s.MongoDB == nil {
 		return errors.New("server must config 'MongoDB'")
 	}
@@ -348,6 +390,40 @@ func (s *RouterServerConfig) CheckExtra() error {
 	if s.MaxGasPriceFluctPercent > 100 {
 		return errors.New("too large 'MaxGasPriceFluctPercent' value")
 	}
+	for category, policy := range s.FailurePolicies {
+		switch policy {
+		case FailurePolicyEscalate, FailurePolicyReswap, FailurePolicyPark:
+			if policy == FailurePolicyReswap && category == "nonceConflict" {
+				return errors.New("failure category 'nonceConflict' can not be reswapped automatically")
+			}
+		case "refund":
+			// the failed swap is verified ok on the source chain, and its swap tx is on chain,
+			// so that oracles can not verify the refund of it.
+			return fmt.Errorf("failure policy 'refund' of category '%v' is not supported, use 'escalate' instead", category)
+		default:
+			return fmt.Errorf("wrong failure policy '%v' of category '%v'", policy, category)
+		}
+	}
+	if s.FailureGasBumpPercent == 0 {
+		s.FailureGasBumpPercent = 50 // default value
+	}
+	if s.MaxAutoReswapCount == 0 {
+		s.MaxAutoReswapCount = 3 // default value
+	}
+	oracleUsers := make(map[string]string, len(s.OracleUsers))
+	for enodeID, user := range s.OracleUsers {
+		if !common.IsHexAddress(user) {
+			return fmt.Errorf("wrong oracle user '%v' of enode '%v'", user, enodeID)
+		}
+		oracleUsers[strings.ToLower(enodeID)] = user
+	}
+	s.OracleUsers = oracleUsers
+	if len(s.OracleUsers) == 0 {
+		log.Warn("!!! oracle reports are NOT verified as no oracle users are configed, any oracle enode can report forged status !!!")
+	}
+	if s.MaxOracleHeightLag == 0 {
+		s.MaxOracleHeightLag = 100 // default value
+	}
 	return nil
 }
 
@@ -370,6 +446,9 @@ func (c *MongoDBConfig) CheckConfig() error {
 
 // CheckConfig check onchain config storing chain and token configs
 func (c *OnchainConfig) CheckConfig() error {
+	if c.SnapshotDir != "" && c.KeepSnapshots == 0 {
+		c.KeepSnapshots = 10 // default value
+	}
 	if c.IgnoreCheck {
 		log.Info("ignore check onchain config")
 		return nil
@@ -397,6 +476,12 @@ func (c *OnchainConfig) CheckConfig() error {
 		log.Info("check onchain config connection success", "contract", c.Contract)
 		return nil
 	}
+	if c.SnapshotDir != "" {
+		if files, _ := filepath.Glob(filepath.Join(c.SnapshotDir, ConfigSnapshotFilePattern)); len(files) > 0 {
+			log.Warn("check onchain config connection failed, will use local snapshot", "gateway", c.APIAddress, "contract", c.Contract, "snapshotDir", c.SnapshotDir)
+			return nil
+		}
+	}
 	log.Error("check onchain config connection failed", "gateway", c.APIAddress, "contract", c.Contract)
 	return errors.New("check onchain config connection failed")
 }
@@ -405,9 +490,21 @@ func (c *OnchainConfig) CheckConfig() error {
 //
 //nolint:funlen,gocyclo // ok
 func (c *MPCConfig) CheckConfig(isServer bool) (err error) {
-	if c.SignWithPrivateKey {
+	if c.IsSignWithPrivateKey() {
+		return nil
+	}
+	if c.SignOffline {
+		if c.KeyProvider != nil {
+			return errors.New("mpc can not config both 'SignOffline' and 'KeyProvider'")
+		}
+		if c.OfflineSpoolDir == "" {
+			return errors.New("mpc sign offline must config 'OfflineSpoolDir'")
+		}
 		return nil
 	}
+	if c.KeyProvider != nil {
+		return c.KeyProvider.CheckConfig()
+	}
 	if c.GroupID == nil {
 		return errors.New("mpc must config 'GroupID'")
 	}
@@ -439,6 +536,36 @@ func (c *MPCConfig) CheckConfig(isServer bool) (err error) {
 	return nil
 }
 
+// CheckConfig check key provider config
+func (c *KeyProviderConfig) CheckConfig() error {
+	switch c.Type {
+	case "keystore":
+		if len(c.Keystores) == 0 {
+			return errors.New("keystore key provider must config 'Keystores'")
+		}
+		for chainID, ks := range c.Keystores {
+			if ks == nil || ks.KeystoreFile == "" || ks.PasswordFile == "" {
+				return fmt.Errorf("keystore key provider must config 'KeystoreFile' and 'PasswordFile' of chain %v", chainID)
+			}
+		}
+	case "pkcs11":
+		if c.PKCS11 == nil || c.PKCS11.ModulePath == "" || c.PKCS11.TokenLabel == "" {
+			return errors.New("pkcs11 key provider must config 'ModulePath' and 'TokenLabel'")
+		}
+		if len(c.PKCS11.KeyLabels) == 0 {
+			return errors.New("pkcs11 key provider must config 'KeyLabels'")
+		}
+	case "remote":
+		if c.RemoteSigner == nil || c.RemoteSigner.URL == "" {
+			return errors.New("remote key provider must config 'URL'")
+		}
+	default:
+		return fmt.Errorf("unknown key provider type '%v'", c.Type)
+	}
+	log.Info("check key provider config pass", "type", c.Type)
+	return nil
+}
+
 // CheckConfig check mpc node config
 func (c *MPCNodeConfig) CheckConfig(isServer bool) (err error) {
 	if c.RPCAddress == nil || *c.RPCAddress == "" {
@@ -465,6 +592,7 @@ func (c *ExtraConfig) CheckConfig() (err error) {
 	initDynamicFeeTxEnabledChains()
 	initEnableCheckTxBlockHashChains()
 	initEnableCheckTxBlockIndexChains()
+	initEnableCheckReceiptProofChains()
 	initDisableUseFromChainIDInReceiptChains()
 	initUseFastMPCChains()
 	initIncreaseNonceWhenSendTxChains()
@@ -475,7 +603,7 @@ func (c *ExtraConfig) CheckConfig() (err error) {
 	initIgnoreAnycallFallbackAppIDs()
 
 	for cid, cfg := range c.LocalChainConfig {
-		if err = cfg.CheckConfig(); err != nil {
+		if err = cfg.CheckConfig(cid); err != nil {
 			log.Warn("check local chain config failed", "chainID", cid, "err", err)
 			return err
 		}
@@ -494,6 +622,29 @@ func (c *ExtraConfig) CheckConfig() (err error) {
 		}
 	}
 
+	for chainID, quorum := range c.QuorumVerifyChains {
+		if _, ok := new(big.Int).SetString(chainID, 0); !ok {
+			return fmt.Errorf("wrong chain id '%v' in 'QuorumVerifyChains'", chainID)
+		}
+		if quorum < 1 {
+			return fmt.Errorf("wrong quorum %v of chain '%v' in 'QuorumVerifyChains'", quorum, chainID)
+		}
+	}
+
+	if c.Refund != nil {
+		if c.Refund.AutoRefundDelay < 0 {
+			return errors.New("'AutoRefundDelay' must be non-negative")
+		}
+		if c.Refund.FeeRatePerMillion >= 1000000 {
+			return errors.New("'FeeRatePerMillion' of refund must be less than 1000000")
+		}
+		for _, approver := range c.Refund.ManualRefundApprovers {
+			if !common.IsHexAddress(approver) {
+				return fmt.Errorf("wrong manual refund approver '%v'", approver)
+			}
+		}
+	}
+
 	log.Info("check extra config success",
 		"minReserveFee", c.MinReserveFee,
 		"allowCallByContract", c.AllowCallByContract,
@@ -503,10 +654,112 @@ func (c *ExtraConfig) CheckConfig() (err error) {
 	return nil
 }
 
+func checkConfirmationTiers(tiers []*ConfirmationTier) error {
+	var lastMaxValue *big.Rat
+	for i, tier := range tiers {
+		if tier == nil {
+			return fmt.Errorf("empty tier %v", i)
+		}
+		tier.Finality = strings.ToLower(tier.Finality)
+		if GetL2FinalityRank(tier.Finality) < 0 {
+			return fmt.Errorf("wrong finality %v of tier %v", tier.Finality, i)
+		}
+		if tier.MaxValue == "" {
+			if i != len(tiers)-1 {
+				return fmt.Errorf("tier %v without max value is not the last one", i)
+			}
+			continue
+		}
+		if !tokenValueRegexp.MatchString(tier.MaxValue) {
+			return fmt.Errorf("wrong max value %v of tier %v", tier.MaxValue, i)
+		}
+		maxValue, _ := new(big.Rat).SetString(tier.MaxValue)
+		if lastMaxValue != nil && maxValue.Cmp(lastMaxValue) <= 0 {
+			return fmt.Errorf("max value %v of tier %v is not ascending", tier.MaxValue, i)
+		}
+		lastMaxValue = maxValue
+	}
+	return nil
+}
+
+func hasTierFinality(c *LocalChainConfig) bool {
+	hasFinality := func(tiers []*ConfirmationTier) bool {
+		for _, tier := range tiers {
+			if tier != nil && GetL2FinalityRank(strings.ToLower(tier.Finality)) > 0 {
+				return true
+			}
+		}
+		return false
+	}
+	if hasFinality(c.ConfirmationTiers) {
+		return true
+	}
+	for _, tiers := range c.TokenConfirmationTiers {
+		if hasFinality(tiers) {
+			return true
+		}
+	}
+	return false
+}
+
 // CheckConfig check local chain config
-func (c *LocalChainConfig) CheckConfig() (err error) {
+func (c *LocalChainConfig) CheckConfig(chainID string) (err error) {
 	if c.BigValueDiscount > 100 {
 		return errors.New("'BigValueDiscount' is larger than 100")
 	}
+	c.L2Finality = strings.ToLower(c.L2Finality)
+	if GetL2FinalityRank(c.L2Finality) < 0 {
+		return fmt.Errorf("wrong 'L2Finality' %v", c.L2Finality)
+	}
+	tiers := make(map[string]uint64, len(c.L2FinalityTiers))
+	for level, percent := range c.L2FinalityTiers {
+		level = strings.ToLower(level)
+		if GetL2FinalityRank(level) <= GetL2FinalityRank(c.L2Finality) {
+			return fmt.Errorf("'L2FinalityTiers' level %v is not higher than 'L2Finality' %v", level, c.L2Finality)
+		}
+		tiers[level] = percent
+	}
+	c.L2FinalityTiers = tiers
+	if (GetL2FinalityRank(c.L2Finality) > 0 || len(c.L2FinalityTiers) != 0 || hasTierFinality(c)) &&
+		IsL2FinalitySupported != nil && !IsL2FinalitySupported(chainID) {
+		return errors.New("'L2Finality' is not supported by the bridge of this chain")
+	}
+	if (len(c.ConfirmationTiers) != 0 || len(c.TokenConfirmationTiers) != 0) &&
+		IsConfirmationTiersSupported != nil && !IsConfirmationTiersSupported(chainID) {
+		return errors.New("'ConfirmationTiers' is not supported by the bridge of this chain")
+	}
+	err = checkConfirmationTiers(c.ConfirmationTiers)
+	if err != nil {
+		return fmt.Errorf("wrong 'ConfirmationTiers': %w", err)
+	}
+	for tokenID, tiers := range c.TokenConfirmationTiers {
+		err = checkConfirmationTiers(tiers)
+		if err != nil {
+			return fmt.Errorf("wrong 'TokenConfirmationTiers' of %v: %w", tokenID, err)
+		}
+	}
+	for tokenID, minFeeLimit := range c.MinFeeLimit {
+		if minFeeLimit < 0 {
+			return fmt.Errorf("negative 'MinFeeLimit' of %v", tokenID)
+		}
+	}
+	for tokenID, maxFeeLimit := range c.MaxFeeLimit {
+		if maxFeeLimit < 0 {
+			return fmt.Errorf("negative 'MaxFeeLimit' of %v", tokenID)
+		}
+		if minFeeLimit := c.MinFeeLimit[tokenID]; minFeeLimit > maxFeeLimit {
+			return fmt.Errorf("'MinFeeLimit' %v is larger than 'MaxFeeLimit' %v of %v", minFeeLimit, maxFeeLimit, tokenID)
+		}
+	}
+	if c.MaxGasDrop != "" {
+		if maxGasDrop, ok := new(big.Int).SetString(c.MaxGasDrop, 10); !ok || maxGasDrop.Sign() < 0 {
+			return fmt.Errorf("wrong 'MaxGasDrop' %v", c.MaxGasDrop)
+		}
+	}
+	for tokenID, price := range c.GasDropPrices {
+		if bi, ok := new(big.Int).SetString(price, 10); !ok || bi.Sign() <= 0 {
+			return fmt.Errorf("wrong 'GasDropPrices' %v of %v", price, tokenID)
+		}
+	}
 	return nil
 }
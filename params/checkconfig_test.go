@@ -0,0 +1,103 @@
+package params
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckSwapTypes(t *testing.T) {
+	tests := []struct {
+		swapType    string
+		swapSubType string
+		swapTypes   map[string]*SwapTypeConfig
+		wantErr     bool
+		want        []string
+	}{
+		{swapType: "ERC20Swap", want: []string{"erc20swap"}},
+		{swapType: "", wantErr: true},
+		{
+			swapTypes: map[string]*SwapTypeConfig{"NFTSwap": nil, "ERC20Swap": {}},
+			want:      []string{"erc20swap", "nftswap"},
+		},
+		{
+			swapType:  "nftswap",
+			swapTypes: map[string]*SwapTypeConfig{"erc20swap": {}, "nftswap": {}},
+			want:      []string{"nftswap", "erc20swap"},
+		},
+		{
+			swapType:  "nftswap",
+			swapTypes: map[string]*SwapTypeConfig{"erc20swap": {}},
+			wantErr:   true,
+		},
+		{
+			swapTypes: map[string]*SwapTypeConfig{
+				"nftswap": {RouterContracts: map[string]string{"56": "0x1111111111111111111111111111111111111111"}},
+			},
+			want: []string{"nftswap"},
+		},
+		{
+			swapTypes: map[string]*SwapTypeConfig{
+				"nftswap": {RouterContracts: map[string]string{"bsc": "0x1111111111111111111111111111111111111111"}},
+			},
+			wantErr: true,
+		},
+		{
+			swapTypes: map[string]*SwapTypeConfig{
+				"erc20swap": {RouterContracts: map[string]string{"56": "0x1111111111111111111111111111111111111111"}},
+			},
+			wantErr: true,
+		},
+		{swapType: "anycallswap", wantErr: true},
+		{swapType: "anycallswap", swapSubType: "v7", want: []string{"anycallswap"}},
+		{
+			swapType:  "erc20swap",
+			swapTypes: map[string]*SwapTypeConfig{"erc20swap": {}, "anycallswap": {}},
+			wantErr:   true,
+		},
+		{
+			swapType:  "erc20swap",
+			swapTypes: map[string]*SwapTypeConfig{"erc20swap": {}, "anycallswap": {SwapSubType: "v7"}},
+			want:      []string{"erc20swap", "anycallswap"},
+		},
+	}
+	for i, tt := range tests {
+		config := &RouterConfig{
+			SwapType:    tt.swapType,
+			SwapSubType: tt.swapSubType,
+			SwapTypes:   tt.swapTypes,
+		}
+		err := config.checkSwapTypes()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("case %d: checkSwapTypes error = %v, wantErr %v", i, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if have := config.GetSwapTypes(); !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("case %d: GetSwapTypes = %v, want %v", i, have, tt.want)
+		}
+		if config.SwapType != tt.want[0] {
+			t.Errorf("case %d: default swap type = %v, want %v", i, config.SwapType, tt.want[0])
+		}
+	}
+}
+
+func TestCheckFailurePolicies(t *testing.T) {
+	tests := []struct {
+		policies map[string]string
+		wantErr  bool
+	}{
+		{map[string]string{}, false},
+		{map[string]string{"outOfGas": "reswap", "insufficientLiquidity": "park", "unknown": "escalate"}, false},
+		{map[string]string{"nonceConflict": "reswap"}, true},
+		{map[string]string{"receiverRejected": "refund"}, true},
+		{map[string]string{"outOfGas": "retry"}, true},
+	}
+	for i, tt := range tests {
+		s := &RouterServerConfig{FailurePolicies: tt.policies}
+		if err := s.CheckExtra(); (err != nil) != tt.wantErr {
+			t.Errorf("case %d: CheckExtra error = %v, wantErr %v", i, err, tt.wantErr)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package params
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 
@@ -15,6 +17,15 @@ import (
 // router swap constants
 const (
 	RouterSwapPrefixID = "routerswap"
+
+	ConfigSnapshotFilePattern = "snapshot-*.json"
+)
+
+// l2 finality levels of source txs
+const (
+	L2FinalityUnsafe    = "unsafe"    // only count l2 block confirmations
+	L2FinalitySafe      = "safe"      // batch is posted on l1
+	L2FinalityFinalized = "finalized" // batch is finalized on l1
 )
 
 // CustomizeConfigFunc customize config items
@@ -48,6 +59,7 @@ var (
 	dynamicFeeTxEnabledChains            = make(map[string]struct{})
 	enableCheckTxBlockHashChains         = make(map[string]struct{})
 	enableCheckTxBlockIndexChains        = make(map[string]struct{})
+	enableCheckReceiptProofChains        = make(map[string]struct{})
 	disableUseFromChainIDInReceiptChains = make(map[string]struct{})
 	useFastMPCChains                     = make(map[string]struct{})
 	increaseNonceWhenSendTxChains        = make(map[string]struct{})
@@ -90,6 +102,21 @@ type RouterServerConfig struct {
 	// extras
 	EnableReplaceSwap          bool
 	EnablePassBigValueSwap     bool
+	EnableCheckLiquidity       bool
+	EnableCheckTrustline       bool
+	EnableSimulateTx           bool
+	PermanentRevertReasons     []string `toml:",omitempty" json:",omitempty"`
+	RouterCustomErrors         []string `toml:",omitempty" json:",omitempty"`
+	EnableClassifyFailure      bool
+	FailurePolicies            map[string]string `toml:",omitempty" json:",omitempty"` // key is failure category
+	FailureGasBumpPercent      uint64            `toml:",omitempty" json:",omitempty"`
+	MaxAutoReswapCount         int               `toml:",omitempty" json:",omitempty"`
+	EnableWatchReorg           bool
+	WatchReorgWindow           int64             `toml:",omitempty" json:",omitempty"` // seconds
+	ReorgAlertWebhook          string            `toml:",omitempty" json:",omitempty"`
+	OracleUsers                map[string]string `toml:",omitempty" json:",omitempty"` // key is oracle enode ID
+	MaxOracleHeightLag         uint64            `toml:",omitempty" json:",omitempty"`
+	ConfigChangeAlertWebhook   string            `toml:",omitempty" json:",omitempty"`
 	ReplacePlusGasPricePercent uint64            `toml:",omitempty" json:",omitempty"`
 	WaitTimeToReplace          int64             `toml:",omitempty" json:",omitempty"` // seconds
 	MaxReplaceCount            int               `toml:",omitempty" json:",omitempty"`
@@ -129,6 +156,9 @@ type RouterConfig struct {
 	SwapType    string
 	SwapSubType string
 
+	// enable multiple swap types in one process, key is swap type
+	SwapTypes map[string]*SwapTypeConfig `toml:",omitempty" json:",omitempty"`
+
 	Onchain *OnchainConfig
 	*GatewayConfigs
 
@@ -139,6 +169,14 @@ type RouterConfig struct {
 	*Blacklists
 }
 
+// SwapTypeConfig config of a swap type
+type SwapTypeConfig struct {
+	SwapSubType string `toml:",omitempty" json:",omitempty"`
+	// router contract of this swap type, key is chain ID
+	// (default to the router contract in chain or token config)
+	RouterContracts map[string]string `toml:",omitempty" json:",omitempty"`
+}
+
 // GatewayConfigs gateway config
 type GatewayConfigs struct {
 	Gateways         map[string][]string // key is chain ID
@@ -181,6 +219,7 @@ type ExtraConfig struct {
 	DynamicFeeTxEnabledChains            []string `toml:",omitempty" json:",omitempty"`
 	EnableCheckTxBlockHashChains         []string `toml:",omitempty" json:",omitempty"`
 	EnableCheckTxBlockIndexChains        []string `toml:",omitempty" json:",omitempty"`
+	EnableCheckReceiptProofChains        []string `toml:",omitempty" json:",omitempty"`
 	DisableUseFromChainIDInReceiptChains []string `toml:",omitempty" json:",omitempty"`
 	UseFastMPCChains                     []string `toml:",omitempty" json:",omitempty"`
 	IncreaseNonceWhenSendTxChains        []string `toml:",omitempty" json:",omitempty"`
@@ -191,6 +230,10 @@ type ExtraConfig struct {
 	IgnoreAnycallFallbackAppIDs          []string `toml:",omitempty" json:",omitempty"`
 
 	RPCClientTimeout map[string]int `toml:",omitempty" json:",omitempty"` // key is chainID
+	// verify source tx by matching results of at least quorum gateways
+	QuorumVerifyChains map[string]int `toml:",omitempty" json:",omitempty"` // key is chainID, value is quorum
+	// post security events (eg. gateways disagreement) to this url
+	SecurityAlertWebhook string `toml:",omitempty" json:",omitempty"`
 	// chainID,customKey => customValue
 	Customs map[string]map[string]string `toml:",omitempty" json:",omitempty"`
 
@@ -199,6 +242,29 @@ type ExtraConfig struct {
 	SpecialFlags map[string]string `toml:",omitempty" json:",omitempty"`
 
 	AttestationServer string `toml:",omitempty" json:",omitempty"`
+
+	Refund *RefundConfig `toml:",omitempty" json:",omitempty"`
+}
+
+// RefundConfig refund config (should be same in server and oracles)
+type RefundConfig struct {
+	Enable bool
+	// auto refund after delay of seconds, 0 means refund only on admin approval
+	AutoRefundDelay   int64  `toml:",omitempty" json:",omitempty"`
+	FeeRatePerMillion uint64 `toml:",omitempty" json:",omitempty"`
+	// admins whose signed approval allows refunding swaps made fail manually,
+	// which are verified ok on the source chain. (empty means not allowed)
+	ManualRefundApprovers []string `toml:",omitempty" json:",omitempty"`
+}
+
+// IsManualRefundApprover is approver of refunding swaps made fail manually
+func (c *RefundConfig) IsManualRefundApprover(account string) bool {
+	for _, approver := range c.ManualRefundApprovers {
+		if strings.EqualFold(approver, account) {
+			return true
+		}
+	}
+	return false
 }
 
 // LocalChainConfig local chain config
@@ -216,11 +282,54 @@ type LocalChainConfig struct {
 	ChargeFeeOnDestChain   map[string][]string `toml:",omitempty" json:",omitempty"`
 	FeeReceiverOnDestChain string              `toml:",omitempty" json:",omitempty"`
 
+	// fee limit of contract calling (eg. tron, in sun) is estimated with headroom (percent),
+	// and bounded by [MinFeeLimit, MaxFeeLimit]. key is tokenID ("default" for all tokens)
+	FeeLimitHeadroom uint64           `toml:",omitempty" json:",omitempty"`
+	MinFeeLimit      map[string]int64 `toml:",omitempty" json:",omitempty"`
+	MaxFeeLimit      map[string]int64 `toml:",omitempty" json:",omitempty"`
+
+	// gas drop (native coin top-up of the receiver on this dest chain) is capped by MaxGasDrop,
+	// and charged on the source side by GasDropPrices (tokenID -> token amount per 1e18 native)
+	MaxGasDrop    string            `toml:",omitempty" json:",omitempty"`
+	GasDropPrices map[string]string `toml:",omitempty" json:",omitempty"`
+
+	// evm chain variant (eg. conflux, zksync), default by chain ID
+	EVMVariant string `toml:",omitempty" json:",omitempty"`
+
+	// l2 finality level (unsafe, safe or finalized) required for source txs on this chain,
+	// and higher levels required for swaps whose value reach the percent of big value threshold.
+	// only checked when verifying erc20 swap txs on evm chains.
+	L2Finality      string            `toml:",omitempty" json:",omitempty"`
+	L2FinalityTiers map[string]uint64 `toml:",omitempty" json:",omitempty"` // key is finality level
+
+	// confirmations required for source txs by swap value (ascending by 'MaxValue'),
+	// token specific tiers take precedence over the chain tiers. key is tokenID.
+	// tiers only raise the chain's 'Confirmations', which is always required first,
+	// and are supported by evm and tron chains only
+	ConfirmationTiers      []*ConfirmationTier            `toml:",omitempty" json:",omitempty"`
+	TokenConfirmationTiers map[string][]*ConfirmationTier `toml:",omitempty" json:",omitempty"`
+
 	forbidSwapoutTokenIDMap map[string]struct{}
 
 	lock *sync.Mutex
 }
 
+// IsConfirmationTiersSupported checks whether the bridge of chain enforces confirmation tiers
+// (set by the bridge package, the check is skipped if not set)
+var IsConfirmationTiersSupported func(chainID string) bool
+
+// IsL2FinalitySupported checks whether the bridge of chain enforces l2 finality
+// (set by the bridge package, the check is skipped if not set)
+var IsL2FinalitySupported func(chainID string) bool
+
+// ConfirmationTier confirmations required for swaps with value under 'MaxValue'
+// (in token units, empty means no limit). 'Finality' requires the finality level additionally (evm chains only)
+type ConfirmationTier struct {
+	MaxValue      string `toml:",omitempty" json:",omitempty"`
+	Confirmations uint64
+	Finality      string `toml:",omitempty" json:",omitempty"`
+}
+
 // OnchainConfig struct
 type OnchainConfig struct {
 	Contract    string
@@ -228,6 +337,11 @@ type OnchainConfig struct {
 	WSServers   []string
 	ReloadCycle uint64 // seconds
 	IgnoreCheck bool
+
+	// save local snapshot of onchain config to this dir, and load the whole
+	// config from the latest snapshot if the contract is unreachable in initing
+	SnapshotDir   string `toml:",omitempty" json:",omitempty"`
+	KeepSnapshots uint64 `toml:",omitempty" json:",omitempty"`
 }
 
 // MPCConfig mpc related config
@@ -255,8 +369,54 @@ type MPCConfig struct {
 	DefaultNode   *MPCNodeConfig
 	OtherNodes    []*MPCNodeConfig `toml:",omitempty" json:",omitempty"`
 
-	SignWithPrivateKey bool              // use private key instead (use for testing)
-	SignerPrivateKeys  map[string]string `json:"-"` // key is chain ID (use for testing)
+	// use private key instead (set by testing code only, config file uses KeyProvider instead)
+	SignWithPrivateKey bool              `toml:"-"`
+	SignerPrivateKeys  map[string]string `toml:"-" json:"-"` // key is chain ID
+
+	SignOffline        bool   `toml:",omitempty" json:",omitempty"` // export sign bundles to spool dir and import offline signatures on later passes
+	OfflineSpoolDir    string `toml:",omitempty" json:",omitempty"`
+	OfflineSignTimeout uint64 `toml:",omitempty" json:",omitempty"` // seconds
+
+	// key is chain ID, the contracts allowed by the offline signer (used by sign-offline)
+	OfflineSignChains map[string]*OfflineSignChainConfig `toml:",omitempty" json:",omitempty"`
+
+	KeyProvider *KeyProviderConfig `toml:",omitempty" json:",omitempty"` // sign with keys from key provider instead
+}
+
+// OfflineSignChainConfig offline sign chain config
+type OfflineSignChainConfig struct {
+	RouterContracts []string
+	Tokens          map[string]string // key is token ID, value is token address
+}
+
+// KeyProviderConfig signer key provider config
+type KeyProviderConfig struct {
+	Type string // keystore, pkcs11, remote
+
+	Keystores    map[string]*KeystoreConfig `toml:",omitempty" json:",omitempty"` // key is chain ID
+	PKCS11       *PKCS11Config              `toml:",omitempty" json:",omitempty"`
+	RemoteSigner *RemoteSignerConfig        `toml:",omitempty" json:",omitempty"`
+}
+
+// KeystoreConfig encrypted keystore file config
+type KeystoreConfig struct {
+	KeystoreFile string
+	PasswordFile string `json:"-"`
+}
+
+// PKCS11Config pkcs11 (HSM) config
+type PKCS11Config struct {
+	ModulePath string
+	TokenLabel string
+	PinFile    string `json:"-"`
+	KeyLabels  []string
+}
+
+// RemoteSignerConfig remote signer config
+type RemoteSignerConfig struct {
+	URL           string
+	AuthTokenFile string `toml:",omitempty" json:"-"`
+	Timeout       int    `toml:",omitempty" json:",omitempty"` // seconds
 }
 
 // MPCNodeConfig mpc node config
@@ -272,6 +432,14 @@ type APIServerConfig struct {
 	Port             int
 	AllowedOrigins   []string
 	MaxRequestsLimit int
+
+	// stream swap status updates by sse and websocket,
+	// and keep the latest updates in memory for resuming (default 10000)
+	EnableSwapStream  bool `toml:",omitempty" json:",omitempty"`
+	SwapStreamHistory int  `toml:",omitempty" json:",omitempty"`
+
+	// gRPC service port (0 means disabled)
+	GRPCPort int `toml:",omitempty" json:",omitempty"`
 }
 
 // MongoDBConfig mongodb config
@@ -318,7 +486,39 @@ func GetSwapType() string {
 
 // GetSwapSubType get router swap sub type
 func GetSwapSubType() string {
-	return GetRouterConfig().SwapSubType
+	return GetRouterConfig().GetSwapSubType()
+}
+
+// GetSwapSubType get router swap sub type (of anycall)
+func (config *RouterConfig) GetSwapSubType() string {
+	if c := config.SwapTypes["anycallswap"]; c != nil && c.SwapSubType != "" {
+		return c.SwapSubType
+	}
+	return config.SwapSubType
+}
+
+// GetSwapTypes get enabled router swap types (default swap type comes first)
+func (config *RouterConfig) GetSwapTypes() []string {
+	swapTypes := make([]string, 0, len(config.SwapTypes)+1)
+	if config.SwapType != "" {
+		swapTypes = append(swapTypes, config.SwapType)
+	}
+	others := make([]string, 0, len(config.SwapTypes))
+	for swapType := range config.SwapTypes {
+		if swapType != config.SwapType {
+			others = append(others, swapType)
+		}
+	}
+	sort.Strings(others)
+	return append(swapTypes, others...)
+}
+
+// GetSwapTypeRouterContract get router contract of swap type on chain
+func GetSwapTypeRouterContract(swapType, chainID string) string {
+	if c := GetRouterConfig().SwapTypes[swapType]; c != nil {
+		return c.RouterContracts[chainID]
+	}
+	return ""
 }
 
 // IsSwapWithPermitEnabled is swap with permit enabled
@@ -331,6 +531,14 @@ func IsForceAnySwapInAuto() bool {
 	return GetExtraConfig() != nil && GetExtraConfig().ForceAnySwapInAuto
 }
 
+// GetRefundConfig get refund config
+func GetRefundConfig() *RefundConfig {
+	if GetExtraConfig() == nil || GetExtraConfig().Refund == nil || !GetExtraConfig().Refund.Enable {
+		return nil
+	}
+	return GetExtraConfig().Refund
+}
+
 // IsParallelSwapEnabled is parallel swap enabled
 func IsParallelSwapEnabled() bool {
 	return GetExtraConfig() != nil && GetExtraConfig().EnableParallelSwap
@@ -367,6 +575,25 @@ func GetMaxGasLimit(chainID string) uint64 {
 	return serverCfg.MaxGasLimit[chainID]
 }
 
+// failure policies of classified failed swaps
+const (
+	FailurePolicyEscalate = "escalate" // escalate to admin (default)
+	FailurePolicyReswap   = "reswap"   // auto reswap (with more gas if out of gas)
+	FailurePolicyPark     = "park"     // park for liquidity or trustline
+)
+
+// GetFailurePolicy get policy of failure category, default to escalate
+func GetFailurePolicy(category string) string {
+	serverCfg := GetRouterServerConfig()
+	if serverCfg == nil {
+		return FailurePolicyEscalate
+	}
+	if policy, exist := serverCfg.FailurePolicies[category]; exist {
+		return policy
+	}
+	return FailurePolicyEscalate
+}
+
 // GetMaxTokenGasLimit get max token gas limit of specified tokenID and chainID
 func GetMaxTokenGasLimit(tokenID, chainID string) uint64 {
 	serverCfg := GetRouterServerConfig()
@@ -447,6 +674,24 @@ func GetRPCClientTimeout(chainID string) int {
 	return extraCfg.RPCClientTimeout[chainID]
 }
 
+// GetQuorumVerifyCount get the quorum of gateways to verify source tx (0 means disabled)
+func GetQuorumVerifyCount(chainID string) int {
+	extraCfg := GetExtraConfig()
+	if extraCfg == nil {
+		return 0
+	}
+	return extraCfg.QuorumVerifyChains[chainID]
+}
+
+// GetSecurityAlertWebhook get security alert webhook
+func GetSecurityAlertWebhook() string {
+	extraCfg := GetExtraConfig()
+	if extraCfg == nil {
+		return ""
+	}
+	return extraCfg.SecurityAlertWebhook
+}
+
 // GetCustom get custom
 func GetCustom(chainID, key string) string {
 	extraCfg := GetExtraConfig()
@@ -463,6 +708,27 @@ func GetCustom(chainID, key string) string {
 	return ""
 }
 
+// GetOfflineSignChainConfig get offline sign chain config
+func (c *MPCConfig) GetOfflineSignChainConfig(chainID string) *OfflineSignChainConfig {
+	return c.OfflineSignChains[chainID]
+}
+
+// IsRouterContractAllowed is router contract allowed in offline signing
+func (c *OfflineSignChainConfig) IsRouterContractAllowed(routerContract string) bool {
+	for _, contract := range c.RouterContracts {
+		if strings.EqualFold(contract, routerContract) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSignWithPrivateKey is sign with raw private key (use for testing),
+// the key provider takes precedence if it is configured.
+func (c *MPCConfig) IsSignWithPrivateKey() bool {
+	return c.SignWithPrivateKey && c.KeyProvider == nil
+}
+
 // GetSignerPrivateKey get signer private key (use for testing)
 func (c *MPCConfig) GetSignerPrivateKey(chainID string) string {
 	if prikey, exist := c.SignerPrivateKeys[chainID]; exist {
@@ -737,6 +1003,14 @@ func GetRouterConfig() *RouterConfig {
 	return routerConfig
 }
 
+// GetConfigSnapshotDir get onchain config snapshot dir
+func GetConfigSnapshotDir() string {
+	if routerConfig.Onchain == nil {
+		return ""
+	}
+	return routerConfig.Onchain.SnapshotDir
+}
+
 // GetRouterServerConfig get router server config
 func GetRouterServerConfig() *RouterServerConfig {
 	return routerConfig.Server
@@ -964,6 +1238,27 @@ func IsCheckTxBlockHashEnabled(chainID string) bool {
 	return exist
 }
 
+func initEnableCheckReceiptProofChains() {
+	if GetExtraConfig() == nil || len(GetExtraConfig().EnableCheckReceiptProofChains) == 0 {
+		return
+	}
+	tempMap := make(map[string]struct{})
+	for _, cid := range GetExtraConfig().EnableCheckReceiptProofChains {
+		if _, err := common.GetBigIntFromStr(cid); err != nil {
+			log.Fatal("initEnableCheckReceiptProofChains wrong chainID", "chainID", cid, "err", err)
+		}
+		tempMap[cid] = struct{}{}
+	}
+	enableCheckReceiptProofChains = tempMap
+	log.Info("initEnableCheckReceiptProofChains success", "isReload", IsReload)
+}
+
+// IsCheckReceiptProofEnabled check receipt is in block by receipts root
+func IsCheckReceiptProofEnabled(chainID string) bool {
+	_, exist := enableCheckReceiptProofChains[chainID]
+	return exist
+}
+
 func initEnableCheckTxBlockIndexChains() {
 	if GetExtraConfig() == nil || len(GetExtraConfig().EnableCheckTxBlockIndexChains) == 0 {
 		return
@@ -1150,6 +1445,41 @@ func GetDynamicFeeTxConfig(chainID string) *DynamicFeeTxConfig {
 	return nil
 }
 
+// rawSignerKeysConfig is the removed raw signer private keys config.
+// To migrate, move the keys into encrypted keystore files (or HSM)
+// and config them in the '[MPC.KeyProvider]' section instead.
+type rawSignerKeysConfig struct {
+	SignWithPrivateKey bool
+	SignerPrivateKeys  map[string]string
+}
+
+// checkRawSignerKeys reject enabled raw signer private keys in config file,
+// the disabled (eg. 'SignWithPrivateKey = false') ones are ignored.
+func checkRawSignerKeys(configFile string) error {
+	var config struct {
+		MPC     *rawSignerKeysConfig
+		FastMPC *rawSignerKeysConfig
+	}
+	md, err := toml.DecodeFile(configFile, &config)
+	if err != nil {
+		return err
+	}
+	mpcKeys := []string{"MPC", "FastMPC"}
+	for i, c := range []*rawSignerKeysConfig{config.MPC, config.FastMPC} {
+		mpcKey := mpcKeys[i]
+		if c == nil {
+			continue
+		}
+		if c.SignWithPrivateKey || len(c.SignerPrivateKeys) > 0 {
+			return fmt.Errorf("raw signer private keys in [%v] is not supported, use [%v.KeyProvider] instead", mpcKey, mpcKey)
+		}
+		if md.IsDefined(mpcKey, "SignWithPrivateKey") || md.IsDefined(mpcKey, "SignerPrivateKeys") {
+			log.Warnf("ignore disabled raw signer private keys config in [%v], please remove it", mpcKey)
+		}
+	}
+	return nil
+}
+
 // LoadRouterConfig load router swap config
 func LoadRouterConfig(configFile string, isServer, check bool) *RouterConfig {
 	IsSwapServer = isServer
@@ -1164,6 +1494,9 @@ func LoadRouterConfig(configFile string, isServer, check bool) *RouterConfig {
 	if _, err := toml.DecodeFile(configFile, &config); err != nil {
 		log.Fatalf("LoadRouterConfig error (toml DecodeFile): %v", err)
 	}
+	if err := checkRawSignerKeys(configFile); err != nil {
+		log.Fatalf("LoadRouterConfig error: %v", err)
+	}
 
 	if !isServer {
 		config.Server = nil
@@ -1335,6 +1668,84 @@ func ChargeFeeOnDestChain(tokenID, fromChainID, toChainID string) bool {
 	return false
 }
 
+// GetEVMVariant get evm chain variant of chain (empty means default)
+func GetEVMVariant(chainID string) string {
+	return GetLocalChainConfig(chainID).EVMVariant
+}
+
+// GetL2FinalityRank get rank of l2 finality level (-1 if invalid)
+func GetL2FinalityRank(level string) int {
+	switch level {
+	case "", L2FinalityUnsafe:
+		return 0
+	case L2FinalitySafe:
+		return 1
+	case L2FinalityFinalized:
+		return 2
+	default:
+		return -1
+	}
+}
+
+// GetL2Finality get l2 finality level required for source txs on chain
+func GetL2Finality(chainID string) string {
+	return GetLocalChainConfig(chainID).L2Finality
+}
+
+// GetL2FinalityTiers get l2 finality tiers of chain (finality level -> percent of big value threshold)
+func GetL2FinalityTiers(chainID string) map[string]uint64 {
+	return GetLocalChainConfig(chainID).L2FinalityTiers
+}
+
+// GetConfirmationTiers get confirmation tiers of token on chain (token specific tiers first)
+func GetConfirmationTiers(chainID, tokenID string) []*ConfirmationTier {
+	c := GetLocalChainConfig(chainID)
+	for tid, tiers := range c.TokenConfirmationTiers {
+		if strings.EqualFold(tid, tokenID) {
+			return tiers
+		}
+	}
+	return c.ConfirmationTiers
+}
+
+// GetFeeLimitHeadroom get fee limit headroom (percent)
+func GetFeeLimitHeadroom(chainID string) uint64 {
+	return GetLocalChainConfig(chainID).FeeLimitHeadroom
+}
+
+// GetFeeLimitBounds get fee limit bounds of token (0 means not configed)
+func GetFeeLimitBounds(chainID, tokenID string) (minFeeLimit, maxFeeLimit int64) {
+	c := GetLocalChainConfig(chainID)
+	getBound := func(bounds map[string]int64) int64 {
+		for tid, bound := range bounds {
+			if strings.EqualFold(tid, tokenID) {
+				return bound
+			}
+		}
+		return bounds["default"]
+	}
+	return getBound(c.MinFeeLimit), getBound(c.MaxFeeLimit)
+}
+
+// GetGasDropConfig get gas drop cap and price of token on dest chain (nil means not supported)
+func GetGasDropConfig(toChainID, tokenID string) (maxGasDrop, price *big.Int) {
+	c := GetLocalChainConfig(toChainID)
+	if c.MaxGasDrop == "" || len(c.GasDropPrices) == 0 {
+		return nil, nil
+	}
+	for tid, priceStr := range c.GasDropPrices {
+		if strings.EqualFold(tid, tokenID) {
+			maxGasDrop, _ = new(big.Int).SetString(c.MaxGasDrop, 10)
+			price, _ = new(big.Int).SetString(priceStr, 10)
+			break
+		}
+	}
+	if maxGasDrop == nil || maxGasDrop.Sign() <= 0 || price == nil || price.Sign() <= 0 {
+		return nil, nil
+	}
+	return maxGasDrop, price
+}
+
 // GetAttestationServer get attestation server
 func GetAttestationServer() string {
 	if GetExtraConfig() != nil {
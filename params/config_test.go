@@ -0,0 +1,42 @@
+package params
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckRawSignerKeys(t *testing.T) {
+	tests := []struct {
+		config  string
+		wantErr bool
+	}{
+		{"[MPC]\nSignTimeout = 120\n", false},
+		{"[MPC]\nSignWithPrivateKey = false\n", false},
+		{"[MPC]\nSignWithPrivateKey = false\n[MPC.SignerPrivateKeys]\n", false},
+		{"[FastMPC]\nSignWithPrivateKey = false\n", false},
+		{"[MPC]\nSignWithPrivateKey = true\n", true},
+		{"[MPC.SignerPrivateKeys]\n4 = \"1111111111111111111111111111111111111111111111111111111111111111\"\n", true},
+		{"[FastMPC]\nSignWithPrivateKey = true\n", true},
+	}
+	for i, tt := range tests {
+		configFile := filepath.Join(t.TempDir(), "config.toml")
+		if err := os.WriteFile(configFile, []byte(tt.config), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := checkRawSignerKeys(configFile); (err != nil) != tt.wantErr {
+			t.Errorf("case %d: checkRawSignerKeys error = %v, wantErr %v", i, err, tt.wantErr)
+		}
+	}
+
+	// raw private key is ignored if key provider is configured
+	c := &MPCConfig{KeyProvider: &KeyProviderConfig{Type: "keystore"}}
+	c.SetSignerPrivateKey("4", "1111111111111111111111111111111111111111111111111111111111111111")
+	if c.IsSignWithPrivateKey() {
+		t.Errorf("sign with private key while key provider is configured")
+	}
+	c.KeyProvider = nil
+	if !c.IsSignWithPrivateKey() {
+		t.Errorf("not sign with private key set by testing code")
+	}
+}
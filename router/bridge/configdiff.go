@@ -0,0 +1,484 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const configChangeAlertTimeout = 10 // seconds
+
+// config change kinds
+const (
+	ChainAdded   = "ChainAdded"
+	ChainRemoved = "ChainRemoved"
+	TokenAdded   = "TokenAdded"
+	TokenRemoved = "TokenRemoved"
+	ChainChanged = "ChainChanged"
+	TokenChanged = "TokenChanged"
+	SwapChanged  = "SwapChanged"
+	FeeChanged   = "FeeChanged"
+)
+
+var (
+	configDiff *configDiffCollector // not nil in initing or reloading
+
+	configChanges configChangeStore = mgoConfigChangeStore{}
+)
+
+// configChangeStore stores the held and accepted config changes
+type configChangeStore interface {
+	IsAvailable() bool
+	FindHeld(key string) (*mongodb.MgoConfigChange, error)
+	FindLastAccepted(change *mongodb.MgoConfigChange) (*mongodb.MgoConfigChange, error)
+	Accept(change *mongodb.MgoConfigChange) error
+	Hold(change *mongodb.MgoConfigChange) error
+}
+
+// mgoConfigChangeStore stores config changes in mongodb (server only)
+type mgoConfigChangeStore struct{}
+
+func (mgoConfigChangeStore) IsAvailable() bool {
+	return mongodb.HasClient()
+}
+
+func (mgoConfigChangeStore) FindHeld(key string) (*mongodb.MgoConfigChange, error) {
+	return mongodb.FindHeldConfigChange(key)
+}
+
+func (mgoConfigChangeStore) FindLastAccepted(c *mongodb.MgoConfigChange) (*mongodb.MgoConfigChange, error) {
+	return mongodb.FindLastAcceptedConfigChange(c.Kind, c.ChainID, c.ToChainID, c.TokenID, c.Field)
+}
+
+func (mgoConfigChangeStore) Accept(change *mongodb.MgoConfigChange) error {
+	return mongodb.AcceptConfigChange(change)
+}
+
+func (mgoConfigChangeStore) Hold(change *mongodb.MgoConfigChange) error {
+	return mongodb.HoldConfigChange(change)
+}
+
+type configDiffCollector struct {
+	lock        sync.Mutex
+	isInit      bool
+	blockNumber uint64
+	timestamp   int64
+	changes     []*mongodb.MgoConfigChange
+	serverHeld  map[string]*mongodb.MgoConfigChange // oracles hold the changes held by server
+}
+
+// beginConfigDiff begin collecting config changes. in initing, there is no old config,
+// so dangerous config values are checked against the last accepted ones instead.
+func beginConfigDiff(isInit bool) {
+	blockNumber, err := router.GetConfigBlockNumber()
+	if err != nil {
+		log.Warn("[reload] get config block number failed", "err", err)
+	}
+	configDiff = &configDiffCollector{
+		isInit:      isInit,
+		blockNumber: blockNumber,
+		timestamp:   time.Now().Unix(),
+		serverHeld:  getServerHeldConfigChanges(),
+	}
+}
+
+// getServerHeldConfigChanges oracles get the held config changes from server
+func getServerHeldConfigChanges() map[string]*mongodb.MgoConfigChange {
+	oracleCfg := params.GetRouterOracleConfig()
+	if oracleCfg == nil || mongodb.HasClient() {
+		return nil
+	}
+	var result struct {
+		HeldChanges []*mongodb.MgoConfigChange
+	}
+	err := client.RPCPostWithTimeout(configChangeAlertTimeout, &result, oracleCfg.ServerAPIAddress, "swap.GetConfigChanges")
+	if err != nil {
+		log.Warn("get held config changes from server failed", "err", err)
+		return nil
+	}
+	held := make(map[string]*mongodb.MgoConfigChange, len(result.HeldChanges))
+	for _, change := range result.HeldChanges {
+		if change.Status == mongodb.ConfigChangeHeld {
+			held[change.Key] = change
+		}
+	}
+	log.Info("get held config changes from server success", "count", len(held))
+	return held
+}
+
+func (diff *configDiffCollector) addChange(change *mongodb.MgoConfigChange) {
+	diff.lock.Lock()
+	diff.changes = append(diff.changes, change)
+	diff.lock.Unlock()
+}
+
+// checkAcceptedConfig check dangerous config value in initing against the latest
+// accepted value, returns the accepted value to use instead if the value is changed and held.
+func checkAcceptedConfig(change *mongodb.MgoConfigChange) (accepted string, hold bool) {
+	diff := configDiff
+	if diff == nil || !diff.isInit {
+		return "", false
+	}
+	change.Key = getConfigChangeKey(change)
+	change.BlockNumber = diff.blockNumber
+	change.Timestamp = diff.timestamp
+
+	if !configChanges.IsAvailable() {
+		held, exist := diff.serverHeld[change.Key]
+		if !exist {
+			return "", false
+		}
+		change.Old = held.Old
+	} else {
+		held, err := configChanges.FindHeld(change.Key)
+		switch {
+		case err == nil && held.Status == mongodb.ConfigChangeConfirmed:
+			_ = configChanges.Accept(change)
+			return "", false
+		case err == nil && held.Status == mongodb.ConfigChangeHeld:
+			change.Old = held.Old
+		case err == nil, errors.Is(err, mongodb.ErrItemNotFound):
+			// an applied value may be accepted long ago, and changed
+			// to others later (eg. A -> B -> A), so compare with the latest one
+			last, errf := configChanges.FindLastAccepted(change)
+			if errf == nil && strings.EqualFold(last.New, change.New) {
+				return "", false
+			}
+			if errors.Is(errf, mongodb.ErrItemNotFound) || (errf == nil && !change.Dangerous) {
+				_ = configChanges.Accept(change) // first seen or safe value
+				return "", false
+			}
+			if errf != nil {
+				log.Warn("find last accepted config failed", "key", change.Key, "err", errf)
+				return "", false
+			}
+			change.Old = last.New
+			_ = configChanges.Hold(change)
+		default:
+			log.Warn("find held config change failed", "key", change.Key, "err", err)
+			return "", false
+		}
+	}
+
+	change.Status = mongodb.ConfigChangeHeld
+	log.Warn("hold config change until admin confirms", "kind", change.Kind, "chainID", change.ChainID, "toChainID", change.ToChainID,
+		"tokenID", change.TokenID, "field", change.Field, "accepted", change.Old, "new", change.New, "key", change.Key)
+	diff.addChange(change)
+	return change.Old, true
+}
+
+func getConfigChangeKey(c *mongodb.MgoConfigChange) string {
+	return strings.ToLower(fmt.Sprintf("%s:%s:%s:%s:%s:%s", c.Kind, c.ChainID, c.ToChainID, c.TokenID, c.Field, c.New))
+}
+
+// recordConfigChange record config change in reloading,
+// returns true if it is a dangerous change and should be held.
+// the applied value is saved as accepted, which is the baseline to check in initing.
+func recordConfigChange(change *mongodb.MgoConfigChange) (hold bool) {
+	diff := configDiff
+	if diff == nil || diff.isInit {
+		return false
+	}
+	change.Key = getConfigChangeKey(change)
+	change.BlockNumber = diff.blockNumber
+	change.Timestamp = diff.timestamp
+	change.Status = mongodb.ConfigChangeApplied
+
+	// hold dangerous change until admin confirms on server,
+	// and oracles hold the same changes as server.
+	switch {
+	case !change.Dangerous:
+	case configChanges.IsAvailable():
+		held, err := configChanges.FindHeld(change.Key)
+		if err != nil || held.Status != mongodb.ConfigChangeConfirmed {
+			if err == nil || errors.Is(err, mongodb.ErrItemNotFound) {
+				_ = configChanges.Hold(change)
+			}
+			hold = true
+		}
+	default:
+		_, hold = diff.serverHeld[change.Key]
+	}
+	if hold {
+		change.Status = mongodb.ConfigChangeHeld
+	} else if change.Field != "" && configChanges.IsAvailable() {
+		_ = configChanges.Accept(change)
+	}
+
+	diff.addChange(change)
+	return hold
+}
+
+func endConfigDiff() {
+	diff := configDiff
+	configDiff = nil
+	if diff == nil || len(diff.changes) == 0 {
+		return
+	}
+	for _, c := range diff.changes {
+		logFunc := log.GetLogFuncOr(c.Dangerous, log.Warn, log.Info)
+		logFunc("[reload] config changed", "kind", c.Kind, "chainID", c.ChainID, "toChainID", c.ToChainID, "tokenID", c.TokenID,
+			"field", c.Field, "old", c.Old, "new", c.New, "dangerous", c.Dangerous, "status", c.Status.String(), "key", c.Key)
+	}
+	mgoDiff := &mongodb.MgoConfigDiff{
+		Key:         fmt.Sprintf("%d", common.NowMilli()),
+		BlockNumber: diff.blockNumber,
+		Timestamp:   diff.timestamp,
+		Changes:     diff.changes,
+	}
+	if mongodb.HasClient() {
+		_ = mongodb.AddConfigDiff(mgoDiff)
+	}
+	sendConfigChangeAlert(mgoDiff)
+}
+
+func sendConfigChangeAlert(diff *mongodb.MgoConfigDiff) {
+	serverCfg := params.GetRouterServerConfig()
+	if serverCfg == nil || serverCfg.ConfigChangeAlertWebhook == "" {
+		return
+	}
+	resp, err := client.HTTPPost(serverCfg.ConfigChangeAlertWebhook, diff, nil, nil, configChangeAlertTimeout)
+	if err != nil {
+		log.Warn("[reload] send config change alert failed", "blockNumber", diff.BlockNumber, "err", err)
+		return
+	}
+	_ = resp.Body.Close()
+}
+
+func isDroppingToZero(oldVal, newVal *big.Int) bool {
+	return oldVal != nil && oldVal.Sign() > 0 && (newVal == nil || newVal.Sign() == 0)
+}
+
+func bigIntString(x *big.Int) string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
+
+// recordChainChanges returns true if some changes are held.
+// in initing, held values of new config are replaced with the accepted ones.
+func recordChainChanges(chainID string, oldCfg, newCfg *tokens.ChainConfig) (hold bool) {
+	if configDiff == nil {
+		return false
+	}
+	if configDiff.isInit {
+		if accepted, held := checkAcceptedConfig(&mongodb.MgoConfigChange{
+			Kind:      ChainChanged,
+			ChainID:   chainID,
+			Field:     "RouterContract",
+			New:       newCfg.RouterContract,
+			Dangerous: true,
+		}); held {
+			newCfg.RouterContract = accepted
+		}
+		return false
+	}
+	if oldCfg == nil {
+		return false
+	}
+	if !strings.EqualFold(oldCfg.RouterContract, newCfg.RouterContract) {
+		hold = recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      ChainChanged,
+			ChainID:   chainID,
+			Field:     "RouterContract",
+			Old:       oldCfg.RouterContract,
+			New:       newCfg.RouterContract,
+			Dangerous: true,
+		})
+	}
+	return hold
+}
+
+// recordTokenChanges returns true if some changes are held.
+// in initing, held values of new config are replaced with the accepted ones.
+func recordTokenChanges(chainID, tokenID string, oldCfg, newCfg *tokens.TokenConfig) (hold bool) {
+	if configDiff == nil {
+		return false
+	}
+	if configDiff.isInit {
+		if accepted, held := checkAcceptedConfig(&mongodb.MgoConfigChange{
+			Kind:      TokenChanged,
+			ChainID:   chainID,
+			TokenID:   tokenID,
+			Field:     "Decimals",
+			New:       fmt.Sprint(newCfg.Decimals),
+			Dangerous: true,
+		}); held {
+			decimals, err := strconv.ParseUint(accepted, 10, 8)
+			if err != nil {
+				return true // can not restore, do not load it
+			}
+			newCfg.Decimals = uint8(decimals)
+		}
+		if accepted, held := checkAcceptedConfig(&mongodb.MgoConfigChange{
+			Kind:      TokenChanged,
+			ChainID:   chainID,
+			TokenID:   tokenID,
+			Field:     "RouterContract",
+			New:       newCfg.RouterContract,
+			Dangerous: true,
+		}); held {
+			newCfg.RouterContract = accepted
+		}
+		return false
+	}
+	if oldCfg == nil {
+		recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:    TokenAdded,
+			ChainID: chainID,
+			TokenID: tokenID,
+			New:     newCfg.ContractAddress,
+		})
+		return false
+	}
+	if !strings.EqualFold(oldCfg.ContractAddress, newCfg.ContractAddress) {
+		recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:    TokenChanged,
+			ChainID: chainID,
+			TokenID: tokenID,
+			Field:   "ContractAddress",
+			Old:     oldCfg.ContractAddress,
+			New:     newCfg.ContractAddress,
+		})
+	}
+	if oldCfg.Decimals != newCfg.Decimals {
+		hold = recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      TokenChanged,
+			ChainID:   chainID,
+			TokenID:   tokenID,
+			Field:     "Decimals",
+			Old:       fmt.Sprint(oldCfg.Decimals),
+			New:       fmt.Sprint(newCfg.Decimals),
+			Dangerous: true,
+		}) || hold
+	}
+	if !strings.EqualFold(oldCfg.RouterContract, newCfg.RouterContract) {
+		hold = recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      TokenChanged,
+			ChainID:   chainID,
+			TokenID:   tokenID,
+			Field:     "RouterContract",
+			Old:       oldCfg.RouterContract,
+			New:       newCfg.RouterContract,
+			Dangerous: true,
+		}) || hold
+	}
+	return hold
+}
+
+func recordSwapChanges(tokenID, fromChainID, toChainID string, newCfg *tokens.SwapConfig) {
+	if configDiff == nil || configDiff.isInit {
+		return
+	}
+	oldCfg := tokens.GetSwapConfig(tokenID, fromChainID, toChainID)
+	if oldCfg == nil {
+		return
+	}
+	fields := []struct {
+		name     string
+		old, new *big.Int
+	}{
+		{"MaximumSwap", oldCfg.MaximumSwap, newCfg.MaximumSwap},
+		{"MinimumSwap", oldCfg.MinimumSwap, newCfg.MinimumSwap},
+		{"BigValueThreshold", oldCfg.BigValueThreshold, newCfg.BigValueThreshold},
+	}
+	for _, f := range fields {
+		if bigIntString(f.old) == bigIntString(f.new) {
+			continue
+		}
+		recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      SwapChanged,
+			ChainID:   fromChainID,
+			ToChainID: toChainID,
+			TokenID:   tokenID,
+			Field:     f.name,
+			Old:       bigIntString(f.old),
+			New:       bigIntString(f.new),
+		})
+	}
+}
+
+// recordFeeChanges returns the old config if some changes are held.
+// in initing, returns the new config with the accepted held values.
+func recordFeeChanges(tokenID, fromChainID, toChainID string, newCfg *tokens.FeeConfig) *tokens.FeeConfig {
+	if configDiff == nil {
+		return nil
+	}
+	if configDiff.isInit {
+		// only dropping to zero is dangerous
+		if accepted, held := checkAcceptedConfig(&mongodb.MgoConfigChange{
+			Kind:      FeeChanged,
+			ChainID:   fromChainID,
+			ToChainID: toChainID,
+			TokenID:   tokenID,
+			Field:     "MinimumSwapFee",
+			New:       bigIntString(newCfg.MinimumSwapFee),
+			Dangerous: newCfg.MinimumSwapFee == nil || newCfg.MinimumSwapFee.Sign() == 0,
+		}); held {
+			minSwapFee, ok := new(big.Int).SetString(accepted, 10)
+			if !ok {
+				return nil
+			}
+			heldCfg := *newCfg
+			heldCfg.MinimumSwapFee = minSwapFee
+			return &heldCfg
+		}
+		return nil
+	}
+	oldCfg := tokens.GetFeeConfig(tokenID, fromChainID, toChainID)
+	if oldCfg == nil {
+		return nil
+	}
+	hold := false
+	if bigIntString(oldCfg.MaximumSwapFee) != bigIntString(newCfg.MaximumSwapFee) {
+		recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      FeeChanged,
+			ChainID:   fromChainID,
+			ToChainID: toChainID,
+			TokenID:   tokenID,
+			Field:     "MaximumSwapFee",
+			Old:       bigIntString(oldCfg.MaximumSwapFee),
+			New:       bigIntString(newCfg.MaximumSwapFee),
+		})
+	}
+	if bigIntString(oldCfg.MinimumSwapFee) != bigIntString(newCfg.MinimumSwapFee) {
+		hold = recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      FeeChanged,
+			ChainID:   fromChainID,
+			ToChainID: toChainID,
+			TokenID:   tokenID,
+			Field:     "MinimumSwapFee",
+			Old:       bigIntString(oldCfg.MinimumSwapFee),
+			New:       bigIntString(newCfg.MinimumSwapFee),
+			Dangerous: isDroppingToZero(oldCfg.MinimumSwapFee, newCfg.MinimumSwapFee),
+		})
+	}
+	if oldCfg.SwapFeeRatePerMillion != newCfg.SwapFeeRatePerMillion {
+		recordConfigChange(&mongodb.MgoConfigChange{
+			Kind:      FeeChanged,
+			ChainID:   fromChainID,
+			ToChainID: toChainID,
+			TokenID:   tokenID,
+			Field:     "SwapFeeRatePerMillion",
+			Old:       fmt.Sprint(oldCfg.SwapFeeRatePerMillion),
+			New:       fmt.Sprint(newCfg.SwapFeeRatePerMillion),
+		})
+	}
+	if hold {
+		return oldCfg
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+)
+
+// memConfigChangeStore stores config changes in memory
+type memConfigChangeStore map[string]*mongodb.MgoConfigChange
+
+func (m memConfigChangeStore) IsAvailable() bool {
+	return true
+}
+
+func (m memConfigChangeStore) FindHeld(key string) (*mongodb.MgoConfigChange, error) {
+	if change, exist := m[key]; exist {
+		c := *change
+		return &c, nil
+	}
+	return nil, mongodb.ErrItemNotFound
+}
+
+func (m memConfigChangeStore) FindLastAccepted(c *mongodb.MgoConfigChange) (*mongodb.MgoConfigChange, error) {
+	var last *mongodb.MgoConfigChange
+	for _, change := range m {
+		if change.Status != mongodb.ConfigChangeApplied ||
+			change.Kind != c.Kind || change.ChainID != c.ChainID || change.ToChainID != c.ToChainID ||
+			change.TokenID != c.TokenID || change.Field != c.Field {
+			continue
+		}
+		if last == nil || change.Timestamp > last.Timestamp {
+			last = change
+		}
+	}
+	if last == nil {
+		return nil, mongodb.ErrItemNotFound
+	}
+	result := *last
+	return &result, nil
+}
+
+func (m memConfigChangeStore) Accept(change *mongodb.MgoConfigChange) error {
+	change.Status = mongodb.ConfigChangeApplied
+	c := *change
+	m[change.Key] = &c
+	return nil
+}
+
+func (m memConfigChangeStore) Hold(change *mongodb.MgoConfigChange) error {
+	change.Status = mongodb.ConfigChangeHeld
+	c := *change
+	m[change.Key] = &c
+	return nil
+}
+
+func useMemConfigChangeStore(t *testing.T) memConfigChangeStore {
+	t.Helper()
+	store := make(memConfigChangeStore)
+	configChanges = store
+	t.Cleanup(func() {
+		configChanges = mgoConfigChangeStore{}
+		configDiff = nil
+	})
+	return store
+}
+
+func newMinSwapFeeChange(oldVal, newVal string) *mongodb.MgoConfigChange {
+	return &mongodb.MgoConfigChange{
+		Kind:      FeeChanged,
+		ChainID:   "1",
+		ToChainID: "56",
+		TokenID:   "USDC",
+		Field:     "MinimumSwapFee",
+		Old:       oldVal,
+		New:       newVal,
+		Dangerous: newVal == "0",
+	}
+}
+
+func newRouterContractChange(oldVal, newVal string) *mongodb.MgoConfigChange {
+	return &mongodb.MgoConfigChange{
+		Kind:      ChainChanged,
+		ChainID:   "56",
+		Field:     "RouterContract",
+		Old:       oldVal,
+		New:       newVal,
+		Dangerous: true,
+	}
+}
+
+// initConfig check config value in initing at timestamp
+func initConfig(timestamp int64, change *mongodb.MgoConfigChange) (accepted string, hold bool) {
+	configDiff = &configDiffCollector{isInit: true, timestamp: timestamp}
+	defer func() { configDiff = nil }()
+	change.Old = ""
+	return checkAcceptedConfig(change)
+}
+
+// reloadConfig record config change in reloading at timestamp
+func reloadConfig(timestamp int64, change *mongodb.MgoConfigChange) (hold bool) {
+	configDiff = &configDiffCollector{timestamp: timestamp}
+	defer func() { configDiff = nil }()
+	return recordConfigChange(change)
+}
+
+func TestMinimumSwapFeeDropsWhileDown(t *testing.T) {
+	store := useMemConfigChangeStore(t)
+
+	if _, hold := initConfig(1, newMinSwapFeeChange("", "0")); hold {
+		t.Fatal("first seen value is held")
+	}
+	// safe change in reloading is accepted
+	if hold := reloadConfig(2, newMinSwapFeeChange("0", "5")); hold {
+		t.Fatal("safe change is held")
+	}
+	last, err := store.FindLastAccepted(newMinSwapFeeChange("", ""))
+	if err != nil || last.New != "5" {
+		t.Fatalf("last accepted value = %+v (err %v), want 5", last, err)
+	}
+	// dropping to zero while down is held with the latest accepted value
+	accepted, hold := initConfig(3, newMinSwapFeeChange("", "0"))
+	if !hold || accepted != "5" {
+		t.Errorf("drop to zero in initing: accepted %q hold %v, want 5 true", accepted, hold)
+	}
+	// dropping to zero in reloading is held
+	if hold = reloadConfig(4, newMinSwapFeeChange("5", "0")); !hold {
+		t.Errorf("drop to zero in reloading is not held")
+	}
+}
+
+func TestRouterContractRevertsWhileDown(t *testing.T) {
+	store := useMemConfigChangeStore(t)
+	const routerA, routerB = "0xaaaa", "0xbbbb"
+
+	if _, hold := initConfig(1, newRouterContractChange("", routerA)); hold {
+		t.Fatal("first seen value is held")
+	}
+	if _, hold := initConfig(2, newRouterContractChange("", routerA)); hold {
+		t.Fatal("accepted value is held")
+	}
+
+	// A -> B is held until admin confirms
+	change := newRouterContractChange(routerA, routerB)
+	if hold := reloadConfig(3, change); !hold {
+		t.Fatal("dangerous change is not held")
+	}
+	store[change.Key].Status = mongodb.ConfigChangeConfirmed
+	if hold := reloadConfig(4, newRouterContractChange(routerA, routerB)); hold {
+		t.Fatal("confirmed change is held")
+	}
+
+	// B -> A while down is checked against B, not the accepted A long ago
+	accepted, hold := initConfig(5, newRouterContractChange("", routerA))
+	if !hold || accepted != routerB {
+		t.Errorf("revert in initing: accepted %q hold %v, want %v true", accepted, hold, routerB)
+	}
+	if _, hold = initConfig(6, newRouterContractChange("", routerB)); hold {
+		t.Errorf("latest accepted value is held")
+	}
+
+	// admin confirms the revert
+	store[getConfigChangeKey(newRouterContractChange("", routerA))].Status = mongodb.ConfigChangeConfirmed
+	if _, hold = initConfig(7, newRouterContractChange("", routerA)); hold {
+		t.Errorf("confirmed value is held")
+	}
+	last, err := store.FindLastAccepted(newRouterContractChange("", ""))
+	if err != nil || last.New != routerA {
+		t.Errorf("last accepted value = %+v (err %v), want %v", last, err, routerA)
+	}
+}
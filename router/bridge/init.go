@@ -34,6 +34,24 @@ func setRouterInfoLoaded(chainID, routerContract string) {
 	routerInfoIsLoaded.Store(key, struct{}{})
 }
 
+// isTokenIDsRequired token IDs are required by erc20 and nft swap types,
+// anycall swap type does not need tokens, but if it runs along with
+// the erc20 or nft swap type, the empty token IDs is still a config error.
+func isTokenIDsRequired() bool {
+	return tokens.IsERC20Router() || tokens.IsNFTRouter()
+}
+
+// isOfflineSignSupported check the chain can be signed offline if offline sign is enabled,
+// otherwise its swaps are stuck as mpc sign fails in offline sign mode
+func isOfflineSignSupported(bridge tokens.IBridge, chainID string) bool {
+	mpcConfig := params.GetMPCConfig(params.IsUseFastMPC(chainID))
+	if mpcConfig == nil || !mpcConfig.SignOffline {
+		return true
+	}
+	signer, ok := bridge.(tokens.IOfflineSigner)
+	return ok && signer.IsOfflineSignSupported()
+}
+
 // InitRouterBridges init router bridges
 //
 //nolint:funlen,gocyclo // ok
@@ -51,6 +69,13 @@ func InitRouterBridges(isServer bool) {
 
 	router.InitRouterConfigClients()
 
+	router.BeginConfigSnapshot(true)
+	beginConfigDiff(true)
+	defer func() {
+		endConfigDiff()
+		router.EndConfigSnapshot(success)
+	}()
+
 	log.Info("start get all chain ids")
 	allChainIDs, err := router.GetAllChainIDs()
 	if err != nil {
@@ -88,7 +113,7 @@ func InitRouterBridges(isServer bool) {
 		tokenIDs = append(tokenIDs, tokenID)
 	}
 	log.Info("get all token ids success", "tokenIDs", tokenIDs)
-	if len(tokenIDs) == 0 && !tokens.IsAnyCallRouter() {
+	if len(tokenIDs) == 0 && isTokenIDsRequired() {
 		logErrFunc("empty token IDs")
 		return
 	}
@@ -110,6 +135,11 @@ func InitRouterBridges(isServer bool) {
 			AdjustGatewayOrder(bridge, chainID.String())
 			InitChainConfig(bridge, chainID)
 
+			if isServer && !isOfflineSignSupported(bridge, chainID.String()) {
+				logErrFunc("chain does not support offline sign", "chainID", chainID)
+				return
+			}
+
 			bridge.InitAfterConfig()
 			router.SetBridge(chainID.String(), bridge)
 
@@ -262,6 +292,7 @@ func loadSwapConfigs(wg *sync.WaitGroup, swapConfigs *sync.Map, tokenID string,
 			if !exist {
 				continue
 			}
+			recordSwapChanges(tokenID, fromChainID.String(), toChainID.String(), swapCfg)
 			innerMap.Store(toChainID.String(), swapCfg)
 		}
 	}
@@ -333,6 +364,10 @@ func loadFeeConfigs(wg *sync.WaitGroup, feeConfigs *sync.Map, tokenID string, su
 			if !exist {
 				continue
 			}
+			if oldFeeCfg := recordFeeChanges(tokenID, fromChainID.String(), toChainID.String(), feeCfg); oldFeeCfg != nil {
+				log.Warn("hold fee config change until admin confirms", "tokenID", tokenID, "fromChainID", fromChainID, "toChainID", toChainID)
+				feeCfg = oldFeeCfg
+			}
 			innerMap.Store(toChainID.String(), feeCfg)
 		}
 	}
@@ -388,6 +423,10 @@ func InitChainConfig(b tokens.IBridge, chainID *big.Int) {
 		logErrFunc("check chain config failed", "chainID", chainID, "err", err)
 		return
 	}
+	if recordChainChanges(chainID.String(), b.GetChainConfig(), chainCfg) {
+		log.Warn("hold chain config change until admin confirms", "chainID", chainID)
+		return
+	}
 	b.SetChainConfig(chainCfg)
 	log.Info("init chain config success", "blockChain", chainCfg.BlockChain, "chainID", chainID, "isReload", isReload, "chainCfg", chainCfg)
 
@@ -401,6 +440,25 @@ func InitChainConfig(b tokens.IBridge, chainID *big.Int) {
 			return
 		}
 	}
+
+	// router contracts of nft and anycall swap types in a multiple swap types router
+	for _, swapType := range []tokens.SwapType{tokens.NFTSwapType, tokens.AnyCallSwapType} {
+		routerContract = tokens.GetSwapTypeRouterContract(swapType, chainID.String())
+		if routerContract == "" || !tokens.IsSwapTypeEnabled(swapType) || isRouterInfoLoaded(chainID.String(), routerContract) {
+			continue
+		}
+		routerVersion := ""
+		if swapType == tokens.AnyCallSwapType {
+			routerVersion = params.GetSwapSubType()
+		}
+		err = b.InitRouterInfo(routerContract, routerVersion)
+		if err == nil {
+			setRouterInfoLoaded(chainID.String(), routerContract)
+		} else {
+			logErrFunc("init swap type router info failed", "chainID", chainID, "swapType", swapType.String(), "routerContract", routerContract, "err", err)
+			return
+		}
+	}
 }
 
 // InitTokenConfig impl
@@ -439,10 +497,22 @@ func InitTokenConfig(b tokens.IBridge, tokenID string, chainID *big.Int) {
 		logErrFunc("verify token ID mismatch", "chainID", chainID, "inconfig", tokenCfg.TokenID, "intokenids", tokenID)
 		return
 	}
-	if err = tokenCfg.CheckConfig(); err != nil {
+	routerContract := tokenCfg.RouterContract
+	if routerContract == "" {
+		routerContract = b.GetChainConfig().RouterContract
+	}
+	if err = tokenCfg.CheckConfig(tokens.GetTokenSwapType(chainID.String(), routerContract)); err != nil {
 		logErrFunc("check token config failed", "tokenID", tokenID, "chainID", chainID, "tokenAddr", tokenAddr, "err", err)
 		return
 	}
+	var oldTokenCfg *tokens.TokenConfig
+	if oldTokenAddr := router.GetCachedMultichainToken(tokenID, chainID.String()); oldTokenAddr != "" {
+		oldTokenCfg = b.GetTokenConfig(oldTokenAddr)
+	}
+	if recordTokenChanges(chainID.String(), tokenID, oldTokenCfg, tokenCfg) {
+		log.Warn("hold token config change until admin confirms", "tokenID", tokenID, "chainID", chainID)
+		return
+	}
 	router.InitOnchainCustomConfig(chainID, tokenID)
 	b.SetTokenConfig(tokenAddr, tokenCfg)
 
@@ -450,7 +520,7 @@ func InitTokenConfig(b tokens.IBridge, tokenID string, chainID *big.Int) {
 
 	log.Info(fmt.Sprintf("[%5v] init '%v' token config success", chainID, tokenID), "tokenAddr", tokenAddr, "decimals", tokenCfg.Decimals, "isReload", isReload, "tokenCfg", tokenCfg)
 
-	routerContract := tokenCfg.RouterContract
+	routerContract = tokenCfg.RouterContract
 	if routerContract != "" && !isRouterInfoLoaded(chainID.String(), routerContract) {
 		err = b.InitRouterInfo(routerContract, tokenCfg.RouterVersion)
 		if err == nil {
@@ -0,0 +1,35 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// anycall swap type does not need tokens, but erc20 and nft swap types do,
+// even if they run along with anycall swap type
+func TestIsTokenIDsRequired(t *testing.T) {
+	config := params.GetRouterConfig()
+	oldSubType := config.SwapSubType
+	config.SwapSubType = tokens.AnycallSubTypeV7
+	defer func() { config.SwapSubType = oldSubType }()
+
+	tests := []struct {
+		swapTypes []string
+		want      bool
+	}{
+		{[]string{"erc20swap"}, true},
+		{[]string{"nftswap"}, true},
+		{[]string{"anycallswap"}, false},
+		{[]string{"anycallswap", "erc20swap"}, true},
+		{[]string{"erc20swap", "anycallswap"}, true},
+		{[]string{"anycallswap", "nftswap"}, true},
+	}
+	for i, tt := range tests {
+		tokens.InitRouterSwapTypes(tt.swapTypes)
+		if have := isTokenIDsRequired(); have != tt.want {
+			t.Errorf("case %d: isTokenIDsRequired of %v = %v, want %v", i, tt.swapTypes, have, tt.want)
+		}
+	}
+}
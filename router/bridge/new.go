@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
 	"github.com/deltaswapio/swaprouter/v3/tokens/aptos"
 	"github.com/deltaswapio/swaprouter/v3/tokens/btc"
@@ -20,6 +21,45 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/tokens/tron"
 )
 
+func init() {
+	params.IsConfirmationTiersSupported = supportsConfirmationTiers
+	params.IsL2FinalitySupported = isEthBridgeChain
+}
+
+// supportsConfirmationTiers only the eth and tron bridges verify the confirmation tiers
+func supportsConfirmationTiers(chainID string) bool {
+	cid, ok := new(big.Int).SetString(chainID, 0)
+	if !ok || cid.Sign() <= 0 {
+		return false
+	}
+	return tron.SupportsChainID(cid) || isEthBridgeChain(chainID)
+}
+
+// isEthBridgeChain is chain served by the eth bridge (the default bridge)
+func isEthBridgeChain(chainID string) bool {
+	cid, ok := new(big.Int).SetString(chainID, 0)
+	if !ok || cid.Sign() <= 0 {
+		return false
+	}
+	switch {
+	case tron.SupportsChainID(cid),
+		reef.SupportsChainID(cid),
+		solana.SupportChainID(cid),
+		cosmos.SupportsChainID(cid),
+		btc.SupportsChainID(cid),
+		cardano.SupportsChainID(cid),
+		aptos.SupportsChainID(cid),
+		near.SupportsChainID(cid),
+		iota.SupportsChainID(cid),
+		ripple.SupportsChainID(cid),
+		stellar.SupportsChainID(cid),
+		flow.SupportsChainID(cid):
+		return false
+	default:
+		return true // eth bridge
+	}
+}
+
 // NewCrossChainBridge new bridge
 func NewCrossChainBridge(chainID *big.Int) tokens.IBridge {
 	switch {
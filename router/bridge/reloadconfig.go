@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
 	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
@@ -83,6 +84,13 @@ func ReloadRouterConfig() (success bool) {
 	// reload local config
 	params.ReloadRouterConfig()
 
+	router.BeginConfigSnapshot(false)
+	beginConfigDiff(false)
+	defer func() {
+		endConfigDiff()
+		router.EndConfigSnapshot(success)
+	}()
+
 	allChainIDs, err := router.GetAllChainIDs()
 	if err != nil {
 		log.Error("[reload] call GetAllChainIDs failed", "err", err)
@@ -135,6 +143,7 @@ func ReloadRouterConfig() (success bool) {
 				log.Info("[reload] add new bridge", "chainID", chainID)
 				bridge = NewCrossChainBridge(chainID)
 				isNewBridge = true
+				recordConfigChange(&mongodb.MgoConfigChange{Kind: ChainAdded, ChainID: chainID.String()})
 			}
 
 			log.Info("[reload] set chain config", "chainID", chainID)
@@ -185,6 +194,9 @@ func ReloadRouterConfig() (success bool) {
 	if len(removedChainIDs) > 0 {
 		log.Info("[reload] remove chain ids", "removedChainIDs", removedChainIDs)
 	}
+	for _, chainID := range removedChainIDs {
+		recordConfigChange(&mongodb.MgoConfigChange{Kind: ChainRemoved, ChainID: chainID})
+	}
 
 	removedTokenIDs := make([]string, 0)
 	for _, tokenID := range oldTokenIDs {
@@ -202,6 +214,9 @@ func ReloadRouterConfig() (success bool) {
 	if len(removedTokenIDs) > 0 {
 		log.Info("[reload] remove token ids", "removedTokenIDs", removedTokenIDs)
 	}
+	for _, tokenID := range removedTokenIDs {
+		recordConfigChange(&mongodb.MgoConfigChange{Kind: TokenRemoved, TokenID: tokenID})
+	}
 
 	// get rid of removed token configs
 	for _, chainID := range oldChainIDs {
@@ -0,0 +1,323 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+)
+
+// ConfigSnapshotVersion version of config snapshot format
+const ConfigSnapshotVersion = 1
+
+var (
+	snapshotLock     sync.Mutex
+	snapshotRecorder *ConfigSnapshot // record calls in initing or reloading
+	snapshotInUse    *ConfigSnapshot // load the whole config from snapshot in initing
+	loadedSnapshot   *ConfigSnapshot // latest snapshot loaded or saved
+	isConfigStale    bool
+
+	errConfigCallNotInSnapshot = errors.New("config call is not found in snapshot")
+)
+
+// ConfigSnapshot local snapshot of onchain router config
+type ConfigSnapshot struct {
+	Version     int
+	Contract    string
+	BlockNumber uint64            // block number of config chain when taken
+	Timestamp   int64             // unix time when taken
+	Calls       map[string]string // key is call data, value is call result
+}
+
+// ConfigSnapshotDiff diff of a config call between snapshot and contract
+type ConfigSnapshotDiff struct {
+	Method   string
+	CallData string
+	Snapshot interface{}
+	Contract interface{}
+}
+
+// IsConfigStale is router config loaded from local snapshot
+func IsConfigStale() bool {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	return isConfigStale
+}
+
+// GetConfigSnapshotBlock get block number of the snapshot in use (0 if not using)
+func GetConfigSnapshotBlock() uint64 {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	if !isConfigStale || loadedSnapshot == nil {
+		return 0
+	}
+	return loadedSnapshot.BlockNumber
+}
+
+// BeginConfigSnapshot begin recording config calls (in initing or reloading).
+// if the config chain is unreachable in initing, the whole config
+// is loaded from the latest local snapshot instead of the contract.
+// there is no fallback in reloading, the reloading fails then.
+func BeginConfigSnapshot(isInit bool) {
+	dir := params.GetConfigSnapshotDir()
+	if dir == "" {
+		return
+	}
+	blockNumber, err := GetConfigBlockNumber()
+	for i := 0; err != nil && isInit && i < RetryRPCCountInInit; i++ {
+		log.Warn("retry get config block number failed", "times", i+1, "err", err)
+		time.Sleep(RetryRPCIntervalInInit)
+		blockNumber, err = GetConfigBlockNumber()
+	}
+	if err != nil {
+		log.Warn("get config block number failed", "err", err)
+		if isInit {
+			useConfigSnapshot(dir)
+		}
+		return
+	}
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	snapshotRecorder = &ConfigSnapshot{
+		Version:     ConfigSnapshotVersion,
+		Contract:    strings.ToLower(routerConfigContract.String()),
+		BlockNumber: blockNumber,
+		Timestamp:   time.Now().Unix(),
+		Calls:       make(map[string]string),
+	}
+}
+
+func useConfigSnapshot(dir string) {
+	snapshot, err := LoadLatestConfigSnapshot(dir)
+	if err != nil {
+		log.Warn("load latest config snapshot failed", "err", err)
+		return
+	}
+	if !strings.EqualFold(snapshot.Contract, routerConfigContract.String()) {
+		log.Warn("config snapshot contract mismatch", "have", snapshot.Contract, "want", routerConfigContract.String())
+		return
+	}
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	snapshotInUse = snapshot
+	loadedSnapshot = snapshot
+	log.Warn("config chain is unreachable, load router config from local snapshot", "snapshotBlock", snapshot.BlockNumber, "snapshotTime", snapshot.Timestamp)
+}
+
+// EndConfigSnapshot end recording config calls,
+// save snapshot if loading from the contract success
+func EndConfigSnapshot(success bool) {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	recorder, inUse := snapshotRecorder, snapshotInUse
+	snapshotRecorder, snapshotInUse = nil, nil
+	if !success {
+		return
+	}
+	if inUse != nil {
+		isConfigStale = true
+		log.Warn("router config is loaded from stale snapshot", "snapshotBlock", inUse.BlockNumber, "snapshotTime", inUse.Timestamp)
+		return
+	}
+	isConfigStale = false
+	if recorder == nil {
+		return
+	}
+	if err := saveConfigSnapshot(recorder); err != nil {
+		log.Warn("save config snapshot failed", "blockNumber", recorder.BlockNumber, "err", err)
+		return
+	}
+	loadedSnapshot = recorder
+	log.Info("save config snapshot success", "blockNumber", recorder.BlockNumber, "calls", len(recorder.Calls))
+}
+
+func recordConfigCall(data, result []byte) {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	if snapshotRecorder != nil {
+		snapshotRecorder.Calls[hexutil.Encode(data)] = hexutil.Encode(result)
+	}
+}
+
+// getConfigCallFromSnapshot get config call result from the snapshot in use,
+// inUse is false if config is not loading from snapshot
+func getConfigCallFromSnapshot(data []byte) (result []byte, inUse bool, err error) {
+	snapshotLock.Lock()
+	defer snapshotLock.Unlock()
+	if snapshotInUse == nil {
+		return nil, false, nil
+	}
+	res, exist := snapshotInUse.Calls[hexutil.Encode(data)]
+	if !exist {
+		return nil, true, errConfigCallNotInSnapshot
+	}
+	return common.FromHex(res), true, nil
+}
+
+// GetConfigBlockNumber get latest block number of config chain
+func GetConfigBlockNumber() (blockNumber uint64, err error) {
+	for _, cli := range routerConfigClients {
+		blockNumber, err = cli.BlockNumber(routerConfigCtx)
+		if err == nil {
+			return blockNumber, nil
+		}
+	}
+	return 0, err
+}
+
+func getConfigSnapshotFileName(blockNumber uint64) string {
+	return fmt.Sprintf("snapshot-%020d.json", blockNumber)
+}
+
+func saveConfigSnapshot(snapshot *ConfigSnapshot) error {
+	onchainCfg := params.GetRouterConfig().Onchain
+	dir := onchainCfg.SnapshotDir
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	fileName := filepath.Join(dir, getConfigSnapshotFileName(snapshot.BlockNumber))
+	tmpFile := fileName + ".tmp"
+	if err = os.WriteFile(tmpFile, data, 0o600); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpFile, fileName); err != nil {
+		return err
+	}
+
+	// get rid of old snapshots
+	files, err := getConfigSnapshotFiles(dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > int(onchainCfg.KeepSnapshots) {
+		if err = os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// getConfigSnapshotFiles get snapshot files in ascending order of block number
+func getConfigSnapshotFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, params.ConfigSnapshotFilePattern))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// LoadConfigSnapshot load config snapshot from file
+func LoadConfigSnapshot(fileName string) (*ConfigSnapshot, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot ConfigSnapshot
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	if snapshot.Version != ConfigSnapshotVersion {
+		return nil, fmt.Errorf("unsupported config snapshot version %v", snapshot.Version)
+	}
+	return &snapshot, nil
+}
+
+// LoadLatestConfigSnapshot load the latest config snapshot in dir
+func LoadLatestConfigSnapshot(dir string) (*ConfigSnapshot, error) {
+	files, err := getConfigSnapshotFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no config snapshot found")
+	}
+	return LoadConfigSnapshot(files[len(files)-1])
+}
+
+type configCallDecoder struct {
+	method string
+	decode func([]byte) (interface{}, error)
+}
+
+func decodeString(data []byte) (interface{}, error) {
+	return abicoder.ParseStringInData(data, 0)
+}
+
+var configCallDecoders = map[string]*configCallDecoder{
+	"0x19ed16dc": {"getChainConfig", func(data []byte) (interface{}, error) { return parseChainConfig(data) }},
+	"0x459511d1": {"getTokenConfig", func(data []byte) (interface{}, error) { return parseTokenConfig(data) }},
+	"0x4da7163c": {"getSwapConfig", func(data []byte) (interface{}, error) { return parseSwapConfig(data) }},
+	"0x1aed1c97": {"getFeeConfig", func(data []byte) (interface{}, error) { return parseFeeConfig(data) }},
+	"0x3c6b1a8f": {"getSwapConfigs", func(data []byte) (interface{}, error) { return parseSwapConfigs(data) }},
+	"0x6a3ea04f": {"getFeeConfigs", func(data []byte) (interface{}, error) { return parseFeeConfigs(data) }},
+	"0x61387d61": {"getCustomConfig", decodeString},
+	"0x340a5f2d": {"getExtraConfig", decodeString},
+	"0x9f1cdedd": {"getMPCPubkey", decodeString},
+	"0xb735ab5a": {"getMultichainToken", decodeString},
+	"0xe27112d5": {"getAllChainIDs", func(data []byte) (interface{}, error) { return abicoder.ParseNumberSliceAsBigIntsInData(data, 0) }},
+	"0x684a10b3": {"getAllTokenIDs", func(data []byte) (interface{}, error) { return abicoder.ParseStringSliceInData(data, 0) }},
+}
+
+func decodeConfigCallResult(decoder *configCallDecoder, result []byte) interface{} {
+	if decoder != nil {
+		if res, err := decoder.decode(result); err == nil {
+			return res
+		}
+	}
+	return hexutil.Bytes(result)
+}
+
+// DiffConfigSnapshot diff config snapshot with the onchain contract
+func DiffConfigSnapshot(snapshot *ConfigSnapshot) ([]*ConfigSnapshotDiff, error) {
+	callDatas := make([]string, 0, len(snapshot.Calls))
+	for callData := range snapshot.Calls {
+		callDatas = append(callDatas, callData)
+	}
+	sort.Strings(callDatas)
+
+	diffs := make([]*ConfigSnapshotDiff, 0)
+	for _, callData := range callDatas {
+		data := common.FromHex(callData)
+		oldResult := common.FromHex(snapshot.Calls[callData])
+		newResult, err := CallOnchainContract(data, "latest")
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(oldResult, newResult) {
+			continue
+		}
+		method := "unknown"
+		var decoder *configCallDecoder
+		if len(callData) >= 10 {
+			decoder = configCallDecoders[callData[:10]]
+		}
+		if decoder != nil {
+			method = decoder.method
+		}
+		diffs = append(diffs, &ConfigSnapshotDiff{
+			Method:   method,
+			CallData: callData,
+			Snapshot: decodeConfigCallResult(decoder, oldResult),
+			Contract: decodeConfigCallResult(decoder, newResult),
+		})
+	}
+	return diffs, nil
+}
@@ -0,0 +1,266 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+const testConfigContract = "0x1111111111111111111111111111111111111111"
+
+var (
+	testConfigCallA = common.FromHex("0x12345678")
+	testConfigCallB = common.FromHex("0x87654321")
+	// getAllChainIDs
+	testConfigCallChainIDs = common.FromHex("0xe27112d5")
+)
+
+// fakeConfigNode is a fake node of config chain
+type fakeConfigNode struct {
+	lock        sync.Mutex
+	down        bool
+	blockNumber uint64
+	results     map[string][]byte // key is call data
+}
+
+func (n *fakeConfigNode) set(blockNumber uint64, results map[string][]byte) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.blockNumber = blockNumber
+	n.results = results
+}
+
+func (n *fakeConfigNode) setDown(down bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.down = down
+}
+
+func (n *fakeConfigNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.down {
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	var req struct {
+		ID     json.RawMessage
+		Method string
+		Params []json.RawMessage
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var result interface{}
+	switch req.Method {
+	case "eth_blockNumber":
+		result = hexutil.Uint64(n.blockNumber)
+	case "eth_call":
+		var msg struct {
+			Data  hexutil.Bytes `json:"data"`
+			Input hexutil.Bytes `json:"input"`
+		}
+		_ = json.Unmarshal(req.Params[0], &msg)
+		data := msg.Input
+		if len(data) == 0 {
+			data = msg.Data
+		}
+		res, exist := n.results[hexutil.Encode(data)]
+		if !exist {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":3,"message":"execution reverted"}}`, req.ID)
+			return
+		}
+		result = hexutil.Bytes(res)
+	default:
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+		return
+	}
+	resp, _ := json.Marshal(result)
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, resp)
+}
+
+func setConfigSnapshotTestEnv(t *testing.T, keepSnapshots uint64) (node *fakeConfigNode, dir string) {
+	t.Helper()
+	node = &fakeConfigNode{}
+	srv := httptest.NewServer(node)
+	t.Cleanup(srv.Close)
+	InitRouterConfigClientsWithArgs(testConfigContract, []string{srv.URL})
+
+	dir = t.TempDir()
+	cfg := params.GetRouterConfig()
+	oldOnchain, oldInterval := cfg.Onchain, RetryRPCIntervalInInit
+	cfg.Onchain = &params.OnchainConfig{Contract: testConfigContract, SnapshotDir: dir, KeepSnapshots: keepSnapshots}
+	RetryRPCIntervalInInit = 0
+	t.Cleanup(func() {
+		cfg.Onchain, RetryRPCIntervalInInit = oldOnchain, oldInterval
+		snapshotRecorder, snapshotInUse, loadedSnapshot = nil, nil, nil
+		isConfigStale = false
+	})
+	return node, dir
+}
+
+// loadTestConfig load config calls in initing or reloading
+func loadTestConfig(isInit bool, calls ...[]byte) (results [][]byte, err error) {
+	success := false
+	BeginConfigSnapshot(isInit)
+	defer func() { EndConfigSnapshot(success) }()
+	for _, data := range calls {
+		res, errc := CallOnchainContract(data, "latest")
+		if errc != nil {
+			return nil, errc
+		}
+		results = append(results, res)
+	}
+	success = true
+	return results, nil
+}
+
+func TestConfigSnapshotSaveAndRotation(t *testing.T) {
+	node, dir := setConfigSnapshotTestEnv(t, 2)
+
+	for block := uint64(1); block <= 3; block++ {
+		result := []byte{byte(block)}
+		node.set(block, map[string][]byte{hexutil.Encode(testConfigCallA): result})
+		if _, err := loadTestConfig(block == 1, testConfigCallA); err != nil {
+			t.Fatalf("block %d: load config failed: %v", block, err)
+		}
+		snapshot, err := LoadLatestConfigSnapshot(dir)
+		if err != nil {
+			t.Fatalf("block %d: load latest snapshot failed: %v", block, err)
+		}
+		if snapshot.BlockNumber != block || snapshot.Calls[hexutil.Encode(testConfigCallA)] != hexutil.Encode(result) {
+			t.Errorf("block %d: wrong saved snapshot %+v", block, snapshot)
+		}
+	}
+	files, _ := getConfigSnapshotFiles(dir)
+	want := []string{
+		filepath.Join(dir, getConfigSnapshotFileName(2)),
+		filepath.Join(dir, getConfigSnapshotFileName(3)),
+	}
+	if fmt.Sprint(files) != fmt.Sprint(want) {
+		t.Errorf("snapshot files = %v, want %v", files, want)
+	}
+
+	// failed loading is not saved
+	node.set(4, map[string][]byte{hexutil.Encode(testConfigCallA): {4}})
+	if _, err := loadTestConfig(false, testConfigCallA, testConfigCallB); err == nil {
+		t.Fatal("load config with reverted call succeed")
+	}
+	if snapshot, _ := LoadLatestConfigSnapshot(dir); snapshot.BlockNumber != 3 {
+		t.Errorf("latest snapshot block = %v after failed loading, want 3", snapshot.BlockNumber)
+	}
+	if IsConfigStale() {
+		t.Error("config loaded from contract is stale")
+	}
+}
+
+func TestConfigSnapshotFallback(t *testing.T) {
+	node, _ := setConfigSnapshotTestEnv(t, 10)
+	node.set(5, map[string][]byte{hexutil.Encode(testConfigCallA): {5}})
+	if _, err := loadTestConfig(true, testConfigCallA); err != nil {
+		t.Fatal(err)
+	}
+	node.set(6, map[string][]byte{hexutil.Encode(testConfigCallA): {6}})
+
+	// the whole config is loaded from snapshot in initing
+	node.setDown(true)
+	results, err := loadTestConfig(true, testConfigCallA)
+	if err != nil || len(results) != 1 || results[0][0] != 5 {
+		t.Fatalf("load config from snapshot = %v (err %v), want [[5]]", results, err)
+	}
+	if !IsConfigStale() || GetConfigSnapshotBlock() != 5 {
+		t.Errorf("stale %v snapshot block %v, want true 5", IsConfigStale(), GetConfigSnapshotBlock())
+	}
+	if _, err = loadTestConfig(true, testConfigCallA, testConfigCallB); !errors.Is(err, errConfigCallNotInSnapshot) {
+		t.Errorf("load call not in snapshot error = %v, want %v", err, errConfigCallNotInSnapshot)
+	}
+
+	// no fallback at runtime and in reloading
+	if _, err = CallOnchainContract(testConfigCallA, "latest"); err == nil {
+		t.Error("call contract at runtime fallback to snapshot")
+	}
+	if _, err = loadTestConfig(false, testConfigCallA); err == nil {
+		t.Error("reload config fallback to snapshot")
+	}
+	if !IsConfigStale() {
+		t.Error("config is not stale after failed reloading")
+	}
+
+	// no mixing of contract and snapshot results in one loading
+	node.setDown(false)
+	BeginConfigSnapshot(true)
+	node.setDown(true)
+	if _, err = CallOnchainContract(testConfigCallA, "latest"); err == nil {
+		t.Error("call contract in loading from contract fallback to snapshot")
+	}
+	EndConfigSnapshot(false)
+
+	// reloading from contract clears staleness
+	node.setDown(false)
+	results, err = loadTestConfig(false, testConfigCallA)
+	if err != nil || results[0][0] != 6 {
+		t.Fatalf("reload config = %v (err %v), want [[6]]", results, err)
+	}
+	if IsConfigStale() || GetConfigSnapshotBlock() != 0 {
+		t.Errorf("stale %v snapshot block %v after reloading, want false 0", IsConfigStale(), GetConfigSnapshotBlock())
+	}
+}
+
+func TestDiffConfigSnapshot(t *testing.T) {
+	node, _ := setConfigSnapshotTestEnv(t, 10)
+
+	encodeChainIDs := func(chainIDs ...int64) []byte {
+		data := common.LeftPadBytes(big.NewInt(32).Bytes(), 32)
+		data = append(data, common.LeftPadBytes(big.NewInt(int64(len(chainIDs))).Bytes(), 32)...)
+		for _, chainID := range chainIDs {
+			data = append(data, common.LeftPadBytes(big.NewInt(chainID).Bytes(), 32)...)
+		}
+		return data
+	}
+	snapshot := &ConfigSnapshot{
+		Version: ConfigSnapshotVersion,
+		Calls: map[string]string{
+			hexutil.Encode(testConfigCallA):        "0x01",
+			hexutil.Encode(testConfigCallB):        "0x02",
+			hexutil.Encode(testConfigCallChainIDs): hexutil.Encode(encodeChainIDs(1, 56)),
+		},
+	}
+	node.set(10, map[string][]byte{
+		hexutil.Encode(testConfigCallA):        {1},
+		hexutil.Encode(testConfigCallB):        {3},
+		hexutil.Encode(testConfigCallChainIDs): encodeChainIDs(1, 56, 137),
+	})
+
+	diffs, err := DiffConfigSnapshot(snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 2 {
+		t.Fatalf("diff count = %v, want 2", len(diffs))
+	}
+	if diffs[0].Method != "unknown" || diffs[0].CallData != hexutil.Encode(testConfigCallB) ||
+		fmt.Sprint(diffs[0].Snapshot) != "0x02" || fmt.Sprint(diffs[0].Contract) != "0x03" {
+		t.Errorf("wrong diff of unknown call %+v", diffs[0])
+	}
+	if diffs[1].Method != "getAllChainIDs" ||
+		fmt.Sprint(diffs[1].Snapshot) != "[1 56]" || fmt.Sprint(diffs[1].Contract) != "[1 56 137]" {
+		t.Errorf("wrong diff of getAllChainIDs %+v", diffs[1])
+	}
+
+	// diff needs the contract
+	node.setDown(true)
+	if _, err = DiffConfigSnapshot(snapshot); err == nil {
+		t.Error("diff snapshot with unreachable contract succeed")
+	}
+}
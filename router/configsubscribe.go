@@ -0,0 +1,286 @@
+package router
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethclient "github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	// topic of event 'UpdateConfig()'
+	updateConfigTopic = ethcommon.HexToHash("0x22590461e7ba17e1fe7580cb0ea47f283d3b2248f04873dfbe926d08fe4c5ab9")
+
+	// router config is up to date as of this block (the last scanned head)
+	latestUpdateConfigBlock uint64
+	// block of the latest 'UpdateConfig' event which router config is reloaded for
+	reloadedUpdateConfigBlock uint64
+
+	updateConfigCh  = make(chan ethtypes.Log, 16)
+	wsSubscribers   []*wsSubscriber
+	isPollingConfig int32
+
+	configPollInterval           = 60 * time.Second
+	maxResubscribeBackoff        = 60 * time.Second
+	wsDialTimeout                = 10 * time.Second
+	maxFilterLogsRange    uint64 = 5000
+)
+
+// WSSubscriberHealth health of web socket subscriber
+type WSSubscriberHealth struct {
+	Index         int
+	Healthy       bool
+	Reconnects    uint64
+	LastError     string `json:",omitempty"`
+	LastConnected int64  `json:",omitempty"`
+}
+
+// ConfigSubscriptionHealth health of 'UpdateConfig' event subscription
+type ConfigSubscriptionHealth struct {
+	WebSockets              []*WSSubscriberHealth
+	Polling                 bool // fallback to polling as no healthy web socket
+	LatestUpdateConfigBlock uint64
+}
+
+type wsSubscriber struct {
+	lock   sync.RWMutex
+	health WSSubscriberHealth
+	server string
+}
+
+// GetConfigSubscriptionHealth get health of 'UpdateConfig' event subscription
+func GetConfigSubscriptionHealth() *ConfigSubscriptionHealth {
+	if len(wsSubscribers) == 0 {
+		return nil
+	}
+	result := &ConfigSubscriptionHealth{
+		WebSockets:              make([]*WSSubscriberHealth, 0, len(wsSubscribers)),
+		Polling:                 atomic.LoadInt32(&isPollingConfig) != 0,
+		LatestUpdateConfigBlock: atomic.LoadUint64(&latestUpdateConfigBlock),
+	}
+	for _, s := range wsSubscribers {
+		s.lock.RLock()
+		health := s.health
+		s.lock.RUnlock()
+		result.WebSockets = append(result.WebSockets, &health)
+	}
+	return result
+}
+
+// SubscribeUpdateConfig subscribe 'UpdateConfig' event and reload configs.
+// resubscribe with backoff if web socket drops, backfill missed events,
+// and fallback to polling if there is no healthy web socket.
+func SubscribeUpdateConfig(callback func() bool) {
+	wsServers := params.GetRouterConfig().Onchain.WSServers
+	if len(wsServers) == 0 {
+		return
+	}
+	if blockNumber, err := GetConfigBlockNumber(); err == nil {
+		atomic.StoreUint64(&latestUpdateConfigBlock, blockNumber)
+		atomic.StoreUint64(&reloadedUpdateConfigBlock, blockNumber)
+	}
+	wsSubscribers = make([]*wsSubscriber, len(wsServers))
+	for i, wsServer := range wsServers {
+		wsSubscribers[i] = &wsSubscriber{
+			health: WSSubscriberHealth{Index: i},
+			server: wsServer,
+		}
+	}
+	for _, s := range wsSubscribers {
+		go s.run()
+	}
+	go pollUpdateConfig()
+	processUpdateConfig(callback)
+}
+
+func processUpdateConfig(callback func() bool) {
+	for rlog := range updateConfigCh {
+		// sleep random in a second to mess steps
+		rNum, _ := rand.Int(rand.Reader, big.NewInt(1000))
+		time.Sleep(time.Duration(rNum.Uint64()) * time.Millisecond)
+
+		blockNumber := rlog.BlockNumber
+		oldBlock := atomic.LoadUint64(&reloadedUpdateConfigBlock)
+		if blockNumber > oldBlock {
+			atomic.StoreUint64(&reloadedUpdateConfigBlock, blockNumber)
+			log.Info("start reload router config", "oldBlock", oldBlock, "blockNumber", blockNumber, "timestamp", time.Now().Unix())
+			callback()
+		}
+	}
+}
+
+func getUpdateConfigFilterQuery() ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		Addresses: []ethcommon.Address{routerConfigContract},
+		Topics:    [][]ethcommon.Hash{{updateConfigTopic}},
+	}
+}
+
+func (s *wsSubscriber) run() {
+	backoff := time.Second
+	for {
+		connected, err := s.subscribe()
+		if err == nil {
+			err = errors.New("subscription is closed")
+		}
+		s.lock.Lock()
+		s.health.Healthy = false
+		s.health.LastError = err.Error()
+		s.health.Reconnects++
+		s.lock.Unlock()
+
+		if connected {
+			backoff = time.Second
+		}
+		log.Warn("subscribe 'UpdateConfig' event failed, will retry later", "index", s.health.Index, "backoff", backoff.String(), "err", err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxResubscribeBackoff {
+			backoff = maxResubscribeBackoff
+		}
+	}
+}
+
+// subscribe blocks until the subscription is dropped
+func (s *wsSubscriber) subscribe() (connected bool, err error) {
+	ctx, cancel := context.WithTimeout(routerConfigCtx, wsDialTimeout)
+	cli, err := ethclient.DialContext(ctx, s.server)
+	cancel()
+	if err != nil {
+		return false, err
+	}
+	defer cli.Close()
+
+	ch := make(chan ethtypes.Log)
+	sub, err := cli.SubscribeFilterLogs(routerConfigCtx, getUpdateConfigFilterQuery(), ch)
+	if err != nil {
+		return false, err
+	}
+	defer sub.Unsubscribe()
+
+	s.lock.Lock()
+	s.health.Healthy = true
+	s.health.LastConnected = time.Now().Unix()
+	s.lock.Unlock()
+	log.Info("subscribe 'UpdateConfig' event success", "index", s.health.Index)
+
+	// backfill the events missed in disconnection
+	if _, err = scanUpdateConfigLogs(cli, nextUpdateConfigBlock()); err != nil {
+		log.Warn("backfill 'UpdateConfig' event failed", "index", s.health.Index, "err", err)
+	}
+
+	for {
+		select {
+		case err = <-sub.Err():
+			return true, err
+		case rlog := <-ch:
+			updateConfigCh <- rlog
+			advanceUpdateConfigBlock(rlog.BlockNumber)
+		}
+	}
+}
+
+func hasHealthyWSSubscriber() bool {
+	for _, s := range wsSubscribers {
+		s.lock.RLock()
+		healthy := s.health.Healthy
+		s.lock.RUnlock()
+		if healthy {
+			return true
+		}
+	}
+	return false
+}
+
+func pollUpdateConfig() {
+	var nextBlock uint64
+	ticker := time.NewTicker(configPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if hasHealthyWSSubscriber() {
+			if atomic.CompareAndSwapInt32(&isPollingConfig, 1, 0) {
+				log.Info("web socket is healthy, stop polling 'UpdateConfig' event")
+			}
+			continue
+		}
+		if atomic.CompareAndSwapInt32(&isPollingConfig, 0, 1) {
+			log.Warn("no healthy web socket, fallback to polling 'UpdateConfig' event")
+			nextBlock = nextUpdateConfigBlock()
+		}
+		for _, cli := range routerConfigClients {
+			next, err := scanUpdateConfigLogs(cli, nextBlock)
+			nextBlock = next
+			if err == nil {
+				break
+			}
+			log.Warn("poll 'UpdateConfig' event failed", "nextBlock", nextBlock, "err", err)
+		}
+	}
+}
+
+// nextUpdateConfigBlock returns 0 if latest update config block is unknown
+func nextUpdateConfigBlock() uint64 {
+	if blockNumber := atomic.LoadUint64(&latestUpdateConfigBlock); blockNumber > 0 {
+		return blockNumber + 1
+	}
+	return 0
+}
+
+// advanceUpdateConfigBlock advance latest update config block if 'blockNumber' is newer
+func advanceUpdateConfigBlock(blockNumber uint64) {
+	for {
+		oldBlock := atomic.LoadUint64(&latestUpdateConfigBlock)
+		if blockNumber <= oldBlock ||
+			atomic.CompareAndSwapUint64(&latestUpdateConfigBlock, oldBlock, blockNumber) {
+			return
+		}
+	}
+}
+
+// scanUpdateConfigLogs scan 'UpdateConfig' logs since 'from' block,
+// returns the next block to scan. (only sync latest block if 'from' is 0)
+// latest update config block is advanced to the scanned head on success.
+func scanUpdateConfigLogs(cli *ethclient.Client, from uint64) (next uint64, err error) {
+	latest, err := cli.BlockNumber(routerConfigCtx)
+	if err != nil {
+		return from, err
+	}
+	if from == 0 {
+		advanceUpdateConfigBlock(latest)
+		return latest + 1, nil
+	}
+	fq := getUpdateConfigFilterQuery()
+	for start := from; start <= latest; start += maxFilterLogsRange {
+		end := start + maxFilterLogsRange - 1
+		if end > latest {
+			end = latest
+		}
+		fq.FromBlock = new(big.Int).SetUint64(start)
+		fq.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := cli.FilterLogs(routerConfigCtx, fq)
+		if err != nil {
+			return start, err
+		}
+		for _, rlog := range logs {
+			if !rlog.Removed {
+				updateConfigCh <- rlog
+			}
+		}
+		advanceUpdateConfigBlock(end)
+	}
+	if latest+1 > from {
+		return latest + 1, nil
+	}
+	return from, nil
+}
@@ -2,11 +2,9 @@ package router
 
 import (
 	"context"
-	"crypto/rand"
 	"errors"
 	"math/big"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"github.com/deltaswapio/swaprouter/v3/common"
@@ -18,43 +16,19 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
 )
 
 var (
-	routerConfigContract   ethcommon.Address
-	routerConfigClients    []*ethclient.Client
-	routerWebSocketClients []*ethclient.Client
-	routerConfigCtx        = context.Background()
-
-	channels   = make([]chan ethtypes.Log, 0, 3)
-	subscribes = make([]ethereum.Subscription, 0, 3)
-
-	// topic of event 'UpdateConfig()'
-	updateConfigTopic = ethcommon.HexToHash("0x22590461e7ba17e1fe7580cb0ea47f283d3b2248f04873dfbe926d08fe4c5ab9")
-
-	latestUpdateConfigBlock uint64
+	routerConfigContract ethcommon.Address
+	routerConfigClients  []*ethclient.Client
+	routerConfigCtx      = context.Background()
 )
 
 // InitRouterConfigClients init router config clients
 func InitRouterConfigClients() {
 	onchainCfg := params.GetRouterConfig().Onchain
 	InitRouterConfigClientsWithArgs(onchainCfg.Contract, onchainCfg.APIAddress)
-	routerWebSocketClients = InitWebSocketClients(onchainCfg.WSServers)
-}
-
-// InitWebSocketClients init
-func InitWebSocketClients(wsServers []string) []*ethclient.Client {
-	var err error
-	wsClients := make([]*ethclient.Client, len(wsServers))
-	for i, wsServer := range wsServers {
-		wsClients[i], err = ethclient.Dial(wsServer)
-		if err != nil {
-			log.Fatal("init router config web socket clients failed", "wsServer", wsServer, "err", err)
-		}
-	}
-	return wsClients
 }
 
 // InitRouterConfigClientsWithArgs init standalone
@@ -76,6 +50,9 @@ func InitRouterConfigClientsWithArgs(configContract string, gateways []string) {
 
 // CallOnchainContract call onchain contract
 func CallOnchainContract(data hexutil.Bytes, blockNumber string) (result []byte, err error) {
+	if result, inUse, err := getConfigCallFromSnapshot(data); inUse {
+		return result, err
+	}
 	msg := ethereum.CallMsg{
 		To:   &routerConfigContract,
 		Data: data,
@@ -86,10 +63,10 @@ LOOP:
 		if err != nil && IsIniting {
 			for i := 0; i < RetryRPCCountInInit; i++ {
 				if result, err = cli.CallContract(routerConfigCtx, msg, nil); err == nil {
+					recordConfigCall(data, result)
 					return result, nil
 				}
-				if strings.Contains(err.Error(), "revert") ||
-					strings.Contains(err.Error(), "VM execution error") {
+				if isContractRevertError(err) {
 					break LOOP
 				}
 				log.Warn("retry call onchain router config contract failed", "contract", routerConfigContract, "times", i+1, "err", err)
@@ -97,6 +74,7 @@ LOOP:
 			}
 		}
 		if err == nil {
+			recordConfigCall(data, result)
 			return result, nil
 		}
 	}
@@ -104,52 +82,9 @@ LOOP:
 	return nil, err
 }
 
-// SubscribeUpdateConfig subscribe update ID and reload configs
-func SubscribeUpdateConfig(callback func() bool) {
-	if len(routerWebSocketClients) == 0 {
-		return
-	}
-	SubscribeRouterConfig([]ethcommon.Hash{updateConfigTopic})
-	for _, ch := range channels {
-		go processUpdateConfig(ch, callback)
-	}
-}
-
-func processUpdateConfig(ch <-chan ethtypes.Log, callback func() bool) {
-	for {
-		rlog := <-ch
-
-		// sleep random in a second to mess steps
-		rNum, _ := rand.Int(rand.Reader, big.NewInt(1000))
-		time.Sleep(time.Duration(rNum.Uint64()) * time.Millisecond)
-
-		blockNumber := rlog.BlockNumber
-		oldBlock := atomic.LoadUint64(&latestUpdateConfigBlock)
-		if blockNumber > oldBlock {
-			atomic.StoreUint64(&latestUpdateConfigBlock, blockNumber)
-			log.Info("start reload router config", "oldBlock", oldBlock, "blockNumber", blockNumber, "timestamp", time.Now().Unix())
-			callback()
-		}
-	}
-}
-
-// SubscribeRouterConfig subscribe router config
-func SubscribeRouterConfig(topics []ethcommon.Hash) {
-	fq := ethereum.FilterQuery{
-		Addresses: []ethcommon.Address{routerConfigContract},
-		Topics:    [][]ethcommon.Hash{topics},
-	}
-	for i, cli := range routerWebSocketClients {
-		ch := make(chan ethtypes.Log)
-		sub, err := cli.SubscribeFilterLogs(routerConfigCtx, fq, ch)
-		if err != nil {
-			log.Error("subscribe 'UpdateConfig' event failed", "index", i, "err", err)
-			continue
-		}
-		channels = append(channels, ch)
-		subscribes = append(subscribes, sub)
-	}
-	log.Info("subscribe 'UpdateConfig' event finished", "subscribes", len(subscribes))
+func isContractRevertError(err error) bool {
+	return strings.Contains(err.Error(), "revert") ||
+		strings.Contains(err.Error(), "VM execution error")
 }
 
 func parseChainConfig(data []byte) (config *tokens.ChainConfig, err error) {
@@ -2,6 +2,7 @@
 package router
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -107,21 +108,60 @@ func GetRouterInfo(router, chainID string) *SwapRouterInfo {
 	return nil
 }
 
-// GetTokenRouterContract get token router contract
-func GetTokenRouterContract(tokenID, chainID string) (string, error) {
+// RegisterSwap register swap of each enabled swap type and merge the results,
+// get rid of the 'log not found' results if some swap type has found logs,
+// and keep only the first result of each log index (default swap type comes first).
+func RegisterSwap(bridge tokens.IBridge, txid string, logIndex int) (swapInfos []*tokens.SwapTxInfo, errs []error) {
+	swapTypes := tokens.GetRouterSwapTypes()
+	if len(swapTypes) == 1 {
+		return bridge.RegisterSwap(txid, &tokens.RegisterArgs{SwapType: swapTypes[0], LogIndex: logIndex})
+	}
+	var notFoundInfo *tokens.SwapTxInfo
+	var notFoundErr error
+	logIndexes := make(map[int]struct{})
+	for _, swapType := range swapTypes {
+		infos, verifyErrs := bridge.RegisterSwap(txid, &tokens.RegisterArgs{SwapType: swapType, LogIndex: logIndex})
+		for i, info := range infos {
+			verifyErr := verifyErrs[i]
+			if errors.Is(verifyErr, tokens.ErrSwapoutLogNotFound) ||
+				errors.Is(verifyErr, tokens.ErrSwapTypeNotSupported) {
+				if notFoundInfo == nil {
+					notFoundInfo, notFoundErr = info, verifyErr
+				}
+				continue
+			}
+			if _, exist := logIndexes[info.LogIndex]; exist {
+				continue
+			}
+			logIndexes[info.LogIndex] = struct{}{}
+			swapInfos = append(swapInfos, info)
+			errs = append(errs, verifyErr)
+		}
+	}
+	if len(swapInfos) == 0 && notFoundInfo != nil {
+		return []*tokens.SwapTxInfo{notFoundInfo}, []error{notFoundErr}
+	}
+	return swapInfos, errs
+}
+
+// GetTokenRouterContract get token router contract of swap type
+func GetTokenRouterContract(swapType tokens.SwapType, tokenID, chainID string) (string, error) {
 	bridge := GetBridgeByChainID(chainID)
 	if bridge == nil {
 		return "", tokens.ErrNoBridgeForChainID
 	}
 	multichainToken := ""
-	if !tokens.IsAnyCallRouter() {
+	if swapType != tokens.AnyCallSwapType {
 		multichainToken = GetCachedMultichainToken(tokenID, chainID)
 		if multichainToken == "" {
 			log.Warn("GetTokenRouterContract get multichain token failed", "tokenID", tokenID, "chainID", chainID)
 			return "", tokens.ErrMissTokenConfig
 		}
 	}
-	routerContract := bridge.GetRouterContract(multichainToken)
+	routerContract := tokens.GetSwapTypeRouterContract(swapType, chainID)
+	if routerContract == "" {
+		routerContract = bridge.GetRouterContract(multichainToken)
+	}
 	if routerContract == "" {
 		return "", tokens.ErrMissRouterInfo
 	}
@@ -129,8 +169,8 @@ func GetTokenRouterContract(tokenID, chainID string) (string, error) {
 }
 
 // GetTokenRouterInfo get token router info
-func GetTokenRouterInfo(tokenID, chainID string) (*SwapRouterInfo, error) {
-	routerContract, err := GetTokenRouterContract(tokenID, chainID)
+func GetTokenRouterInfo(swapType tokens.SwapType, tokenID, chainID string) (*SwapRouterInfo, error) {
+	routerContract, err := GetTokenRouterContract(swapType, tokenID, chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -142,14 +182,44 @@ func GetTokenRouterInfo(tokenID, chainID string) (*SwapRouterInfo, error) {
 }
 
 // GetRouterMPC get router mpc on dest chain (to build swapin tx)
-func GetRouterMPC(tokenID, chainID string) (string, error) {
-	routerInfo, err := GetTokenRouterInfo(tokenID, chainID)
+func GetRouterMPC(swapType tokens.SwapType, tokenID, chainID string) (string, error) {
+	routerInfo, err := GetTokenRouterInfo(swapType, tokenID, chainID)
 	if err != nil {
 		return "", err
 	}
 	return routerInfo.RouterMPC, nil
 }
 
+// GetRefundRouterContract get router contract on source chain to refund token
+// (fallback to the chain's router contract if the token is not configed)
+func GetRefundRouterContract(token, chainID string) (string, error) {
+	bridge := GetBridgeByChainID(chainID)
+	if bridge == nil {
+		return "", tokens.ErrNoBridgeForChainID
+	}
+	if bridge.GetTokenConfig(token) == nil {
+		token = ""
+	}
+	routerContract := bridge.GetRouterContract(token)
+	if routerContract == "" {
+		return "", tokens.ErrMissRouterInfo
+	}
+	return routerContract, nil
+}
+
+// GetRefundRouterMPC get router mpc on source chain (to build refund tx)
+func GetRefundRouterMPC(token, chainID string) (string, error) {
+	routerContract, err := GetRefundRouterContract(token, chainID)
+	if err != nil {
+		return "", err
+	}
+	routerInfo := GetRouterInfo(routerContract, chainID)
+	if routerInfo == nil {
+		return "", tokens.ErrMissRouterInfo
+	}
+	return routerInfo.RouterMPC, nil
+}
+
 // SetMPCPublicKey set router mpc public key
 func SetMPCPublicKey(mpc, pubkey string) {
 	key := strings.ToLower(mpc)
@@ -97,6 +97,17 @@ func (err *jsonError) Error() string {
 	return fmt.Sprintf("json-rpc error %d, %s", err.Code, err.Message)
 }
 
+// ErrorData impl DataError
+func (err *jsonError) ErrorData() interface{} {
+	return err.Data
+}
+
+// DataError json-rpc error with data (eg. revert data of `eth_call`)
+type DataError interface {
+	Error() string
+	ErrorData() interface{}
+}
+
 type jsonrpcResponse struct {
 	Version string          `json:"jsonrpc,omitempty"`
 	ID      json.RawMessage `json:"id,omitempty"`
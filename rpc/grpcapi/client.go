@@ -0,0 +1,20 @@
+package grpcapi
+
+import (
+	"github.com/deltaswapio/swaprouter/v3/rpc/grpcapi/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Dial dial the gRPC service of api server (insecure by default), and returns the
+// generated client. the returned connection should be closed after use.
+func Dial(target string, opts ...grpc.DialOption) (pb.RouterSwapClient, *grpc.ClientConn, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb.NewRouterSwapClient(conn), conn, nil
+}
@@ -0,0 +1,167 @@
+package grpcapi
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/deltaswapio/swaprouter/v3/internal/swapapi"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/rpc/grpcapi/pb"
+)
+
+func convertSwapInfo(s *swapapi.SwapInfo) *pb.SwapInfo {
+	if s == nil {
+		return nil
+	}
+	return &pb.SwapInfo{
+		SwapType:        s.SwapType,
+		Txid:            s.TxID,
+		TxTo:            s.TxTo,
+		TxHeight:        s.TxHeight,
+		From:            s.From,
+		To:              s.To,
+		Bind:            s.Bind,
+		Value:           s.Value,
+		LogIndex:        int32(s.LogIndex),
+		FromChainId:     s.FromChainID,
+		ToChainId:       s.ToChainID,
+		Erc20SwapInfo:   convertERC20SwapInfo(s.SwapInfo.ERC20SwapInfo),
+		NftSwapInfo:     convertNFTSwapInfo(s.SwapInfo.NFTSwapInfo),
+		AnycallSwapInfo: convertAnyCallSwapInfo(s.SwapInfo.AnyCallSwapInfo),
+		SwapTx:          s.SwapTx,
+		SwapHeight:      s.SwapHeight,
+		SwapValue:       s.SwapValue,
+		SwapNonce:       s.SwapNonce,
+		Status:          uint32(s.Status),
+		StatusMsg:       s.StatusMsg,
+		InitTime:        s.InitTime,
+		Timestamp:       s.Timestamp,
+		Memo:            s.Memo,
+		PendingReason:   s.PendingReason,
+		ReplaceCount:    int32(s.ReplaceCount),
+		Confirmations:   s.Confirmations,
+	}
+}
+
+func convertSwapInfos(swaps []*swapapi.SwapInfo) *pb.SwapInfoList {
+	result := &pb.SwapInfoList{Swaps: make([]*pb.SwapInfo, len(swaps))}
+	for i, swap := range swaps {
+		result.Swaps[i] = convertSwapInfo(swap)
+	}
+	return result
+}
+
+func convertERC20SwapInfo(s *mongodb.ERC20SwapInfo) *pb.ERC20SwapInfo {
+	if s == nil {
+		return nil
+	}
+	return &pb.ERC20SwapInfo{
+		Token:     s.Token,
+		TokenId:   s.TokenID,
+		SwapoutId: s.SwapoutID,
+		CallProxy: s.CallProxy,
+		CallData:  s.CallData,
+	}
+}
+
+func convertNFTSwapInfo(s *mongodb.NFTSwapInfo) *pb.NFTSwapInfo {
+	if s == nil {
+		return nil
+	}
+	return &pb.NFTSwapInfo{
+		Token:   s.Token,
+		TokenId: s.TokenID,
+		Ids:     s.IDs,
+		Amounts: s.Amounts,
+		Batch:   s.Batch,
+		Data:    s.Data,
+	}
+}
+
+func convertAnyCallSwapInfo(s *mongodb.AnyCallSwapInfo) *pb.AnyCallSwapInfo {
+	if s == nil {
+		return nil
+	}
+	return &pb.AnyCallSwapInfo{
+		CallFrom:    s.CallFrom,
+		CallTo:      s.CallTo,
+		CallData:    s.CallData,
+		Fallback:    s.Fallback,
+		Flags:       s.Flags,
+		AppId:       s.AppID,
+		Nonce:       s.Nonce,
+		ExtData:     s.ExtData,
+		Message:     s.Message,
+		Attestation: s.Attestation,
+	}
+}
+
+func convertSwapStatusUpdate(update *swapapi.SwapStatusUpdate) *pb.SwapStatusUpdate {
+	return &pb.SwapStatusUpdate{
+		Id:    update.ID,
+		Epoch: update.Epoch,
+		Seq:   update.Seq,
+		Swap:  convertSwapInfo(update.Swap),
+	}
+}
+
+// status counts are numbers of mongodb aggregation
+func convertStatusInfo(statusInfo map[string]interface{}) (*pb.StatusInfo, error) {
+	result := &pb.StatusInfo{Counts: make(map[string]int64, len(statusInfo))}
+	for status, count := range statusInfo {
+		switch c := count.(type) {
+		case int32:
+			result.Counts[status] = int64(c)
+		case int64:
+			result.Counts[status] = c
+		case int:
+			result.Counts[status] = int64(c)
+		default:
+			n, err := strconv.ParseInt(fmt.Sprint(count), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("wrong count %v of status %v", count, status)
+			}
+			result.Counts[status] = n
+		}
+	}
+	return result, nil
+}
+
+func convertChainConfig(c *swapapi.ChainConfig) *pb.ChainConfig {
+	return &pb.ChainConfig{
+		ChainId:        c.ChainID,
+		BlockChain:     c.BlockChain,
+		RouterContract: c.RouterContract,
+		RouterVersion:  c.RouterVersion,
+		Confirmations:  c.Confirmations,
+		InitialHeight:  c.InitialHeight,
+	}
+}
+
+func convertTokenConfig(c *swapapi.TokenConfig) *pb.TokenConfig {
+	return &pb.TokenConfig{
+		TokenId:         c.TokenID,
+		Decimals:        uint32(c.Decimals),
+		ContractAddress: c.ContractAddress,
+		ContractVersion: c.ContractVersion,
+		RouterContract:  c.RouterContract,
+		RouterVersion:   c.RouterVersion,
+		Underlying:      c.Underlying,
+	}
+}
+
+func convertSwapConfig(c *swapapi.SwapConfig) *pb.SwapConfig {
+	return &pb.SwapConfig{
+		MaximumSwap:       c.MaximumSwap,
+		MinimumSwap:       c.MinimumSwap,
+		BigValueThreshold: c.BigValueThreshold,
+	}
+}
+
+func convertFeeConfig(c *swapapi.FeeConfig) *pb.FeeConfig {
+	return &pb.FeeConfig{
+		SwapFeeRatePerMillion: c.SwapFeeRatePerMillion,
+		MaximumSwapFee:        c.MaximumSwapFee,
+		MinimumSwapFee:        c.MinimumSwapFee,
+	}
+}
@@ -0,0 +1,2420 @@
+// gRPC service of swaprouter api server, served from the same
+// 'internal/swapapi' functions as the JSON RPC and RESTful apis.
+//
+// regenerate the go code by 'make grpc' after modifying this file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1-devel
+// 	protoc        (unknown)
+// source: rpc/grpcapi/pb/swaprouter.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{0}
+}
+
+type StringList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StringList) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type VersionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionInfo) ProtoMessage() {}
+
+func (x *VersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VersionInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier     string   `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Version        string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	AllChainIds    []string `protobuf:"bytes,3,rep,name=all_chain_ids,json=allChainIds,proto3" json:"all_chain_ids,omitempty"`
+	PausedChainIds []string `protobuf:"bytes,4,rep,name=paused_chain_ids,json=pausedChainIds,proto3" json:"paused_chain_ids,omitempty"`
+	StaleConfig    bool     `protobuf:"varint,5,opt,name=stale_config,json=staleConfig,proto3" json:"stale_config,omitempty"`
+	SnapshotBlock  uint64   `protobuf:"varint,6,opt,name=snapshot_block,json=snapshotBlock,proto3" json:"snapshot_block,omitempty"`
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServerInfo) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetAllChainIds() []string {
+	if x != nil {
+		return x.AllChainIds
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetPausedChainIds() []string {
+	if x != nil {
+		return x.PausedChainIds
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetStaleConfig() bool {
+	if x != nil {
+		return x.StaleConfig
+	}
+	return false
+}
+
+func (x *ServerInfo) GetSnapshotBlock() uint64 {
+	if x != nil {
+		return x.SnapshotBlock
+	}
+	return 0
+}
+
+type StatusInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses string `protobuf:"bytes,1,opt,name=statuses,proto3" json:"statuses,omitempty"` // comma separated statuses, empty means the default statuses
+}
+
+func (x *StatusInfoRequest) Reset() {
+	*x = StatusInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusInfoRequest) ProtoMessage() {}
+
+func (x *StatusInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusInfoRequest.ProtoReflect.Descriptor instead.
+func (*StatusInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StatusInfoRequest) GetStatuses() string {
+	if x != nil {
+		return x.Statuses
+	}
+	return ""
+}
+
+type StatusInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // key is status
+}
+
+func (x *StatusInfo) Reset() {
+	*x = StatusInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusInfo) ProtoMessage() {}
+
+func (x *StatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusInfo.ProtoReflect.Descriptor instead.
+func (*StatusInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StatusInfo) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type SwapKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId  string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txid     string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	LogIndex string `protobuf:"bytes,3,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+}
+
+func (x *SwapKey) Reset() {
+	*x = SwapKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapKey) ProtoMessage() {}
+
+func (x *SwapKey) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapKey.ProtoReflect.Descriptor instead.
+func (*SwapKey) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SwapKey) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SwapKey) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SwapKey) GetLogIndex() string {
+	if x != nil {
+		return x.LogIndex
+	}
+	return ""
+}
+
+type RegisterResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results map[int32]string `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // key is log index
+}
+
+func (x *RegisterResult) Reset() {
+	*x = RegisterResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResult) ProtoMessage() {}
+
+func (x *RegisterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResult.ProtoReflect.Descriptor instead.
+func (*RegisterResult) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegisterResult) GetResults() map[int32]string {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SwapHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Offset  int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status  string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SwapHistoryRequest) Reset() {
+	*x = SwapHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapHistoryRequest) ProtoMessage() {}
+
+func (x *SwapHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SwapHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SwapHistoryRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SwapHistoryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SwapHistoryRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SwapHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SwapHistoryRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ERC20SwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenId   string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	SwapoutId string `protobuf:"bytes,3,opt,name=swapout_id,json=swapoutId,proto3" json:"swapout_id,omitempty"`
+	CallProxy string `protobuf:"bytes,4,opt,name=call_proxy,json=callProxy,proto3" json:"call_proxy,omitempty"`
+	CallData  string `protobuf:"bytes,5,opt,name=call_data,json=callData,proto3" json:"call_data,omitempty"`
+}
+
+func (x *ERC20SwapInfo) Reset() {
+	*x = ERC20SwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ERC20SwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ERC20SwapInfo) ProtoMessage() {}
+
+func (x *ERC20SwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ERC20SwapInfo.ProtoReflect.Descriptor instead.
+func (*ERC20SwapInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ERC20SwapInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ERC20SwapInfo) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ERC20SwapInfo) GetSwapoutId() string {
+	if x != nil {
+		return x.SwapoutId
+	}
+	return ""
+}
+
+func (x *ERC20SwapInfo) GetCallProxy() string {
+	if x != nil {
+		return x.CallProxy
+	}
+	return ""
+}
+
+func (x *ERC20SwapInfo) GetCallData() string {
+	if x != nil {
+		return x.CallData
+	}
+	return ""
+}
+
+type NFTSwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenId string   `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Ids     []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	Amounts []string `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts,omitempty"`
+	Batch   bool     `protobuf:"varint,5,opt,name=batch,proto3" json:"batch,omitempty"`
+	Data    string   `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *NFTSwapInfo) Reset() {
+	*x = NFTSwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTSwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTSwapInfo) ProtoMessage() {}
+
+func (x *NFTSwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTSwapInfo.ProtoReflect.Descriptor instead.
+func (*NFTSwapInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NFTSwapInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NFTSwapInfo) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *NFTSwapInfo) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *NFTSwapInfo) GetAmounts() []string {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *NFTSwapInfo) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *NFTSwapInfo) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type AnyCallSwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallFrom    string `protobuf:"bytes,1,opt,name=call_from,json=callFrom,proto3" json:"call_from,omitempty"`
+	CallTo      string `protobuf:"bytes,2,opt,name=call_to,json=callTo,proto3" json:"call_to,omitempty"`
+	CallData    string `protobuf:"bytes,3,opt,name=call_data,json=callData,proto3" json:"call_data,omitempty"`
+	Fallback    string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Flags       string `protobuf:"bytes,5,opt,name=flags,proto3" json:"flags,omitempty"`
+	AppId       string `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Nonce       string `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ExtData     string `protobuf:"bytes,8,opt,name=ext_data,json=extData,proto3" json:"ext_data,omitempty"`
+	Message     string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	Attestation string `protobuf:"bytes,10,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (x *AnyCallSwapInfo) Reset() {
+	*x = AnyCallSwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyCallSwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyCallSwapInfo) ProtoMessage() {}
+
+func (x *AnyCallSwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyCallSwapInfo.ProtoReflect.Descriptor instead.
+func (*AnyCallSwapInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AnyCallSwapInfo) GetCallFrom() string {
+	if x != nil {
+		return x.CallFrom
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetCallTo() string {
+	if x != nil {
+		return x.CallTo
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetCallData() string {
+	if x != nil {
+		return x.CallData
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetExtData() string {
+	if x != nil {
+		return x.ExtData
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AnyCallSwapInfo) GetAttestation() string {
+	if x != nil {
+		return x.Attestation
+	}
+	return ""
+}
+
+type SwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapType        uint32           `protobuf:"varint,1,opt,name=swap_type,json=swapType,proto3" json:"swap_type,omitempty"`
+	Txid            string           `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	TxTo            string           `protobuf:"bytes,3,opt,name=tx_to,json=txTo,proto3" json:"tx_to,omitempty"`
+	TxHeight        uint64           `protobuf:"varint,4,opt,name=tx_height,json=txHeight,proto3" json:"tx_height,omitempty"`
+	From            string           `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To              string           `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Bind            string           `protobuf:"bytes,7,opt,name=bind,proto3" json:"bind,omitempty"`
+	Value           string           `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	LogIndex        int32            `protobuf:"varint,9,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	FromChainId     string           `protobuf:"bytes,10,opt,name=from_chain_id,json=fromChainId,proto3" json:"from_chain_id,omitempty"`
+	ToChainId       string           `protobuf:"bytes,11,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	Erc20SwapInfo   *ERC20SwapInfo   `protobuf:"bytes,12,opt,name=erc20_swap_info,json=erc20SwapInfo,proto3" json:"erc20_swap_info,omitempty"`
+	NftSwapInfo     *NFTSwapInfo     `protobuf:"bytes,13,opt,name=nft_swap_info,json=nftSwapInfo,proto3" json:"nft_swap_info,omitempty"`
+	AnycallSwapInfo *AnyCallSwapInfo `protobuf:"bytes,14,opt,name=anycall_swap_info,json=anycallSwapInfo,proto3" json:"anycall_swap_info,omitempty"`
+	SwapTx          string           `protobuf:"bytes,15,opt,name=swap_tx,json=swapTx,proto3" json:"swap_tx,omitempty"`
+	SwapHeight      uint64           `protobuf:"varint,16,opt,name=swap_height,json=swapHeight,proto3" json:"swap_height,omitempty"`
+	SwapValue       string           `protobuf:"bytes,17,opt,name=swap_value,json=swapValue,proto3" json:"swap_value,omitempty"`
+	SwapNonce       uint64           `protobuf:"varint,18,opt,name=swap_nonce,json=swapNonce,proto3" json:"swap_nonce,omitempty"`
+	Status          uint32           `protobuf:"varint,19,opt,name=status,proto3" json:"status,omitempty"`
+	StatusMsg       string           `protobuf:"bytes,20,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	InitTime        int64            `protobuf:"varint,21,opt,name=init_time,json=initTime,proto3" json:"init_time,omitempty"`
+	Timestamp       int64            `protobuf:"varint,22,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Memo            string           `protobuf:"bytes,23,opt,name=memo,proto3" json:"memo,omitempty"`
+	PendingReason   string           `protobuf:"bytes,24,opt,name=pending_reason,json=pendingReason,proto3" json:"pending_reason,omitempty"`
+	ReplaceCount    int32            `protobuf:"varint,25,opt,name=replace_count,json=replaceCount,proto3" json:"replace_count,omitempty"`
+	Confirmations   uint64           `protobuf:"varint,26,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *SwapInfo) Reset() {
+	*x = SwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapInfo) ProtoMessage() {}
+
+func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
+func (*SwapInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SwapInfo) GetSwapType() uint32 {
+	if x != nil {
+		return x.SwapType
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTxTo() string {
+	if x != nil {
+		return x.TxTo
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTxHeight() uint64 {
+	if x != nil {
+		return x.TxHeight
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetBind() string {
+	if x != nil {
+		return x.Bind
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetLogIndex() int32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetFromChainId() string {
+	if x != nil {
+		return x.FromChainId
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetToChainId() string {
+	if x != nil {
+		return x.ToChainId
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetErc20SwapInfo() *ERC20SwapInfo {
+	if x != nil {
+		return x.Erc20SwapInfo
+	}
+	return nil
+}
+
+func (x *SwapInfo) GetNftSwapInfo() *NFTSwapInfo {
+	if x != nil {
+		return x.NftSwapInfo
+	}
+	return nil
+}
+
+func (x *SwapInfo) GetAnycallSwapInfo() *AnyCallSwapInfo {
+	if x != nil {
+		return x.AnycallSwapInfo
+	}
+	return nil
+}
+
+func (x *SwapInfo) GetSwapTx() string {
+	if x != nil {
+		return x.SwapTx
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetSwapHeight() uint64 {
+	if x != nil {
+		return x.SwapHeight
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetSwapValue() string {
+	if x != nil {
+		return x.SwapValue
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetSwapNonce() uint64 {
+	if x != nil {
+		return x.SwapNonce
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetStatusMsg() string {
+	if x != nil {
+		return x.StatusMsg
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetInitTime() int64 {
+	if x != nil {
+		return x.InitTime
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetPendingReason() string {
+	if x != nil {
+		return x.PendingReason
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetReplaceCount() int32 {
+	if x != nil {
+		return x.ReplaceCount
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type SwapInfoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps []*SwapInfo `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *SwapInfoList) Reset() {
+	*x = SwapInfoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapInfoList) ProtoMessage() {}
+
+func (x *SwapInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapInfoList.ProtoReflect.Descriptor instead.
+func (*SwapInfoList) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SwapInfoList) GetSwaps() []*SwapInfo {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type SwapStatusFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txid    string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	FromId  string `protobuf:"bytes,6,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"` // resume after this id ('<epoch>-<seq>'), empty means not resuming
+}
+
+func (x *SwapStatusFilter) Reset() {
+	*x = SwapStatusFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStatusFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStatusFilter) ProtoMessage() {}
+
+func (x *SwapStatusFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStatusFilter.ProtoReflect.Descriptor instead.
+func (*SwapStatusFilter) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SwapStatusFilter) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SwapStatusFilter) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SwapStatusFilter) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SwapStatusFilter) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *SwapStatusFilter) GetFromId() string {
+	if x != nil {
+		return x.FromId
+	}
+	return ""
+}
+
+type SwapStatusUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq   uint64    `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Swap  *SwapInfo `protobuf:"bytes,2,opt,name=swap,proto3" json:"swap,omitempty"`
+	Epoch uint64    `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Id    string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // resume id in '<epoch>-<seq>' format
+}
+
+func (x *SwapStatusUpdate) Reset() {
+	*x = SwapStatusUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStatusUpdate) ProtoMessage() {}
+
+func (x *SwapStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStatusUpdate.ProtoReflect.Descriptor instead.
+func (*SwapStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SwapStatusUpdate) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SwapStatusUpdate) GetSwap() *SwapInfo {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *SwapStatusUpdate) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SwapStatusUpdate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ChainConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *ChainConfigRequest) Reset() {
+	*x = ChainConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfigRequest) ProtoMessage() {}
+
+func (x *ChainConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfigRequest.ProtoReflect.Descriptor instead.
+func (*ChainConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChainConfigRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type ChainConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId        string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockChain     string `protobuf:"bytes,2,opt,name=block_chain,json=blockChain,proto3" json:"block_chain,omitempty"`
+	RouterContract string `protobuf:"bytes,3,opt,name=router_contract,json=routerContract,proto3" json:"router_contract,omitempty"`
+	RouterVersion  string `protobuf:"bytes,4,opt,name=router_version,json=routerVersion,proto3" json:"router_version,omitempty"`
+	Confirmations  uint64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	InitialHeight  uint64 `protobuf:"varint,6,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height,omitempty"`
+}
+
+func (x *ChainConfig) Reset() {
+	*x = ChainConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfig) ProtoMessage() {}
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChainConfig) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetBlockChain() string {
+	if x != nil {
+		return x.BlockChain
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetRouterContract() string {
+	if x != nil {
+		return x.RouterContract
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetRouterVersion() string {
+	if x != nil {
+		return x.RouterVersion
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetInitialHeight() uint64 {
+	if x != nil {
+		return x.InitialHeight
+	}
+	return 0
+}
+
+type TokenConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *TokenConfigRequest) Reset() {
+	*x = TokenConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenConfigRequest) ProtoMessage() {}
+
+func (x *TokenConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenConfigRequest.ProtoReflect.Descriptor instead.
+func (*TokenConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TokenConfigRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *TokenConfigRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type TokenConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId         string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Decimals        uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	ContractVersion uint64 `protobuf:"varint,4,opt,name=contract_version,json=contractVersion,proto3" json:"contract_version,omitempty"`
+	RouterContract  string `protobuf:"bytes,5,opt,name=router_contract,json=routerContract,proto3" json:"router_contract,omitempty"`
+	RouterVersion   string `protobuf:"bytes,6,opt,name=router_version,json=routerVersion,proto3" json:"router_version,omitempty"`
+	Underlying      string `protobuf:"bytes,7,opt,name=underlying,proto3" json:"underlying,omitempty"`
+}
+
+func (x *TokenConfig) Reset() {
+	*x = TokenConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenConfig) ProtoMessage() {}
+
+func (x *TokenConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenConfig.ProtoReflect.Descriptor instead.
+func (*TokenConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TokenConfig) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetContractVersion() uint64 {
+	if x != nil {
+		return x.ContractVersion
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetRouterContract() string {
+	if x != nil {
+		return x.RouterContract
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetRouterVersion() string {
+	if x != nil {
+		return x.RouterVersion
+	}
+	return ""
+}
+
+func (x *TokenConfig) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+type SwapConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId     string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	FromChainId string `protobuf:"bytes,2,opt,name=from_chain_id,json=fromChainId,proto3" json:"from_chain_id,omitempty"`
+	ToChainId   string `protobuf:"bytes,3,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+}
+
+func (x *SwapConfigRequest) Reset() {
+	*x = SwapConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapConfigRequest) ProtoMessage() {}
+
+func (x *SwapConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapConfigRequest.ProtoReflect.Descriptor instead.
+func (*SwapConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SwapConfigRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *SwapConfigRequest) GetFromChainId() string {
+	if x != nil {
+		return x.FromChainId
+	}
+	return ""
+}
+
+func (x *SwapConfigRequest) GetToChainId() string {
+	if x != nil {
+		return x.ToChainId
+	}
+	return ""
+}
+
+type SwapConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaximumSwap       string `protobuf:"bytes,1,opt,name=maximum_swap,json=maximumSwap,proto3" json:"maximum_swap,omitempty"`
+	MinimumSwap       string `protobuf:"bytes,2,opt,name=minimum_swap,json=minimumSwap,proto3" json:"minimum_swap,omitempty"`
+	BigValueThreshold string `protobuf:"bytes,3,opt,name=big_value_threshold,json=bigValueThreshold,proto3" json:"big_value_threshold,omitempty"`
+}
+
+func (x *SwapConfig) Reset() {
+	*x = SwapConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapConfig) ProtoMessage() {}
+
+func (x *SwapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapConfig.ProtoReflect.Descriptor instead.
+func (*SwapConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SwapConfig) GetMaximumSwap() string {
+	if x != nil {
+		return x.MaximumSwap
+	}
+	return ""
+}
+
+func (x *SwapConfig) GetMinimumSwap() string {
+	if x != nil {
+		return x.MinimumSwap
+	}
+	return ""
+}
+
+func (x *SwapConfig) GetBigValueThreshold() string {
+	if x != nil {
+		return x.BigValueThreshold
+	}
+	return ""
+}
+
+type FeeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapFeeRatePerMillion uint64 `protobuf:"varint,1,opt,name=swap_fee_rate_per_million,json=swapFeeRatePerMillion,proto3" json:"swap_fee_rate_per_million,omitempty"`
+	MaximumSwapFee        string `protobuf:"bytes,2,opt,name=maximum_swap_fee,json=maximumSwapFee,proto3" json:"maximum_swap_fee,omitempty"`
+	MinimumSwapFee        string `protobuf:"bytes,3,opt,name=minimum_swap_fee,json=minimumSwapFee,proto3" json:"minimum_swap_fee,omitempty"`
+}
+
+func (x *FeeConfig) Reset() {
+	*x = FeeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeConfig) ProtoMessage() {}
+
+func (x *FeeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeConfig.ProtoReflect.Descriptor instead.
+func (*FeeConfig) Descriptor() ([]byte, []int) {
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FeeConfig) GetSwapFeeRatePerMillion() uint64 {
+	if x != nil {
+		return x.SwapFeeRatePerMillion
+	}
+	return 0
+}
+
+func (x *FeeConfig) GetMaximumSwapFee() string {
+	if x != nil {
+		return x.MaximumSwapFee
+	}
+	return ""
+}
+
+func (x *FeeConfig) GetMinimumSwapFee() string {
+	if x != nil {
+		return x.MinimumSwapFee
+	}
+	return ""
+}
+
+var File_rpc_grpcapi_pb_swaprouter_proto protoreflect.FileDescriptor
+
+var file_rpc_grpcapi_pb_swaprouter_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x22, 0x07, 0x0a,
+	0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d,
+	0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2f, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x07, 0x53,
+	0x77, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x53, 0x77, 0x61, 0x70, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x45, 0x52, 0x43, 0x32, 0x30,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61,
+	0x70, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x77, 0x61, 0x70, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c,
+	0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
+	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x4e, 0x46, 0x54, 0x53, 0x77, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x02, 0x0a, 0x0f,
+	0x41, 0x6e, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd5, 0x06, 0x0a, 0x08, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x78, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x78, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0f, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x52,
+	0x43, 0x32, 0x30, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3b, 0x0a, 0x0d, 0x6e, 0x66,
+	0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x4e,
+	0x46, 0x54, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6e, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x6e, 0x79, 0x63, 0x61,
+	0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x41, 0x6e, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0f, 0x61, 0x6e, 0x79, 0x63, 0x61, 0x6c, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x54, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x77, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x77, 0x61, 0x70, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x73, 0x67, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x3a, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x95, 0x01, 0x0a,
+	0x10, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x64, 0x4a, 0x04,
+	0x08, 0x05, 0x10, 0x06, 0x22, 0x74, 0x0a, 0x10, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x77,
+	0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x12, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x8a, 0x02, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x22, 0x72, 0x0a, 0x11,
+	0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x22, 0x82, 0x01, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x53, 0x77, 0x61, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x69, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x62, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65,
+	0x65, 0x32, 0xd7, 0x07, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x11, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x11, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x12, 0x13, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x1a, 0x1a, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x12, 0x13, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x1a,
+	0x14, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x1a, 0x18, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x1c, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x3b, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x73, 0x12,
+	0x11, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x11, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x46, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x72, 0x2e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x2f, 0x76, 0x33, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_grpcapi_pb_swaprouter_proto_rawDescOnce sync.Once
+	file_rpc_grpcapi_pb_swaprouter_proto_rawDescData = file_rpc_grpcapi_pb_swaprouter_proto_rawDesc
+)
+
+func file_rpc_grpcapi_pb_swaprouter_proto_rawDescGZIP() []byte {
+	file_rpc_grpcapi_pb_swaprouter_proto_rawDescOnce.Do(func() {
+		file_rpc_grpcapi_pb_swaprouter_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_grpcapi_pb_swaprouter_proto_rawDescData)
+	})
+	return file_rpc_grpcapi_pb_swaprouter_proto_rawDescData
+}
+
+var file_rpc_grpcapi_pb_swaprouter_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_rpc_grpcapi_pb_swaprouter_proto_goTypes = []interface{}{
+	(*Empty)(nil),              // 0: swaprouter.Empty
+	(*StringList)(nil),         // 1: swaprouter.StringList
+	(*VersionInfo)(nil),        // 2: swaprouter.VersionInfo
+	(*ServerInfo)(nil),         // 3: swaprouter.ServerInfo
+	(*StatusInfoRequest)(nil),  // 4: swaprouter.StatusInfoRequest
+	(*StatusInfo)(nil),         // 5: swaprouter.StatusInfo
+	(*SwapKey)(nil),            // 6: swaprouter.SwapKey
+	(*RegisterResult)(nil),     // 7: swaprouter.RegisterResult
+	(*SwapHistoryRequest)(nil), // 8: swaprouter.SwapHistoryRequest
+	(*ERC20SwapInfo)(nil),      // 9: swaprouter.ERC20SwapInfo
+	(*NFTSwapInfo)(nil),        // 10: swaprouter.NFTSwapInfo
+	(*AnyCallSwapInfo)(nil),    // 11: swaprouter.AnyCallSwapInfo
+	(*SwapInfo)(nil),           // 12: swaprouter.SwapInfo
+	(*SwapInfoList)(nil),       // 13: swaprouter.SwapInfoList
+	(*SwapStatusFilter)(nil),   // 14: swaprouter.SwapStatusFilter
+	(*SwapStatusUpdate)(nil),   // 15: swaprouter.SwapStatusUpdate
+	(*ChainConfigRequest)(nil), // 16: swaprouter.ChainConfigRequest
+	(*ChainConfig)(nil),        // 17: swaprouter.ChainConfig
+	(*TokenConfigRequest)(nil), // 18: swaprouter.TokenConfigRequest
+	(*TokenConfig)(nil),        // 19: swaprouter.TokenConfig
+	(*SwapConfigRequest)(nil),  // 20: swaprouter.SwapConfigRequest
+	(*SwapConfig)(nil),         // 21: swaprouter.SwapConfig
+	(*FeeConfig)(nil),          // 22: swaprouter.FeeConfig
+	nil,                        // 23: swaprouter.StatusInfo.CountsEntry
+	nil,                        // 24: swaprouter.RegisterResult.ResultsEntry
+}
+var file_rpc_grpcapi_pb_swaprouter_proto_depIdxs = []int32{
+	23, // 0: swaprouter.StatusInfo.counts:type_name -> swaprouter.StatusInfo.CountsEntry
+	24, // 1: swaprouter.RegisterResult.results:type_name -> swaprouter.RegisterResult.ResultsEntry
+	9,  // 2: swaprouter.SwapInfo.erc20_swap_info:type_name -> swaprouter.ERC20SwapInfo
+	10, // 3: swaprouter.SwapInfo.nft_swap_info:type_name -> swaprouter.NFTSwapInfo
+	11, // 4: swaprouter.SwapInfo.anycall_swap_info:type_name -> swaprouter.AnyCallSwapInfo
+	12, // 5: swaprouter.SwapInfoList.swaps:type_name -> swaprouter.SwapInfo
+	12, // 6: swaprouter.SwapStatusUpdate.swap:type_name -> swaprouter.SwapInfo
+	0,  // 7: swaprouter.RouterSwap.GetVersionInfo:input_type -> swaprouter.Empty
+	0,  // 8: swaprouter.RouterSwap.GetServerInfo:input_type -> swaprouter.Empty
+	4,  // 9: swaprouter.RouterSwap.GetStatusInfo:input_type -> swaprouter.StatusInfoRequest
+	6,  // 10: swaprouter.RouterSwap.RegisterRouterSwap:input_type -> swaprouter.SwapKey
+	6,  // 11: swaprouter.RouterSwap.GetRouterSwap:input_type -> swaprouter.SwapKey
+	6,  // 12: swaprouter.RouterSwap.GetRouterSwaps:input_type -> swaprouter.SwapKey
+	8,  // 13: swaprouter.RouterSwap.GetRouterSwapHistory:input_type -> swaprouter.SwapHistoryRequest
+	14, // 14: swaprouter.RouterSwap.SubscribeSwapStatus:input_type -> swaprouter.SwapStatusFilter
+	0,  // 15: swaprouter.RouterSwap.GetAllChainIDs:input_type -> swaprouter.Empty
+	0,  // 16: swaprouter.RouterSwap.GetAllTokenIDs:input_type -> swaprouter.Empty
+	16, // 17: swaprouter.RouterSwap.GetChainConfig:input_type -> swaprouter.ChainConfigRequest
+	18, // 18: swaprouter.RouterSwap.GetTokenConfig:input_type -> swaprouter.TokenConfigRequest
+	20, // 19: swaprouter.RouterSwap.GetSwapConfig:input_type -> swaprouter.SwapConfigRequest
+	20, // 20: swaprouter.RouterSwap.GetFeeConfig:input_type -> swaprouter.SwapConfigRequest
+	2,  // 21: swaprouter.RouterSwap.GetVersionInfo:output_type -> swaprouter.VersionInfo
+	3,  // 22: swaprouter.RouterSwap.GetServerInfo:output_type -> swaprouter.ServerInfo
+	5,  // 23: swaprouter.RouterSwap.GetStatusInfo:output_type -> swaprouter.StatusInfo
+	7,  // 24: swaprouter.RouterSwap.RegisterRouterSwap:output_type -> swaprouter.RegisterResult
+	12, // 25: swaprouter.RouterSwap.GetRouterSwap:output_type -> swaprouter.SwapInfo
+	13, // 26: swaprouter.RouterSwap.GetRouterSwaps:output_type -> swaprouter.SwapInfoList
+	13, // 27: swaprouter.RouterSwap.GetRouterSwapHistory:output_type -> swaprouter.SwapInfoList
+	15, // 28: swaprouter.RouterSwap.SubscribeSwapStatus:output_type -> swaprouter.SwapStatusUpdate
+	1,  // 29: swaprouter.RouterSwap.GetAllChainIDs:output_type -> swaprouter.StringList
+	1,  // 30: swaprouter.RouterSwap.GetAllTokenIDs:output_type -> swaprouter.StringList
+	17, // 31: swaprouter.RouterSwap.GetChainConfig:output_type -> swaprouter.ChainConfig
+	19, // 32: swaprouter.RouterSwap.GetTokenConfig:output_type -> swaprouter.TokenConfig
+	21, // 33: swaprouter.RouterSwap.GetSwapConfig:output_type -> swaprouter.SwapConfig
+	22, // 34: swaprouter.RouterSwap.GetFeeConfig:output_type -> swaprouter.FeeConfig
+	21, // [21:35] is the sub-list for method output_type
+	7,  // [7:21] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_grpcapi_pb_swaprouter_proto_init() }
+func file_rpc_grpcapi_pb_swaprouter_proto_init() {
+	if File_rpc_grpcapi_pb_swaprouter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERC20SwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyCallSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatusFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatusUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_grpcapi_pb_swaprouter_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_grpcapi_pb_swaprouter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpc_grpcapi_pb_swaprouter_proto_goTypes,
+		DependencyIndexes: file_rpc_grpcapi_pb_swaprouter_proto_depIdxs,
+		MessageInfos:      file_rpc_grpcapi_pb_swaprouter_proto_msgTypes,
+	}.Build()
+	File_rpc_grpcapi_pb_swaprouter_proto = out.File
+	file_rpc_grpcapi_pb_swaprouter_proto_rawDesc = nil
+	file_rpc_grpcapi_pb_swaprouter_proto_goTypes = nil
+	file_rpc_grpcapi_pb_swaprouter_proto_depIdxs = nil
+}
@@ -0,0 +1,279 @@
+// Package grpcapi provides gRPC service of the api server.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/didip/tollbooth/v6"
+	"github.com/didip/tollbooth/v6/limiter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/internal/swapapi"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/rpc/grpcapi/pb"
+	"github.com/deltaswapio/swaprouter/v3/worker"
+)
+
+// RouterSwapServer implements the gRPC service by the 'internal/swapapi' functions
+type RouterSwapServer struct {
+	pb.UnimplementedRouterSwapServer
+}
+
+// StartGRPCServer start gRPC server,
+// requests are limited per remote IP by `maxRequestsLimit` per second
+// the same as the JSON RPC service (a stream is counted when opened)
+func StartGRPCServer(port, maxRequestsLimit int) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%v", port))
+	if err != nil {
+		log.Fatal("gRPC service listen failed", "port", port, "err", err)
+	}
+	lmt := tollbooth.NewLimiter(float64(maxRequestsLimit),
+		&limiter.ExpirableOptions{
+			DefaultExpirationTTL: 600 * time.Second,
+		},
+	)
+	svr := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := checkRequestLimit(ctx, lmt); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := checkRequestLimit(ss.Context(), lmt); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	pb.RegisterRouterSwapServer(svr, new(RouterSwapServer))
+
+	log.Info("gRPC service listen and serving", "port", port)
+	go func() {
+		if err := svr.Serve(listener); err != nil {
+			if errors.Is(err, grpc.ErrServerStopped) && utils.IsCleanuping() {
+				return
+			}
+			log.Fatal("gRPC service serve failed", "err", err)
+		}
+	}()
+
+	utils.TopWaitGroup.Add(1)
+	go utils.WaitAndCleanup(func() { doCleanup(svr) })
+}
+
+func doCleanup(svr *grpc.Server) {
+	defer utils.TopWaitGroup.Done()
+	stopped := make(chan struct{})
+	go func() {
+		svr.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(3 * time.Second):
+		svr.Stop() // close the streaming subscriptions
+	}
+	log.Info("Close gRPC server success")
+}
+
+func getRemoteIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func checkRequestLimit(ctx context.Context, lmt *limiter.Limiter) error {
+	remoteIP := getRemoteIP(ctx)
+	if httpErr := tollbooth.LimitByKeys(lmt, []string{remoteIP}); httpErr != nil {
+		log.Warnf("grpc limit reached: %v\n", remoteIP)
+		return status.Error(codes.ResourceExhausted, httpErr.Message)
+	}
+	return nil
+}
+
+func toGRPCError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, mongodb.ErrSwapNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
+
+func bigIntsToStrings(bis []*big.Int) []string {
+	result := make([]string, len(bis))
+	for i, bi := range bis {
+		result[i] = bi.String()
+	}
+	return result
+}
+
+// GetVersionInfo api
+func (s *RouterSwapServer) GetVersionInfo(ctx context.Context, req *pb.Empty) (*pb.VersionInfo, error) {
+	return &pb.VersionInfo{Version: params.VersionWithMeta}, nil
+}
+
+// GetServerInfo api
+func (s *RouterSwapServer) GetServerInfo(ctx context.Context, req *pb.Empty) (*pb.ServerInfo, error) {
+	info := swapapi.GetServerInfo()
+	return &pb.ServerInfo{
+		Identifier:     info.Identifier,
+		Version:        info.Version,
+		AllChainIds:    bigIntsToStrings(info.AllChainIDs),
+		PausedChainIds: bigIntsToStrings(info.PausedChainIDs),
+		StaleConfig:    info.StaleConfig,
+		SnapshotBlock:  info.SnapshotBlock,
+	}, nil
+}
+
+// GetStatusInfo api
+func (s *RouterSwapServer) GetStatusInfo(ctx context.Context, req *pb.StatusInfoRequest) (*pb.StatusInfo, error) {
+	res, err := swapapi.GetStatusInfo(req.Statuses)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	res2, err := convertStatusInfo(res)
+	return res2, toGRPCError(err)
+}
+
+// RegisterRouterSwap api
+func (s *RouterSwapServer) RegisterRouterSwap(ctx context.Context, req *pb.SwapKey) (*pb.RegisterResult, error) {
+	res, err := swapapi.RegisterRouterSwap(req.ChainId, req.Txid, req.LogIndex)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	result := &pb.RegisterResult{Results: make(map[int32]string, len(*res))}
+	for logIndex, status := range *res {
+		result.Results[int32(logIndex)] = status
+	}
+	return result, nil
+}
+
+// GetRouterSwap api
+func (s *RouterSwapServer) GetRouterSwap(ctx context.Context, req *pb.SwapKey) (*pb.SwapInfo, error) {
+	res, err := swapapi.GetRouterSwap(req.ChainId, req.Txid, req.LogIndex)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertSwapInfo(res), nil
+}
+
+// GetRouterSwaps api
+func (s *RouterSwapServer) GetRouterSwaps(ctx context.Context, req *pb.SwapKey) (*pb.SwapInfoList, error) {
+	res, err := swapapi.GetRouterSwaps(req.ChainId, req.Txid)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertSwapInfos(res), nil
+}
+
+// GetRouterSwapHistory api
+func (s *RouterSwapServer) GetRouterSwapHistory(ctx context.Context, req *pb.SwapHistoryRequest) (*pb.SwapInfoList, error) {
+	res, err := swapapi.GetRouterSwapHistory(req.ChainId, req.Address, int(req.Offset), int(req.Limit), req.Status)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertSwapInfos(res), nil
+}
+
+// SubscribeSwapStatus api
+// streams swap status updates, resume by 'from_id' after reconnecting
+func (s *RouterSwapServer) SubscribeSwapStatus(req *pb.SwapStatusFilter, stream pb.RouterSwap_SubscribeSwapStatusServer) error {
+	filter := &worker.SwapStatusFilter{
+		FromChainID: req.ChainId,
+		TxID:        req.Txid,
+		Address:     req.Address,
+		TokenID:     req.TokenId,
+	}
+	sub, backlog, err := swapapi.SubscribeSwapStatus(filter, req.FromId)
+	if err != nil {
+		return toGRPCError(err)
+	}
+	defer sub.Unsubscribe()
+
+	for _, update := range backlog {
+		if err = stream.Send(convertSwapStatusUpdate(update)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-sub.Chan():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "too slow, resume by the last id")
+			}
+			if err = stream.Send(convertSwapStatusUpdate(swapapi.ConvertSwapStatusEvent(event))); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetAllChainIDs api
+func (s *RouterSwapServer) GetAllChainIDs(ctx context.Context, req *pb.Empty) (*pb.StringList, error) {
+	return &pb.StringList{Items: bigIntsToStrings(router.AllChainIDs)}, nil
+}
+
+// GetAllTokenIDs api
+func (s *RouterSwapServer) GetAllTokenIDs(ctx context.Context, req *pb.Empty) (*pb.StringList, error) {
+	return &pb.StringList{Items: router.AllTokenIDs}, nil
+}
+
+// GetChainConfig api
+func (s *RouterSwapServer) GetChainConfig(ctx context.Context, req *pb.ChainConfigRequest) (*pb.ChainConfig, error) {
+	res, err := swapapi.GetChainConfig(req.ChainId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertChainConfig(res), nil
+}
+
+// GetTokenConfig api
+func (s *RouterSwapServer) GetTokenConfig(ctx context.Context, req *pb.TokenConfigRequest) (*pb.TokenConfig, error) {
+	res, err := swapapi.GetTokenConfig(req.ChainId, req.Address)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertTokenConfig(res), nil
+}
+
+// GetSwapConfig api
+func (s *RouterSwapServer) GetSwapConfig(ctx context.Context, req *pb.SwapConfigRequest) (*pb.SwapConfig, error) {
+	res, err := swapapi.GetSwapConfig(req.TokenId, req.FromChainId, req.ToChainId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertSwapConfig(res), nil
+}
+
+// GetFeeConfig api
+func (s *RouterSwapServer) GetFeeConfig(ctx context.Context, req *pb.SwapConfigRequest) (*pb.FeeConfig, error) {
+	res, err := swapapi.GetFeeConfig(req.TokenId, req.FromChainId, req.ToChainId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return convertFeeConfig(res), nil
+}
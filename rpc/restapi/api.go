@@ -123,6 +123,13 @@ func GetRouterSwapHandler(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, res, err)
 }
 
+// GetRouterRefundHandler handler
+func GetRouterRefundHandler(w http.ResponseWriter, r *http.Request) {
+	chainID, txid, logIndex := getRouterSwapKeys(r)
+	res, err := swapapi.GetRouterRefund(chainID, txid, logIndex)
+	writeResponse(w, res, err)
+}
+
 // GetRouterSwapsHandler handler
 func GetRouterSwapsHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -194,6 +201,45 @@ func GetAllMultichainTokensHandler(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, allMultichainTokens, nil)
 }
 
+// GetTokenLiquidityHandler handler
+func GetTokenLiquidityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tokenID := vars["tokenid"]
+	res, err := swapapi.GetTokenLiquidity(tokenID)
+	writeResponse(w, res, err)
+}
+
+// GetAccountResourceHandler handler
+func GetAccountResourceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chainID := vars["chainid"]
+	account := vars["account"]
+	res, err := swapapi.GetAccountResource(chainID, account)
+	writeResponse(w, res, err)
+}
+
+// GetFailureStatsHandler handler
+func GetFailureStatsHandler(w http.ResponseWriter, r *http.Request) {
+	res := swapapi.GetFailureStats()
+	writeResponse(w, res, nil)
+}
+
+// GetConfigChangesHandler handler
+func GetConfigChangesHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := swapapi.GetConfigChanges()
+	writeResponse(w, res, err)
+}
+
+// GetTrustlineHandler handler
+func GetTrustlineHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	chainID := vars["chainid"]
+	tokenID := vars["tokenid"]
+	receiver := vars["receiver"]
+	res, err := swapapi.GetTrustline(chainID, tokenID, receiver)
+	writeResponse(w, res, err)
+}
+
 // GetChainConfigHandler handler
 func GetChainConfigHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -0,0 +1,209 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/internal/swapapi"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/worker"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	swapStreamPingInterval = 30 * time.Second
+	swapStreamWriteTimeout = 10 * time.Second
+	swapStreamPongWait     = 2 * swapStreamPingInterval
+)
+
+var swapStreamUpgrader = websocket.Upgrader{
+	HandshakeTimeout: 10 * time.Second,
+	CheckOrigin:      checkSwapStreamOrigin,
+}
+
+func checkSwapStreamOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	allowedOrigins := params.GetRouterServerConfig().APIServer.AllowedOrigins
+	if origin == "" || len(allowedOrigins) == 0 {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSwapStreamArgs get filter and resume id ('<epoch>-<seq>') from query,
+// the 'Last-Event-ID' header of sse reconnecting takes precedence over 'fromid'.
+func getSwapStreamArgs(r *http.Request) (filter *worker.SwapStatusFilter, fromID string) {
+	vals := r.URL.Query()
+	filter = &worker.SwapStatusFilter{
+		FromChainID: vals.Get("chainid"),
+		TxID:        vals.Get("txid"),
+		Address:     vals.Get("address"),
+		TokenID:     vals.Get("tokenid"),
+	}
+	fromID = r.Header.Get("Last-Event-ID")
+	if fromID == "" {
+		fromID = vals.Get("fromid")
+	}
+	return filter, fromID
+}
+
+// setWriteDeadline extend the write deadline of the server (WriteTimeout) for streaming,
+// the response writer supports it since go1.20 (see http.ResponseController).
+func setWriteDeadline(w http.ResponseWriter, deadline time.Time) bool {
+	for {
+		switch rw := w.(type) {
+		case interface{ SetWriteDeadline(time.Time) error }:
+			return rw.SetWriteDeadline(deadline) == nil
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = rw.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
+// SwapStreamHandler handler
+// streams swap status updates as server-sent events
+func SwapStreamHandler(w http.ResponseWriter, r *http.Request) {
+	filter, fromID := getSwapStreamArgs(r)
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeErrResponse(w, errors.New("streaming is not supported"))
+		return
+	}
+	if !setWriteDeadline(w, time.Now().Add(swapStreamWriteTimeout)) {
+		writeErrResponse(w, errors.New("streaming write deadline is not supported"))
+		return
+	}
+	sub, backlog, err := swapapi.SubscribeSwapStatus(filter, fromID)
+	if err != nil {
+		writeErrResponse(w, err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for _, update := range backlog {
+		if err = writeSwapStreamEvent(w, update); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(swapStreamPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Chan():
+			_ = setWriteDeadline(w, time.Now().Add(swapStreamWriteTimeout))
+			if !ok {
+				return // too slow, the client should resume by last event id
+			}
+			if err = writeSwapStreamEvent(w, swapapi.ConvertSwapStatusEvent(event)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = setWriteDeadline(w, time.Now().Add(swapStreamWriteTimeout))
+			if _, err = fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeSwapStreamEvent(w http.ResponseWriter, update *swapapi.SwapStatusUpdate) error {
+	data, err := json.Marshal(update.Swap)
+	if err != nil {
+		log.Warn("marshal swap stream event failed", "seq", update.Seq, "err", err)
+		return nil
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: swap\ndata: %s\n\n", update.ID, data)
+	return err
+}
+
+// SwapStreamWebsocketHandler handler
+// streams swap status updates by websocket
+func SwapStreamWebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	filter, fromID := getSwapStreamArgs(r)
+	sub, backlog, err := swapapi.SubscribeSwapStatus(filter, fromID)
+	if err != nil {
+		writeErrResponse(w, err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	conn, err := swapStreamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("upgrade swap stream websocket failed", "err", err)
+		return
+	}
+	defer conn.Close()
+
+	// read pump to handle pong and close messages
+	closed := make(chan struct{})
+	_ = conn.SetReadDeadline(time.Now().Add(swapStreamPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(swapStreamPongWait))
+	})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, errr := conn.ReadMessage(); errr != nil {
+				return
+			}
+		}
+	}()
+
+	writeJSON := func(update *swapapi.SwapStatusUpdate) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(swapStreamWriteTimeout))
+		return conn.WriteJSON(update)
+	}
+
+	for _, update := range backlog {
+		if err = writeJSON(update); err != nil {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(swapStreamPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-sub.Chan():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow, resume by the last id"),
+					time.Now().Add(swapStreamWriteTimeout))
+				return
+			}
+			if err = writeJSON(swapapi.ConvertSwapStatusEvent(event)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(swapStreamWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
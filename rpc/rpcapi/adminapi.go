@@ -14,6 +14,7 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/mongodb"
 	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/router/bridge"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
 	"github.com/deltaswapio/swaprouter/v3/worker"
 )
@@ -25,6 +26,8 @@ const (
 	replaceswapCmd          = "replaceswap"
 	forbidSwapCmd           = "forbidswap"
 	passForbiddenSwapoutCmd = "passforbiddenswapout"
+	approveRefundCmd        = admin.ApproveRefundMethod
+	confirmConfigChangeCmd  = "confirmconfigchange"
 
 	// maintain actions
 	actPause       = "pause"
@@ -53,7 +56,7 @@ func (s *RouterSwapAPI) AdminCall(r *http.Request, rawTx, result *string) (err e
 	senderAddress := sender.String()
 	if !params.IsRouterAdmin(senderAddress) {
 		switch args.Method {
-		case reswapCmd, passForbiddenSwapoutCmd:
+		case reswapCmd, passForbiddenSwapoutCmd, approveRefundCmd, confirmConfigChangeCmd:
 			return fmt.Errorf("sender %v is not admin", senderAddress)
 		case maintainCmd:
 			action := args.Params[0]
@@ -70,10 +73,10 @@ func (s *RouterSwapAPI) AdminCall(r *http.Request, rawTx, result *string) (err e
 		}
 	}
 	log.Info("admin call", "caller", senderAddress, "args", args, "result", result)
-	return doRouterAdminCall(args, result)
+	return doRouterAdminCall(args, *rawTx, result)
 }
 
-func doRouterAdminCall(args *admin.CallArgs, result *string) error {
+func doRouterAdminCall(args *admin.CallArgs, rawTx string, result *string) error {
 	switch args.Method {
 	case maintainCmd:
 		return maintain(args, result)
@@ -87,6 +90,10 @@ func doRouterAdminCall(args *admin.CallArgs, result *string) error {
 		return routerForbidSwap(args, result)
 	case passForbiddenSwapoutCmd:
 		return routerPassForbiddenSwapout(args, result)
+	case approveRefundCmd:
+		return routerApproveRefund(args, rawTx, result)
+	case confirmConfigChangeCmd:
+		return routerConfirmConfigChange(args, result)
 	default:
 		return fmt.Errorf("unknown admin method '%v'", args.Method)
 	}
@@ -294,6 +301,10 @@ func routerPassForbiddenSwapout(args *admin.CallArgs, result *string) (err error
 	if !errors.Is(err, tokens.ErrSwapoutForbidden) {
 		return fmt.Errorf("verify error mismatch, %v", err)
 	}
+	refund, err := mongodb.FindRouterRefund(chainID, txid, logIndex)
+	if err == nil && refund.Status != mongodb.RefundPending {
+		return fmt.Errorf("forbid pass swapout with refund status %v", refund.Status.String())
+	}
 	err = mongodb.RouterAdminPassForbiddenSwapout(chainID, txid, logIndex)
 	if err != nil {
 		return err
@@ -301,3 +312,74 @@ func routerPassForbiddenSwapout(args *admin.CallArgs, result *string) (err error
 	*result = successReuslt
 	return nil
 }
+
+// routerApproveRefund approve refund, the signed call is kept as approval
+// of refunding swap made fail manually, which oracles will verify.
+func routerApproveRefund(args *admin.CallArgs, rawTx string, result *string) (err error) {
+	if params.GetRefundConfig() == nil {
+		return tokens.ErrRefundNotAllowed
+	}
+	chainID, txid, logIndex, err := getKeys(args, 0)
+	if err != nil {
+		return err
+	}
+	swap, err := mongodb.FindRouterSwap(chainID, txid, logIndex)
+	if err != nil {
+		return err
+	}
+	if !swap.Status.IsRefundableStatus() {
+		return fmt.Errorf("swap status %v is not refundable", swap.Status.String())
+	}
+	var approval string
+	if swap.Status == mongodb.ManualMakeFail {
+		err = worker.VerifyManualRefundApproval(rawTx, chainID, txid, logIndex)
+		if err != nil {
+			return err
+		}
+		approval = rawTx
+	}
+	err = mongodb.ApproveRouterRefund(chainID, txid, logIndex, approval)
+	if err != nil {
+		return err
+	}
+	*result = successReuslt
+	return nil
+}
+
+func routerConfirmConfigChange(args *admin.CallArgs, result *string) (err error) {
+	if len(args.Params) != 1 {
+		return fmt.Errorf("wrong number of params, have %v want 1", len(args.Params))
+	}
+	changeKey := strings.ToLower(args.Params[0])
+	if changeKey == "all" {
+		changes, errf := mongodb.FindHeldConfigChangesWithStatus(mongodb.ConfigChangeHeld)
+		if errf != nil {
+			return errf
+		}
+		if len(changes) == 0 {
+			return errors.New("no held config change")
+		}
+		for _, change := range changes {
+			err = mongodb.UpdateHeldConfigChangeStatus(change.Key, mongodb.ConfigChangeConfirmed)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		change, errf := mongodb.FindHeldConfigChange(changeKey)
+		if errf != nil {
+			return errf
+		}
+		if change.Status != mongodb.ConfigChangeHeld {
+			return fmt.Errorf("config change status %v is not held", change.Status.String())
+		}
+		err = mongodb.UpdateHeldConfigChangeStatus(changeKey, mongodb.ConfigChangeConfirmed)
+		if err != nil {
+			return err
+		}
+	}
+	// apply confirmed changes
+	go bridge.ReloadRouterConfig()
+	*result = successReuslt
+	return nil
+}
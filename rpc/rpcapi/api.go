@@ -2,15 +2,14 @@
 package rpcapi
 
 import (
-	"fmt"
 	"math/big"
 	"net/http"
-	"time"
 
 	"github.com/deltaswapio/swaprouter/v3/internal/swapapi"
 	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/worker"
 )
 
 // RouterSwapAPI rpc api handler
@@ -61,21 +60,11 @@ func (s *RouterSwapAPI) GetStatusInfo(r *http.Request, statuses *string, result
 }
 
 // OracleInfoArgs args
-type OracleInfoArgs struct {
-	Enode     string `json:"enode"`
-	Timestamp int64  `json:"timestamp"`
-}
-
-func (args *OracleInfoArgs) toOracleInfo() *swapapi.OracleInfo {
-	return &swapapi.OracleInfo{
-		Heartbeat:          time.Unix(args.Timestamp, 0).Format(time.RFC3339),
-		HeartbeatTimestamp: args.Timestamp,
-	}
-}
+type OracleInfoArgs = worker.OracleReport
 
 // ReportOracleInfo api
 func (s *RouterSwapAPI) ReportOracleInfo(r *http.Request, args *OracleInfoArgs, result *string) error {
-	err := swapapi.ReportOracleInfo(args.Enode, args.toOracleInfo())
+	err := swapapi.ReportOracleInfo(args)
 	if err != nil {
 		return err
 	}
@@ -101,6 +90,15 @@ func (s *RouterSwapAPI) GetRouterSwap(r *http.Request, args *RouterSwapKeyArgs,
 	return err
 }
 
+// GetRouterRefund api
+func (s *RouterSwapAPI) GetRouterRefund(r *http.Request, args *RouterSwapKeyArgs, result *swapapi.RefundInfo) error {
+	res, err := swapapi.GetRouterRefund(args.ChainID, args.TxID, args.LogIndex)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
 // GetRouterSwaps api
 func (s *RouterSwapAPI) GetRouterSwaps(r *http.Request, args *RouterSwapKeyArgs, result *[]*swapapi.SwapInfo) error {
 	res, err := swapapi.GetRouterSwaps(args.ChainID, args.TxID)
@@ -149,19 +147,70 @@ func (s *RouterSwapAPI) GetAllMultichainTokens(r *http.Request, args *string, re
 	return nil
 }
 
+// GetTokenLiquidity api
+// nolint:gocritic // rpc need result of pointer type
+func (s *RouterSwapAPI) GetTokenLiquidity(r *http.Request, args *string, result *[]*swapapi.TokenLiquidity) error {
+	tokenID := *args
+	res, err := swapapi.GetTokenLiquidity(tokenID)
+	if err == nil && res != nil {
+		*result = res
+	}
+	return err
+}
+
+// GetAccountResourceArgs args
+type GetAccountResourceArgs struct {
+	ChainID string `json:"chainid"`
+	Account string `json:"account"`
+}
+
+// GetAccountResource api
+func (s *RouterSwapAPI) GetAccountResource(r *http.Request, args *GetAccountResourceArgs, result *tokens.AccountResource) error {
+	res, err := swapapi.GetAccountResource(args.ChainID, args.Account)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
+// GetFailureStats api
+func (s *RouterSwapAPI) GetFailureStats(r *http.Request, args *RPCNullArgs, result *map[tokens.FailureCategory]uint64) error {
+	*result = swapapi.GetFailureStats()
+	return nil
+}
+
+// GetConfigChanges api
+func (s *RouterSwapAPI) GetConfigChanges(r *http.Request, args *RPCNullArgs, result *swapapi.ConfigChanges) error {
+	res, err := swapapi.GetConfigChanges()
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
+// GetTrustlineArgs args
+type GetTrustlineArgs struct {
+	ChainID  string `json:"chainid"`
+	TokenID  string `json:"tokenid"`
+	Receiver string `json:"receiver"`
+}
+
+// GetTrustline api
+func (s *RouterSwapAPI) GetTrustline(r *http.Request, args *GetTrustlineArgs, result *tokens.TrustlineInfo) error {
+	res, err := swapapi.GetTrustline(args.ChainID, args.TokenID, args.Receiver)
+	if err == nil && res != nil {
+		*result = *res
+	}
+	return err
+}
+
 // GetChainConfig api
 func (s *RouterSwapAPI) GetChainConfig(r *http.Request, args *string, result *swapapi.ChainConfig) error {
-	chainID := *args
-	bridge := router.GetBridgeByChainID(chainID)
-	if bridge == nil {
-		return fmt.Errorf("chainID %v not exist", chainID)
-	}
-	chainConfig := swapapi.ConvertChainConfig(bridge.GetChainConfig())
-	if chainConfig != nil {
-		*result = *chainConfig
-		return nil
+	res, err := swapapi.GetChainConfig(*args)
+	if err == nil && res != nil {
+		*result = *res
 	}
-	return fmt.Errorf("chain config not found")
+	return err
 }
 
 // GetTokenConfigArgs args
@@ -172,21 +221,11 @@ type GetTokenConfigArgs struct {
 
 // GetTokenConfig api
 func (s *RouterSwapAPI) GetTokenConfig(r *http.Request, args *GetTokenConfigArgs, result *swapapi.TokenConfig) error {
-	chainID := args.ChainID
-	address := args.Address
-	bridge := router.GetBridgeByChainID(chainID)
-	if bridge == nil {
-		return fmt.Errorf("chainID %v not exist", chainID)
-	}
-	tokenConfig := swapapi.ConvertTokenConfig(bridge.GetTokenConfig(address))
-	if tokenConfig != nil {
-		*result = *tokenConfig
-		if result.RouterContract == "" {
-			result.RouterContract = bridge.GetChainConfig().RouterContract
-		}
-		return nil
+	res, err := swapapi.GetTokenConfig(args.ChainID, args.Address)
+	if err == nil && res != nil {
+		*result = *res
 	}
-	return fmt.Errorf("token config not found")
+	return err
 }
 
 // GetSwapConfigArgs args
@@ -198,20 +237,18 @@ type GetSwapConfigArgs struct {
 
 // GetSwapConfig api
 func (s *RouterSwapAPI) GetSwapConfig(r *http.Request, args *GetSwapConfigArgs, result *swapapi.SwapConfig) error {
-	swapConfig := swapapi.ConvertSwapConfig(tokens.GetSwapConfig(args.TokenID, args.FromChainID, args.ToChainID))
-	if swapConfig != nil {
-		*result = *swapConfig
-		return nil
+	res, err := swapapi.GetSwapConfig(args.TokenID, args.FromChainID, args.ToChainID)
+	if err == nil && res != nil {
+		*result = *res
 	}
-	return fmt.Errorf("swap config not found")
+	return err
 }
 
 // GetFeeConfig api
 func (s *RouterSwapAPI) GetFeeConfig(r *http.Request, args *GetSwapConfigArgs, result *swapapi.FeeConfig) error {
-	feeConfig := swapapi.ConvertFeeConfig(tokens.GetFeeConfig(args.TokenID, args.FromChainID, args.ToChainID))
-	if feeConfig != nil {
-		*result = *feeConfig
-		return nil
+	res, err := swapapi.GetFeeConfig(args.TokenID, args.FromChainID, args.ToChainID)
+	if err == nil && res != nil {
+		*result = *res
 	}
-	return fmt.Errorf("fee config not found")
+	return err
 }
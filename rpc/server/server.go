@@ -19,6 +19,7 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
 	"github.com/deltaswapio/swaprouter/v3/log"
 	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/grpcapi"
 	"github.com/deltaswapio/swaprouter/v3/rpc/restapi"
 	"github.com/deltaswapio/swaprouter/v3/rpc/rpcapi"
 )
@@ -77,6 +78,10 @@ func StartAPIServer() {
 	log.Info("JSON RPC service listen and serving finish", "port", apiPort)
 	utils.TopWaitGroup.Add(1)
 	go utils.WaitAndCleanup(func() { doCleanup(&svr) })
+
+	if apiServer.GRPCPort > 0 {
+		grpcapi.StartGRPCServer(apiServer.GRPCPort, maxRequestsLimit)
+	}
 }
 
 // StartTestServer start api test server
@@ -137,11 +142,20 @@ func initRouterSwapRouter(r *mux.Router) {
 	r.HandleFunc("/swap/status/{chainid}/{txid}", restapi.GetRouterSwapHandler).Methods("GET")
 	r.HandleFunc("/swap/status/{chainid}/{txid}/all", restapi.GetRouterSwapsHandler).Methods("GET")
 	r.HandleFunc("/swap/history/{chainid}/{address}", restapi.GetRouterSwapHistoryHandler).Methods("GET")
+	r.HandleFunc("/swap/stream", restapi.SwapStreamHandler).Methods("GET")
+	r.HandleFunc("/swap/ws", restapi.SwapStreamWebsocketHandler).Methods("GET")
+	r.HandleFunc("/refund/{chainid}/{txid}", restapi.GetRouterRefundHandler).Methods("GET")
 
 	r.HandleFunc("/allchainids", restapi.GetAllChainIDsHandler).Methods("GET")
 	r.HandleFunc("/alltokenids", restapi.GetAllTokenIDsHandler).Methods("GET")
 	r.HandleFunc("/allmultichaintokens/{tokenid}", restapi.GetAllMultichainTokensHandler).Methods("GET")
+	r.HandleFunc("/liquidity/{tokenid}", restapi.GetTokenLiquidityHandler).Methods("GET")
 	r.HandleFunc("/chainconfig/{chainid}", restapi.GetChainConfigHandler).Methods("GET")
+	r.HandleFunc("/resource/{chainid}", restapi.GetAccountResourceHandler).Methods("GET")
+	r.HandleFunc("/resource/{chainid}/{account}", restapi.GetAccountResourceHandler).Methods("GET")
+	r.HandleFunc("/trustline/{chainid}/{tokenid}/{receiver}", restapi.GetTrustlineHandler).Methods("GET")
+	r.HandleFunc("/failurestats", restapi.GetFailureStatsHandler).Methods("GET")
+	r.HandleFunc("/configchanges", restapi.GetConfigChangesHandler).Methods("GET")
 	r.HandleFunc("/tokenconfig/{chainid}/{address:.*}", restapi.GetTokenConfigHandler).Methods("GET")
 	r.HandleFunc("/swapconfig/{tokenid}/{fromchainid}/{tochainid}", restapi.GetSwapConfigHandler).Methods("GET")
 	r.HandleFunc("/feeconfig/{tokenid}/{fromchainid}/{tochainid}", restapi.GetFeeConfigHandler).Methods("GET")
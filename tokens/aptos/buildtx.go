@@ -36,7 +36,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, errors.New("forbid empty sender")
 	}
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
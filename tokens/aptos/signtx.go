@@ -54,7 +54,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
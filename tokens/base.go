@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	routerSwapType SwapType
+	routerSwapType  SwapType              // default swap type
+	routerSwapTypes = map[SwapType]bool{} // enabled swap types
 
 	swapConfigMap    = new(sync.Map) // key is tokenID,fromChainID,toChainID
 	feeConfigMap     = new(sync.Map) // key is tokenID,fromChainID,toChainID
@@ -36,44 +37,90 @@ func IsNativeCoin(name string) bool {
 	return strings.EqualFold(name, "native")
 }
 
-// InitRouterSwapType init router swap type
+// InitRouterSwapTypes init router swap types (the first is the default)
 //
 //nolint:goconst // allow dupl constant string
-func InitRouterSwapType(swapTypeStr string) {
-	switch strings.ToLower(swapTypeStr) {
-	case "erc20swap":
-		routerSwapType = ERC20SwapType
-	case "nftswap":
-		routerSwapType = NFTSwapType
-	case "anycallswap":
-		routerSwapType = AnyCallSwapType
-		if !IsValidAnycallSubType(params.GetSwapSubType()) {
-			log.Fatalf("invalid anycall sub type '%v'", params.GetSwapSubType())
+func InitRouterSwapTypes(swapTypeStrs []string) {
+	if len(swapTypeStrs) == 0 {
+		log.Fatal("empty router swap type")
+	}
+	routerSwapTypes = make(map[SwapType]bool, len(swapTypeStrs))
+	for i, swapTypeStr := range swapTypeStrs {
+		var swapType SwapType
+		switch strings.ToLower(swapTypeStr) {
+		case "erc20swap":
+			swapType = ERC20SwapType
+		case "nftswap":
+			swapType = NFTSwapType
+		case "anycallswap":
+			swapType = AnyCallSwapType
+			if !IsValidAnycallSubType(params.GetSwapSubType()) {
+				log.Fatalf("invalid anycall sub type '%v'", params.GetSwapSubType())
+			}
+		default:
+			log.Fatalf("invalid router swap type '%v'", swapTypeStr)
+		}
+		if i == 0 {
+			routerSwapType = swapType
 		}
-	default:
-		log.Fatalf("invalid router swap type '%v'", swapTypeStr)
+		routerSwapTypes[swapType] = true
 	}
-	log.Info("init router swap type success", "swaptype", routerSwapType.String())
+	log.Info("init router swap type success", "swaptype", routerSwapType.String(), "swaptypes", swapTypeStrs)
 }
 
-// GetRouterSwapType get router swap type
+// GetRouterSwapType get default router swap type
 func GetRouterSwapType() SwapType {
 	return routerSwapType
 }
 
-// IsERC20Router is erc20 router
+// GetRouterSwapTypes get enabled router swap types (default swap type comes first)
+func GetRouterSwapTypes() []SwapType {
+	swapTypes := []SwapType{routerSwapType}
+	for _, swapType := range []SwapType{ERC20SwapType, NFTSwapType, AnyCallSwapType} {
+		if swapType != routerSwapType && routerSwapTypes[swapType] {
+			swapTypes = append(swapTypes, swapType)
+		}
+	}
+	return swapTypes
+}
+
+// IsSwapTypeEnabled is swap type enabled
+func IsSwapTypeEnabled(swapType SwapType) bool {
+	if swapType == ERC20SwapTypeMixPool {
+		swapType = ERC20SwapType
+	}
+	return routerSwapTypes[swapType]
+}
+
+// IsERC20Router is erc20 swap type enabled
 func IsERC20Router() bool {
-	return routerSwapType == ERC20SwapType
+	return routerSwapTypes[ERC20SwapType]
 }
 
-// IsNFTRouter is nft router
+// IsNFTRouter is nft swap type enabled
 func IsNFTRouter() bool {
-	return routerSwapType == NFTSwapType
+	return routerSwapTypes[NFTSwapType]
 }
 
-// IsAnyCallRouter is anycall router
+// IsAnyCallRouter is anycall swap type enabled
 func IsAnyCallRouter() bool {
-	return routerSwapType == AnyCallSwapType
+	return routerSwapTypes[AnyCallSwapType]
+}
+
+// GetTokenSwapType get swap type of token by its router contract,
+// the tokens of nft or anycall router contracts configed in swap type section
+// are of these swap types, others are erc20 tokens if erc20 swap type is enabled.
+func GetTokenSwapType(chainID, routerContract string) SwapType {
+	for _, swapType := range []SwapType{NFTSwapType, AnyCallSwapType} {
+		if routerContract != "" && IsSwapTypeEnabled(swapType) &&
+			strings.EqualFold(GetSwapTypeRouterContract(swapType, chainID), routerContract) {
+			return swapType
+		}
+	}
+	if IsERC20Router() {
+		return ERC20SwapType
+	}
+	return GetRouterSwapType()
 }
 
 // CrossChainBridgeBase base bridge
@@ -192,6 +239,34 @@ func (b *CrossChainBridgeBase) GetRouterContract(token string) string {
 	return b.ChainConfig.RouterContract
 }
 
+// GetSwapTypeRouterContract get router contract of swap type
+// (fallback to the router contract in chain or token config)
+func (b *CrossChainBridgeBase) GetSwapTypeRouterContract(swapType SwapType, token string) string {
+	if routerContract := GetSwapTypeRouterContract(swapType, b.ChainConfig.ChainID); routerContract != "" {
+		return routerContract
+	}
+	return b.GetRouterContract(token)
+}
+
+// GetSwapTypeRouterContract get router contract configed in swap type section
+// (only nft and anycall swap types have their own router contracts)
+func GetSwapTypeRouterContract(swapType SwapType, chainID string) string {
+	if swapType != NFTSwapType && swapType != AnyCallSwapType {
+		return ""
+	}
+	return params.GetSwapTypeRouterContract(swapType.String(), chainID)
+}
+
+// IsSwapTypeRouterContract is router contract configed in nft or anycall swap type section
+func IsSwapTypeRouterContract(chainID, routerContract string) bool {
+	for _, swapType := range []SwapType{NFTSwapType, AnyCallSwapType} {
+		if strings.EqualFold(GetSwapTypeRouterContract(swapType, chainID), routerContract) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRouterVersion get router version
 func (b *CrossChainBridgeBase) GetRouterVersion(token string) string {
 	if token != "" {
@@ -289,7 +364,8 @@ func GetBigValueThreshold(tokenID, fromChainID, toChainID string, fromDecimals u
 	value := ConvertTokenValue(swapCfg.BigValueThreshold, 18, fromDecimals)
 	discount := params.GetLocalChainConfig(fromChainID).BigValueDiscount
 	if discount > 0 && discount < 100 {
-		value.Mul(value, new(big.Int).SetUint64(discount))
+		// do not modify the cached swap config
+		value = new(big.Int).Mul(value, new(big.Int).SetUint64(discount))
 		value.Div(value, big.NewInt(100))
 	}
 	return value
@@ -297,7 +373,7 @@ func GetBigValueThreshold(tokenID, fromChainID, toChainID string, fromDecimals u
 
 // CheckTokenSwapValue check swap value is in right range
 func CheckTokenSwapValue(swapInfo *SwapTxInfo, fromDecimals, toDecimals uint8) bool {
-	if !IsERC20Router() {
+	if swapInfo.SwapType == NFTSwapType || swapInfo.SwapType == AnyCallSwapType {
 		return true
 	}
 	value := swapInfo.Value
@@ -324,15 +400,32 @@ func CheckTokenSwapValue(swapInfo *SwapTxInfo, fromDecimals, toDecimals uint8) b
 	return CalcSwapValue(tokenID, fromChainID, toChainID, value, fromDecimals, toDecimals, swapInfo.From, swapInfo.TxTo).Sign() > 0
 }
 
+// CalcRefundFee calc refund fee
+func CalcRefundFee(value *big.Int) *big.Int {
+	refundCfg := params.GetRefundConfig()
+	if refundCfg == nil || value == nil || refundCfg.FeeRatePerMillion == 0 {
+		return big.NewInt(0)
+	}
+	fee := new(big.Int).Mul(value, new(big.Int).SetUint64(refundCfg.FeeRatePerMillion))
+	return fee.Div(fee, big.NewInt(1000000))
+}
+
 // CalcSwapValue calc swap value (get rid of fee and convert by decimals)
 func CalcSwapValue(tokenID, fromChainID, toChainID string, value *big.Int, fromDecimals, toDecimals uint8, originFrom, originTxTo string) *big.Int {
+	swapValue, _ := CalcSwapValueWithGasDrop(tokenID, fromChainID, toChainID, value, fromDecimals, toDecimals, originFrom, originTxTo, nil)
+	return swapValue
+}
+
+// CalcSwapValueWithGasDrop calc swap value and the gas drop delivered along with it,
+// the gas drop fee is charged from the swap value on the source side.
+func CalcSwapValueWithGasDrop(tokenID, fromChainID, toChainID string, value *big.Int, fromDecimals, toDecimals uint8, originFrom, originTxTo string, gasDropRequest *big.Int) (swapValue, gasDrop *big.Int) {
 	if !IsERC20Router() {
-		return value
+		return value, big.NewInt(0)
 	}
 
 	feeCfg := GetFeeConfig(tokenID, fromChainID, toChainID)
 	if feeCfg == nil {
-		return big.NewInt(0)
+		return big.NewInt(0), big.NewInt(0)
 	}
 
 	swapfeeRatePerMillion := feeCfg.SwapFeeRatePerMillion
@@ -405,13 +498,15 @@ func CalcSwapValue(tokenID, fromChainID, toChainID string, value *big.Int, fromD
 			log.Warn("check swap value failed",
 				"tokenID", tokenID, "fromChainID", fromChainID, "toChainID", toChainID,
 				"value", value, "swapFee", swapFee, "adjustBaseFee", adjustBaseFee)
-			return big.NewInt(0)
+			return big.NewInt(0), big.NewInt(0)
 		}
 
 		valueLeft = new(big.Int).Sub(value, swapFee)
 	}
 
-	return ConvertTokenValue(valueLeft, fromDecimals, toDecimals)
+	valueLeft, gasDrop = chargeGasDropFee(tokenID, toChainID, valueLeft, gasDropRequest, fromDecimals)
+
+	return ConvertTokenValue(valueLeft, fromDecimals, toDecimals), gasDrop
 }
 
 // ToBits calc
@@ -43,7 +43,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, fmt.Errorf("forbid empty sender")
 	}
-	routerMPC, getMpcErr := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, getMpcErr := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if getMpcErr != nil {
 		return nil, getMpcErr
 	}
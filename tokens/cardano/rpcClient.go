@@ -41,6 +41,18 @@ func GetTransactionByHash(url, txHash string) (*Transaction, error) {
 	return &result.Transactions[0], nil
 }
 
+func GetTransactionsInBlockRange(url string, start, end uint64, metadataKey string) ([]Transaction, error) {
+	request := &client.Request{}
+	request.Params = fmt.Sprintf(QueryBlockRange, start, end, metadataKey)
+	request.ID = int(time.Now().UnixNano())
+	request.Timeout = rpcTimeout
+	var result TransactionResult
+	if err := client.CardanoPostRequest(url, request, &result); err != nil {
+		return nil, err
+	}
+	return result.Transactions, nil
+}
+
 func GetUtxosByAddress(url, address string) (*[]Output, error) {
 	request := &client.Request{}
 	request.Params = fmt.Sprintf(QueryOutputs, address)
@@ -0,0 +1,31 @@
+package cardano
+
+import (
+	"strconv"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns txs in blocks [start, end] with swapout metadata (graphql gateway only)
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	useAPI, _ := strconv.ParseBool(params.GetCustom(b.ChainConfig.ChainID, "UseAPI"))
+	if useAPI {
+		return nil, tokens.ErrNotImplemented
+	}
+	var txs []Transaction
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		txs, err = GetTransactionsInBlockRange(url, start, end, MetadataKey)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, tokens.WrapRPCQueryError(err, "GetTransactionsInBlockRange", start, end)
+	}
+	for i := range txs {
+		txHashes = append(txHashes, txs[i].Hash)
+	}
+	return txHashes, nil
+}
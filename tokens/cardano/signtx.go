@@ -27,7 +27,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 		}
 
 		mpcParams := params.GetMPCConfig(b.UseFastMPC)
-		if mpcParams.SignWithPrivateKey {
+		if mpcParams.IsSignWithPrivateKey() {
 			priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 			return b.SignTransactionWithPrivateKey(tx, rawTransaction, args, priKey)
 		}
@@ -162,7 +162,7 @@ func (b *Bridge) MPCSignSwapTransaction(rawTx interface{}, args *tokens.BuildTxA
 		}
 
 		mpcParams := params.GetMPCConfig(b.UseFastMPC)
-		if mpcParams.SignWithPrivateKey {
+		if mpcParams.IsSignWithPrivateKey() {
 			priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 			return b.SignTransactionWithPrivateKey(tx, rawTransaction, args, priKey)
 		}
@@ -77,7 +77,7 @@ func main() {
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
 	var signTx *cardano.SignedTransaction
 
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		signTx, _, _ = b.SignTransactionWithPrivateKey(tx, rawTx, args, priKey)
 	} else {
@@ -110,15 +110,15 @@ func (c *ChainConfig) GetChainID() *big.Int {
 	return c.chainID
 }
 
-// CheckConfig check token config
-func (c *TokenConfig) CheckConfig() error {
+// CheckConfig check token config of swap type (see GetTokenSwapType)
+func (c *TokenConfig) CheckConfig(swapType SwapType) error {
 	if c.TokenID == "" {
 		return errors.New("token must config 'TokenID'")
 	}
 	if c.ContractAddress == "" {
 		return errors.New("token must config 'ContractAddress'")
 	}
-	if !IsERC20Router() && c.Decimals != 0 {
+	if swapType != ERC20SwapType && c.Decimals != 0 {
 		return errors.New("non ERC20 token must config 'Decimals' to 0")
 	}
 	return nil
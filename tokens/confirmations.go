@@ -0,0 +1,42 @@
+package tokens
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+// GetConfirmationTier get the confirmation tier of the swap value (nil if no tiers configed).
+// the swap value is normalized to 18 decimals to compare with 'MaxValue' of tiers,
+// and values above all tiers use the last tier.
+func GetConfirmationTier(swapInfo *SwapTxInfo, fromDecimals uint8) *params.ConfirmationTier {
+	if swapInfo.Value == nil {
+		return nil
+	}
+	tiers := params.GetConfirmationTiers(swapInfo.FromChainID.String(), swapInfo.GetTokenID())
+	if len(tiers) == 0 {
+		return nil
+	}
+	value := ConvertTokenValue(swapInfo.Value, fromDecimals, 18)
+	for _, tier := range tiers {
+		if tier.MaxValue == "" {
+			return tier
+		}
+		maxValue := ToBits(tier.MaxValue, 18)
+		if maxValue != nil && value.Cmp(maxValue) < 0 {
+			return tier
+		}
+	}
+	return tiers[len(tiers)-1]
+}
+
+// CheckTierConfirmations check the swap has the confirmations required by its value tier.
+// the returned error wraps ErrTxNotStable with the pending reason,
+// which is kept stable across passes (without the current confirmations).
+func CheckTierConfirmations(swapInfo *SwapTxInfo, tier *params.ConfirmationTier) error {
+	if tier == nil || swapInfo.Confirmations >= tier.Confirmations {
+		return nil
+	}
+	return fmt.Errorf("%w: wait for %v confirmations of value tier",
+		ErrTxNotStable, tier.Confirmations)
+}
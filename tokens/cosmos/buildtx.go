@@ -41,7 +41,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 		return nil, fmt.Errorf("forbid empty sender")
 	}
 
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 
 const (
 	LatestBlock = "/cosmos/base/tendermint/v1beta1/blocks/latest"
+	BlockByNum  = "/cosmos/base/tendermint/v1beta1/blocks/"
 	TxByHash    = "/cosmos/tx/v1beta1/txs/"
 	AccountInfo = "/cosmos/auth/v1beta1/accounts/"
 	Balances    = "/cosmos/bank/v1beta1/balances/"
@@ -68,6 +69,18 @@ func (b *Bridge) GetLatestBlockNumberOf(apiAddress string) (uint64, error) {
 	}
 }
 
+func (b *Bridge) GetBlockByNumber(blockNumber uint64) (*GetLatestBlockResponse, error) {
+	var result *GetLatestBlockResponse
+	var err error
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		restApi := joinURLPath(url, BlockByNum+fmt.Sprint(blockNumber))
+		if err = client.RPCGet(&result, restApi); err == nil && result.Block != nil {
+			return result, nil
+		}
+	}
+	return nil, wrapRPCQueryError(err, "GetBlockByNumber")
+}
+
 func (b *Bridge) GetChainID() (string, error) {
 	if result, err := b.GRPCGetChainID(); err == nil {
 		return result, nil
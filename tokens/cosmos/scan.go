@@ -0,0 +1,25 @@
+package cosmos
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns all txs in blocks [start, end], swaps are filtered by registering
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	for height := start; height <= end; height++ {
+		block, errt := b.GetBlockByNumber(height)
+		if errt != nil {
+			return txHashes, errt
+		}
+		for _, tx := range block.Block.Data.Txs {
+			txBytes, errd := base64.StdEncoding.DecodeString(tx)
+			if errd != nil {
+				continue
+			}
+			txHashes = append(txHashes, fmt.Sprintf("%X", Sha256Sum(txBytes)))
+		}
+	}
+	return txHashes, nil
+}
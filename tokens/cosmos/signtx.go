@@ -21,7 +21,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 		return nil, txHash, errors.New("wrong raw tx param")
 	} else {
 		mpcParams := params.GetMPCConfig(b.UseFastMPC)
-		if mpcParams.SignWithPrivateKey {
+		if mpcParams.IsSignWithPrivateKey() {
 			priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 			return b.SignTransactionWithPrivateKey(buildRawTx, priKey)
 		}
@@ -19,7 +19,13 @@ type GetLatestBlockResponse struct {
 }
 
 type Block struct {
-	Header Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	Header Header    `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	Data   BlockData `protobuf:"bytes,2,opt,name=data,proto3" json:"data"`
+}
+
+// BlockData contains the base64 encoded txs of a block
+type BlockData struct {
+	Txs []string `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 }
 
 // Header defines the structure of a Tendermint block header.
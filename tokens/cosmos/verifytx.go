@@ -160,7 +160,7 @@ func (b *Bridge) checkTxStatus(txres *GetTxResponse, allowUnstable bool) (txHeig
 
 func ParseMemo(swapInfo *tokens.SwapTxInfo, memo string) error {
 	fields := strings.Split(memo, ":")
-	if len(fields) == 2 {
+	if len(fields) == 2 || len(fields) == 3 {
 		if toChainID, err := common.GetBigIntFromStr(fields[1]); err != nil {
 			return err
 		} else {
@@ -169,6 +169,10 @@ func ParseMemo(swapInfo *tokens.SwapTxInfo, memo string) error {
 				swapInfo.Bind = fields[0]      // Bind
 				swapInfo.ToChainID = toChainID // ToChainID
 				swapInfo.To = swapInfo.Bind    // To
+				if len(fields) == 3 && swapInfo.ERC20SwapInfo != nil {
+					// optional gas drop (native amount on dest chain)
+					_ = tokens.SetGasDropRequest(swapInfo.ERC20SwapInfo, fields[2])
+				}
 				return nil
 			}
 		}
@@ -96,6 +96,16 @@ var (
 	ErrGetBlockNumberByID     = errors.New("get block number by id error")
 	ErrSendTx                 = errors.New("send tx fails")
 	ErrGetAccount             = errors.New("get account fails")
+	ErrInsufficientLiquidity  = errors.New("insufficient liquidity")
+	ErrSourceTxReorged        = errors.New("source tx is reorged")
+	ErrMissTrustline          = errors.New("receiver has no trustline")
+	ErrTxSimulateReverted     = errors.New("tx simulation reverted")
+	ErrTxRevertPermanently    = errors.New("tx will revert permanently")
+	ErrRefundNotSupported     = errors.New("refund not supported")
+	ErrRefundNotAllowed       = errors.New("refund not allowed")
+	ErrGasDropNotSupported    = errors.New("gas drop not supported")
+	ErrGasDropMismatch        = errors.New("gas drop mismatch")
+	ErrQuorumNotReached       = errors.New("gateway quorum not reached")
 )
 
 // errors should register in router swap
@@ -123,6 +133,18 @@ func ShouldRegisterRouterSwapForError(err error) bool {
 	return false
 }
 
+// IsRefundableError is verify error of swaps which can never be delivered
+func IsRefundableError(err error) bool {
+	switch {
+	case errors.Is(err, ErrTxWithWrongPath),
+		errors.Is(err, ErrMissTokenConfig),
+		errors.Is(err, ErrNoUnderlyingToken),
+		errors.Is(err, ErrSwapoutForbidden):
+		return true
+	}
+	return false
+}
+
 // IsRPCQueryOrNotFoundError is rpc or not found error
 func IsRPCQueryOrNotFoundError(err error) bool {
 	return errors.Is(err, ErrRPCQueryError) || errors.Is(err, ErrNotFound)
@@ -0,0 +1,81 @@
+package abicoder
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+)
+
+// revert data selectors
+var (
+	// ErrorSelector selector of `Error(string)`
+	ErrorSelector = common.FromHex("0x08c379a0")
+	// PanicSelector selector of `Panic(uint256)`
+	PanicSelector = common.FromHex("0x4e487b71")
+
+	// key is selector hex, value is error signature
+	customErrors = new(sync.Map)
+)
+
+// RevertReason decoded revert reason
+type RevertReason struct {
+	Reason    string
+	PanicCode *big.Int // not nil if reverted by `Panic(uint256)`
+	Custom    string   // signature of custom error
+}
+
+// String returns the readable reason
+func (r *RevertReason) String() string {
+	switch {
+	case r.PanicCode != nil:
+		return fmt.Sprintf("panic: 0x%x", r.PanicCode)
+	case r.Custom != "":
+		return fmt.Sprintf("custom error: %v %v", r.Custom, r.Reason)
+	default:
+		return r.Reason
+	}
+}
+
+// IsPanic is reverted by `Panic(uint256)`
+func (r *RevertReason) IsPanic() bool {
+	return r.PanicCode != nil
+}
+
+// RegisterCustomError register custom error signature, eg. `SwapIDExist(bytes32)`
+func RegisterCustomError(signature string) {
+	signature = strings.ReplaceAll(signature, " ", "")
+	selector := common.Keccak256Hash([]byte(signature)).Bytes()[:4]
+	customErrors.Store(common.ToHex(selector), signature)
+}
+
+// DecodeRevertReason decode revert data of
+// `Error(string)`, `Panic(uint256)` and registered custom errors
+func DecodeRevertReason(data []byte) (*RevertReason, error) {
+	if len(data) < 4 {
+		return nil, ErrParseDataError
+	}
+	selector, params := data[:4], data[4:]
+	switch {
+	case bytes.Equal(selector, ErrorSelector):
+		reason, err := ParseStringInData(params, 0)
+		if err != nil {
+			return nil, err
+		}
+		return &RevertReason{Reason: reason}, nil
+	case bytes.Equal(selector, PanicSelector):
+		if len(params) < 32 {
+			return nil, ErrParseDataError
+		}
+		return &RevertReason{PanicCode: common.GetBigInt(params, 0, 32)}, nil
+	default:
+		signature, exist := customErrors.Load(common.ToHex(selector))
+		if !exist {
+			return &RevertReason{Custom: common.ToHex(selector), Reason: common.ToHex(params)}, nil
+		}
+		return &RevertReason{Custom: signature.(string), Reason: common.ToHex(params)}, nil
+	}
+}
@@ -0,0 +1,39 @@
+package abicoder
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	// Error("insufficient balance")
+	data := common.FromHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"696e73756666696369656e742062616c616e6365000000000000000000000000")
+	reason, err := DecodeRevertReason(data)
+	if err != nil || reason.Reason != "insufficient balance" || reason.IsPanic() {
+		t.Fatalf("decode error reason failed, reason %v err %v", reason, err)
+	}
+
+	// Panic(0x11) arithmetic overflow
+	data = common.FromHex("0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	reason, err = DecodeRevertReason(data)
+	if err != nil || !reason.IsPanic() || reason.PanicCode.Int64() != 0x11 {
+		t.Fatalf("decode panic reason failed, reason %v err %v", reason, err)
+	}
+
+	RegisterCustomError("SwapIDExist(bytes32)")
+	selector := common.Keccak256Hash([]byte("SwapIDExist(bytes32)")).Bytes()[:4]
+	data = append(selector, make([]byte, 32)...)
+	reason, err = DecodeRevertReason(data)
+	if err != nil || reason.Custom != "SwapIDExist(bytes32)" {
+		t.Fatalf("decode custom error failed, reason %v err %v", reason, err)
+	}
+
+	if _, err = DecodeRevertReason([]byte{0x01}); err == nil {
+		t.Fatalf("decode short data should fail")
+	}
+}
@@ -190,7 +190,7 @@ func (b *Bridge) verifyAnyCallSwapTxLog(swapInfo *tokens.SwapTxInfo, rlog *types
 		return tokens.ErrTxWithRemovedLog
 	}
 
-	routerContract := b.GetRouterContract("")
+	routerContract := b.GetSwapTypeRouterContract(tokens.AnyCallSwapType, "")
 	if !common.IsEqualIgnoreCase(rlog.Address.LowerHex(), routerContract) {
 		log.Warn("tx to address mismatch", "have", rlog.Address.LowerHex(), "want", routerContract, "chainID", b.ChainConfig.ChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex, "err", tokens.ErrTxWithWrongContract)
 		return tokens.ErrTxWithWrongContract
@@ -370,7 +370,7 @@ func (b *Bridge) checkAnyCallSwapInfo(swapInfo *tokens.SwapTxInfo) error {
 			minReserveBudget = defMinReserveBudget
 		}
 		callFrom := getCallFrom(swapInfo)
-		routerContract := b.GetRouterContract("")
+		routerContract := b.GetSwapTypeRouterContract(tokens.AnyCallSwapType, "")
 		var budgetBalance *big.Int
 		var err error
 		for i := 0; i < 3; i++ {
@@ -517,7 +517,7 @@ func (b *Bridge) buildAnyCallSwapTxInput(args *tokens.BuildTxArgs) (err error) {
 
 	args.Input = (*hexutil.Bytes)(&input) // input
 
-	routerContract := b.GetRouterContract("")
+	routerContract := b.GetSwapTypeRouterContract(tokens.AnyCallSwapType, "")
 	args.To = routerContract // to
 	args.SwapValue = big.NewInt(0)
 
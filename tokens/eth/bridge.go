@@ -22,6 +22,12 @@ var (
 	_ tokens.IBridge = &Bridge{}
 	// ensure Bridge impl tokens.NonceSetter
 	_ tokens.NonceSetter = &Bridge{}
+	// ensure Bridge impl tokens.ILiquidityChecker
+	_ tokens.ILiquidityChecker = &Bridge{}
+	// ensure Bridge impl tokens.ISourceTxChecker
+	_ tokens.ISourceTxChecker = &Bridge{}
+	// ensure Bridge impl tokens.IRefundBuilder
+	_ tokens.IRefundBuilder = &Bridge{}
 )
 
 type EvmContractBridge interface {
@@ -65,6 +71,14 @@ func (b *Bridge) InitAfterConfig() {
 			"err", err)
 		return
 	}
+	err = b.checkVariant()
+	if err != nil {
+		logErrFunc("check evm variant failed",
+			"chainID", b.ChainConfig.ChainID,
+			"blockChain", b.ChainConfig.BlockChain,
+			"err", err)
+		return
+	}
 	err = b.initSigner(chainID)
 	if err != nil {
 		logErrFunc("init signer failed",
@@ -74,8 +88,9 @@ func (b *Bridge) InitAfterConfig() {
 		return
 	}
 	if b.NeedsFinalizeAPIAddress() && len(b.GatewayConfig.FinalizeAPIAddress) == 0 {
-		logErrFunc("conflux has no 'FinalizeAPIAddress' gateway to get latest finalized block", "chainID", b.ChainConfig.ChainID)
+		logErrFunc("no 'FinalizeAPIAddress' gateway to get latest finalized block", "chainID", b.ChainConfig.ChainID, "variant", b.Variant().Name)
 	}
+	initRouterCustomErrors()
 }
 
 func (b *Bridge) initSigner(chainID *big.Int) (err error) {
@@ -121,7 +136,7 @@ func (b *Bridge) InitRouterInfo(routerContract, routerVersion string) (err error
 	chainID := b.ChainConfig.ChainID
 	log.Info(fmt.Sprintf("[%5v] start init router info", chainID), "routerContract", routerContract)
 	var routerWNative, routerSecurity string
-	if tokens.IsERC20Router() {
+	if tokens.IsERC20Router() && !tokens.IsSwapTypeRouterContract(chainID, routerContract) {
 		routerWNative, err = b.GetWNativeAddress(routerContract)
 		if err != nil {
 			log.Warn("get router wNative address failed", "chainID", chainID, "routerContract", routerContract, "err", err)
@@ -341,19 +356,17 @@ func (b *Bridge) checkTokenMinter(tokenAddr string, tokenCfg *tokens.TokenConfig
 // then we should overwrite this function
 // NOTE: call after chain config setted
 func (b *Bridge) GetSignerChainID() (*big.Int, error) {
-	switch strings.ToUpper(b.ChainConfig.BlockChain) {
-	default:
-		chainID, err := b.ChainID()
-		if err != nil {
-			return nil, err
-		}
-		if chainID.Sign() != 0 {
-			return chainID, nil
-		}
-		return b.NetworkID()
-	case "ETHCLASSIC":
-		return b.getETCSignerChainID()
+	if v := b.Variant(); v.GetSignerChainID != nil {
+		return v.GetSignerChainID(b)
+	}
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	if chainID.Sign() != 0 {
+		return chainID, nil
 	}
+	return b.NetworkID()
 }
 
 func (b *Bridge) getETCSignerChainID() (*big.Int, error) {
@@ -13,8 +13,6 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
 	"github.com/deltaswapio/swaprouter/v3/types"
-	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/zksync-sdk/zksync2-go"
 )
 
 var (
@@ -46,7 +44,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, fmt.Errorf("forbid empty sender")
 	}
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +73,16 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 		return nil, err
 	}
 
-	return b.buildTx(args)
+	rawTx, err = b.buildTx(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.simulateTx(rawTx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rawTx, nil
 }
 
 func (b *Bridge) buildTx(args *tokens.BuildTxArgs) (rawTx interface{}, err error) {
@@ -139,29 +146,8 @@ func (b *Bridge) buildTx(args *tokens.BuildTxArgs) (rawTx interface{}, err error
 	}
 	cachedNonce[key] = nonce
 
-	if b.IsZKSync() {
-		chainId, _ := new(big.Int).SetString(b.ChainConfig.ChainID, 0)
-		tx := zksync2.CreateFunctionCallTransaction(
-			ethcommon.HexToAddress(args.From),
-			ethcommon.HexToAddress(to.Hex()),
-			big.NewInt(0),
-			big.NewInt(0),
-			value,
-			[]byte(input),
-			nil, nil,
-		)
-		rawTx = zksync2.NewTransaction712(
-			chainId,
-			big.NewInt(int64(nonce)),
-			big.NewInt(int64(gasLimit)),
-			ethcommon.HexToAddress(to.Hex()),
-			value,
-			input,
-			big.NewInt(100000000), // TODO: Estimate correct one
-			gasPrice,
-			ethcommon.HexToAddress(args.From),
-			tx.Eip712Meta,
-		)
+	if v := b.Variant(); v.NewRawTx != nil {
+		rawTx = v.NewRawTx(b, args, nonce, gasLimit, to, value, gasPrice, input)
 	} else if isDynamicFeeTx {
 		rawTx = types.NewDynamicFeeTx(b.SignerChainID, nonce, &to, value, gasLimit, gasTipCap, gasFeeCap, input, nil)
 	} else {
@@ -228,7 +214,7 @@ func (b *Bridge) setDefaults(args *tokens.BuildTxArgs) (err error) {
 		extra.GasTipCap = nil
 		extra.GasFeeCap = nil
 	}
-	if extra.Gas == nil && b.IsSapphireChain() {
+	if extra.Gas == nil && b.Variant().AlwaysEstimateGas {
 		esGasLimit, errf := b.EstimateGas(args.From, args.To, args.Value, *args.Input)
 		if errf != nil {
 			log.Error(fmt.Sprintf("build %s tx estimate gas failed", args.SwapType.String()),
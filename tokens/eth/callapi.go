@@ -20,7 +20,6 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/rpc/client"
 	"github.com/deltaswapio/swaprouter/v3/tokens"
-	"github.com/deltaswapio/swaprouter/v3/tokens/eth/callapi"
 	"github.com/deltaswapio/swaprouter/v3/types"
 
 	ethereum "github.com/ethereum/go-ethereum"
@@ -41,39 +40,10 @@ var (
 	wrapRPCQueryError = tokens.WrapRPCQueryError
 )
 
-// NeedsFinalizeAPIAddress need special finalize api
-func (b *Bridge) NeedsFinalizeAPIAddress() bool {
-	switch b.ChainConfig.ChainID {
-	case "1030", "71":
-		return true
-	default:
-		return false
-	}
-}
-
-func (b *Bridge) IsSapphireChain() bool {
-	chainId := b.ChainConfig.ChainID
-	return chainId == "23294" || chainId == "23295"
-}
-
-func (b *Bridge) IsZKSync() bool {
-	chainId := b.ChainConfig.ChainID
-	return chainId == "280" || chainId == "324"
-}
-
-// GetBlockConfirmations some chain may override this method
+// GetBlockConfirmations some chain variant may override this method
 func (b *Bridge) GetBlockConfirmations(receipt *types.RPCTxReceipt) (uint64, error) {
-	if b.ChainConfig != nil {
-		switch b.ChainConfig.ChainID {
-		case "1285", // kusama moonriver
-			"336": // kusama shiden
-			return callapi.KsmGetBlockConfirmations(b, receipt)
-		case "1030", // conlux mainnet
-			"71": // conflux testnet
-			return callapi.CfxGetBlockConfirmations(b, receipt)
-		case "42161": // arbitrum L2
-			return callapi.ArbGetBlockConfirmations(b, receipt)
-		}
+	if v := b.Variant(); v.GetBlockConfirmations != nil {
+		return v.GetBlockConfirmations(b, receipt)
 	}
 	// common implementation
 	latest, err := b.GetLatestBlockNumber()
@@ -126,6 +96,35 @@ func (b *Bridge) GetBlockByNumber(number *big.Int) (*types.RPCBlock, error) {
 	return nil, wrapRPCQueryError(err, "eth_getBlockByNumber", number)
 }
 
+// GetBlockNumberByTag call eth_getBlockByNumber with block tag (eg. safe, finalized)
+func (b *Bridge) GetBlockNumberByTag(tag string) (uint64, error) {
+	var err error
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		var result *types.RPCBlock
+		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getBlockByNumber", tag, false)
+		if err == nil && result != nil && result.Number != nil {
+			return result.Number.ToInt().Uint64(), nil
+		}
+	}
+	return 0, wrapRPCQueryError(err, "eth_getBlockByNumber", tag)
+}
+
+// IsL2Finalized is the tx block reached the l2 finality level,
+// use 'safe'/'finalized' block tags if the chain variant does not override it
+func (b *Bridge) IsL2Finalized(receipt *types.RPCTxReceipt, level string) (bool, error) {
+	if params.GetL2FinalityRank(level) <= 0 {
+		return true, nil
+	}
+	if v := b.Variant(); v.IsL2Finalized != nil {
+		return v.IsL2Finalized(b, receipt, level)
+	}
+	blockNumber, err := b.GetBlockNumberByTag(level)
+	if err != nil {
+		return false, err
+	}
+	return blockNumber >= receipt.BlockNumber.ToInt().Uint64(), nil
+}
+
 // GetTransaction impl
 func (b *Bridge) GetTransaction(txHash string) (interface{}, error) {
 	return b.EvmContractBridge.GetTransactionByHash(txHash)
@@ -168,6 +167,9 @@ func (b *Bridge) getTransactionByBlockNumberAndIndex(blockNumber *big.Int, txInd
 
 // GetTransactionReceipt call eth_getTransactionReceipt
 func (b *Bridge) GetTransactionReceipt(txHash string) (result *types.RPCTxReceipt, err error) {
+	if v := b.Variant(); v.GetTransactionReceipt != nil {
+		return v.GetTransactionReceipt(b, txHash)
+	}
 	for _, url := range b.GatewayConfig.AllGatewayURLs {
 		start := time.Now()
 		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getTransactionReceipt", txHash)
@@ -345,8 +347,8 @@ func (b *Bridge) getMedianGasPrice() (mdGasPrice *big.Int, err error) {
 
 // SendSignedTransaction call eth_sendRawTransaction
 func (b *Bridge) SendSignedTransaction(tx *types.Transaction) (txHash string, err error) {
-	if b.IsSapphireChain() {
-		return b.SendSignedTransactionSapphire(tx)
+	if v := b.Variant(); v.SendSignedTransaction != nil {
+		return v.SendSignedTransaction(b, tx)
 	}
 	data, err := tx.MarshalBinary()
 	if err != nil {
@@ -582,8 +584,8 @@ func (b *Bridge) GetCode(contract string) (code []byte, err error) {
 
 // CallContract call eth_call
 func (b *Bridge) CallContract(contract string, data hexutil.Bytes, blockNumber string) (string, error) {
-	if b.IsSapphireChain() {
-		return b.CallContractSapphire(contract, data, blockNumber)
+	if v := b.Variant(); v.CallContract != nil {
+		return v.CallContract(b, contract, data, blockNumber)
 	}
 	reqArgs := map[string]interface{}{
 		"to":   contract,
@@ -740,8 +742,8 @@ func (b *Bridge) GetBaseFee(blockCount int) (*big.Int, error) {
 
 // EstimateGas call eth_estimateGas
 func (b *Bridge) EstimateGas(from, to string, value *big.Int, data []byte) (uint64, error) {
-	if b.IsSapphireChain() {
-		return b.EstimateGasSapphire(from, to, value, data)
+	if v := b.Variant(); v.EstimateGas != nil {
+		return v.EstimateGas(b, from, to, value, data)
 	}
 	reqArgs := map[string]interface{}{
 		"from":  from,
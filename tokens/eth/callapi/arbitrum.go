@@ -2,6 +2,7 @@ package callapi
 
 import (
 	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
 	"github.com/deltaswapio/swaprouter/v3/types"
 )
@@ -26,3 +27,23 @@ func ArbGetBlockConfirmations(b EvmBridge, receipt *types.RPCTxReceipt) (uint64,
 	}
 	return common.GetBigInt(common.FromHex(res), 0, 32).Uint64(), nil
 }
+
+// ArbL1FinalizedConfirmations l1 confirmations of the batch to regard it as finalized
+const ArbL1FinalizedConfirmations = 64
+
+// ArbIsL2Finalized check l2 finality by l1 confirmations of the batch,
+// batch posted on l1 is safe, and batch with enough l1 confirmations is finalized
+func ArbIsL2Finalized(b EvmBridge, receipt *types.RPCTxReceipt, level string) (bool, error) {
+	confirmations, err := ArbGetBlockConfirmations(b, receipt)
+	if err != nil {
+		return false, err
+	}
+	switch level {
+	case params.L2FinalitySafe:
+		return confirmations > 0, nil
+	case params.L2FinalityFinalized:
+		return confirmations >= ArbL1FinalizedConfirmations, nil
+	default:
+		return true, nil
+	}
+}
@@ -0,0 +1,74 @@
+package eth
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// ensure Bridge impl tokens.IFailureClassifier
+var _ tokens.IFailureClassifier = &Bridge{}
+
+// ClassifyFailedTx impl tokens.IFailureClassifier
+// the tx is out of gas if all its gas is used, otherwise the tx is
+// replayed by `eth_call` on the parent block to get the revert reason.
+func (b *Bridge) ClassifyFailedTx(txHash string) (*tokens.TxFailure, error) {
+	tx, err := b.GetTransactionByHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := b.GetTransactionReceipt(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.BlockNumber == nil || tx.GasLimit == nil || receipt.GasUsed == nil {
+		return nil, errors.New("failed tx is not mined")
+	}
+	if receipt.Status != nil && *receipt.Status == 1 {
+		return nil, errors.New("tx is not failed")
+	}
+	failure := &tokens.TxFailure{
+		GasLimit: uint64(*tx.GasLimit),
+		GasUsed:  uint64(*receipt.GasUsed),
+	}
+	if failure.GasUsed >= failure.GasLimit {
+		failure.Category = tokens.FailureOutOfGas
+		failure.Reason = "all gas is used"
+		return failure, nil
+	}
+
+	reqArgs := map[string]interface{}{
+		"from": tx.From,
+		"to":   tx.Recipient,
+		"gas":  tx.GasLimit,
+	}
+	if tx.Amount != nil {
+		reqArgs["value"] = tx.Amount
+	}
+	if tx.Payload != nil {
+		reqArgs["data"] = tx.Payload
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber.ToInt(), big.NewInt(1))
+	blockNumber := hexutil.EncodeBig(parent)
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		var result hexutil.Bytes
+		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_call", reqArgs, blockNumber)
+		if err == nil {
+			// the state changed by the previous txs in the same block
+			failure.Category = tokens.FailureUnknown
+			failure.Reason = "replay succeed on parent block"
+			return failure, nil
+		}
+		if reason, errd := getRevertReason(err); errd == nil {
+			failure.Reason = reason.String()
+			failure.Category = tokens.ClassifyFailureReason(failure.Reason)
+			return failure, nil
+		}
+	}
+	log.Warn("replay failed tx error", "chainID", b.ChainConfig.ChainID, "txHash", txHash, "err", err)
+	return nil, wrapRPCQueryError(err, "eth_call", txHash, blockNumber)
+}
@@ -0,0 +1,91 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+var _ tokens.IGasDropBuilder = &Bridge{}
+
+// BuildGasDropTransaction impl tokens.IGasDropBuilder
+// transfer native coin from the router mpc to the receiver of the swap,
+// the amount is the one charged by the payout and must not exceed the capped request.
+func (b *Bridge) BuildGasDropTransaction(args *tokens.BuildTxArgs) (rawTx interface{}, err error) {
+	if !args.IsGasDrop() || args.GasDrop.Amount == nil || args.GasDrop.Amount.Sign() <= 0 {
+		return nil, tokens.ErrGasDropNotSupported
+	}
+	if args.SwapType != tokens.ERC20SwapType || args.ERC20SwapInfo == nil || b.Variant().ForbidGasDrop {
+		return nil, tokens.ErrGasDropNotSupported
+	}
+	if args.ToChainID.String() != b.ChainConfig.ChainID {
+		return nil, tokens.ErrToChainIDMismatch
+	}
+	if args.Input != nil {
+		return nil, fmt.Errorf("forbid build raw swap tx with input data")
+	}
+	if args.From == "" {
+		return nil, fmt.Errorf("forbid empty sender")
+	}
+	routerMPC, err := router.GetRouterMPC(tokens.ERC20SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsEqualIgnoreCase(args.From, routerMPC) {
+		log.Error("build gas drop tx mpc mismatch", "have", args.From, "want", routerMPC)
+		return nil, tokens.ErrSenderMismatch
+	}
+
+	receiver := common.HexToAddress(args.Bind)
+	if receiver == (common.Address{}) || !common.IsHexAddress(args.Bind) {
+		return nil, errors.New("can not gas drop to empty or invalid receiver")
+	}
+	maxGasDrop, err := b.calcGasDrop(args)
+	if err != nil {
+		return nil, err
+	}
+	gasDrop := args.GasDrop.Amount
+	if gasDrop.Cmp(maxGasDrop) > 0 {
+		log.Warn("build gas drop tx amount exceeds the capped request", "have", gasDrop, "max", maxGasDrop, "swapID", args.SwapID)
+		return nil, tokens.ErrGasDropMismatch
+	}
+
+	input := []byte{}
+	args.Input = (*hexutil.Bytes)(&input)      // input
+	args.To = receiver.LowerHex()              // to
+	args.Value = new(big.Int).Set(gasDrop)     // value
+	args.SwapValue = new(big.Int).Set(gasDrop) // swapValue
+
+	err = b.setDefaults(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.buildTx(args)
+}
+
+func (b *Bridge) calcGasDrop(args *tokens.BuildTxArgs) (*big.Int, error) {
+	erc20SwapInfo := args.ERC20SwapInfo
+	multichainToken := router.GetCachedMultichainToken(erc20SwapInfo.TokenID, b.ChainConfig.ChainID)
+	if multichainToken == "" {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	fromBridge := router.GetBridgeByChainID(args.FromChainID.String())
+	if fromBridge == nil {
+		return nil, tokens.ErrNoBridgeForChainID
+	}
+	fromTokenCfg := fromBridge.GetTokenConfig(erc20SwapInfo.Token)
+	toTokenCfg := b.GetTokenConfig(multichainToken)
+	if fromTokenCfg == nil || toTokenCfg == nil {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	gasDrop, _ := tokens.CalcGasDrop(erc20SwapInfo.TokenID, b.ChainConfig.ChainID,
+		tokens.GetGasDropRequest(erc20SwapInfo), fromTokenCfg.Decimals)
+	return gasDrop, nil
+}
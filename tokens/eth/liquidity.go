@@ -0,0 +1,40 @@
+package eth
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// GetTokenLiquidity impl tokens.ILiquidityChecker
+// returns the underlying balance held by the multichain token
+func (b *Bridge) GetTokenLiquidity(tokenAddr string) (*big.Int, error) {
+	tokenCfg := b.GetTokenConfig(tokenAddr)
+	if tokenCfg == nil {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	underlying := tokenCfg.GetUnderlying()
+	if common.HexToAddress(underlying) == (common.Address{}) {
+		return nil, nil // without underlying
+	}
+	return b.GetErc20Balance(underlying, tokenAddr)
+}
+
+// IsLiquidityRequired impl tokens.ILiquidityChecker
+// anySwapInAuto mints anyToken when liquidity is insufficient,
+// only anySwapInUnderlying(AndExec) reverts in this situation.
+func (b *Bridge) IsLiquidityRequired(tokenAddr string, withCall bool) bool {
+	tokenCfg := b.GetTokenConfig(tokenAddr)
+	if tokenCfg == nil {
+		return false
+	}
+	if withCall {
+		return bytes.Equal(GetSwapInAndExecFuncHashV7(tokenCfg), AnySwapInUnderlyingAndExecFuncHashV7)
+	}
+	if b.GetRouterVersion(tokenAddr) == "v7" {
+		return bytes.Equal(GetSwapInFuncHashV7(tokenCfg), AnySwapInUnderlyingFuncHashV7)
+	}
+	return bytes.Equal(GetSwapInFuncHash1(tokenCfg), AnySwapInUnderlyingFuncHash)
+}
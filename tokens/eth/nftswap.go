@@ -174,7 +174,7 @@ func (b *Bridge) verifyNFTSwapTxLog(swapInfo *tokens.SwapTxInfo, rlog *types.RPC
 		return tokens.ErrTxWithRemovedLog
 	}
 
-	routerContract := b.GetRouterContract(swapInfo.NFTSwapInfo.Token)
+	routerContract := b.GetSwapTypeRouterContract(tokens.NFTSwapType, swapInfo.NFTSwapInfo.Token)
 	if routerContract == "" {
 		return tokens.ErrMissRouterInfo
 	}
@@ -446,8 +446,8 @@ func (b *Bridge) buildNFTSwapTxInput(args *tokens.BuildTxArgs) (err error) {
 		}
 	}
 
-	args.Input = (*hexutil.Bytes)(&input)          // input
-	args.To = b.GetRouterContract(multichainToken) // to
+	args.Input = (*hexutil.Bytes)(&input)                                      // input
+	args.To = b.GetSwapTypeRouterContract(tokens.NFTSwapType, multichainToken) // to
 
 	return nil
 }
@@ -0,0 +1,287 @@
+package eth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+var (
+	errIncompleteOfflineArgs     = errors.New("incomplete build args of offline raw tx")
+	errOfflineRawTxMismatch      = errors.New("offline raw tx mismatch with build args")
+	errOfflineSwapinMismatch     = errors.New("offline swapin mismatch with claimed swap")
+	errOfflineSwapTypeNotAllowed = errors.New("swap type is not allowed in offline signing")
+	errOfflineContractNotAllowed = errors.New("contract is not allowed in offline signing")
+	errUnknownOfflineSwapinFunc  = errors.New("unknown router swapin func of offline raw tx")
+)
+
+// router swapin call kinds
+const (
+	swapinKindV6 = iota
+	swapinKindV7
+	swapinKindV7AndExec
+	swapinKindMixPool
+)
+
+// offlineSwapin the router swapin call decoded from the raw tx input
+type offlineSwapin struct {
+	kind int
+	auto bool // anySwapInAuto, which is used by refund
+
+	SwapID      string // bytes32 hex (v6) or unique swap identifier
+	SwapoutID   common.Hash
+	Token       common.Address
+	Receiver    common.Address
+	Amount      *big.Int
+	FromChainID *big.Int
+	CallProxy   common.Address
+	CallData    []byte
+}
+
+// IsOfflineSignSupported impl tokens.IOfflineSigner
+func (b *Bridge) IsOfflineSignSupported() bool {
+	return !b.Variant().ForbidOfflineSigning
+}
+
+// InitOfflineSigner impl tokens.IOfflineSigner
+// the signer is initialized from chain config as no rpc is available offline
+func (b *Bridge) InitOfflineSigner() error {
+	chainID, err := common.GetBigIntFromStr(b.ChainConfig.ChainID)
+	if err != nil {
+		return err
+	}
+	b.SignerChainID = chainID
+	b.Signer = types.MakeSigner("London", chainID)
+	return nil
+}
+
+// EncodeOfflineRawTx impl tokens.IOfflineSigner
+func (b *Bridge) EncodeOfflineRawTx(rawTx interface{}) ([]byte, error) {
+	if b.Variant().ForbidOfflineSigning {
+		return nil, tokens.ErrNotImplemented
+	}
+	tx, ok := rawTx.(*types.Transaction)
+	if !ok {
+		return nil, tokens.ErrWrongRawTx
+	}
+	return tx.MarshalBinary()
+}
+
+// DecodeOfflineRawTx impl tokens.IOfflineSigner
+func (b *Bridge) DecodeOfflineRawTx(data []byte) (rawTx interface{}, err error) {
+	if b.Variant().ForbidOfflineSigning {
+		return nil, tokens.ErrNotImplemented
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// VerifyOfflineRawTx impl tokens.IOfflineSigner
+// rebuild the tx by the build args (which carry the input, nonce and gas fields)
+// and check it is the same as the raw tx to sign, then decode the router swapin
+// call in the input and check it against the claimed swap and the allowed contracts
+// (the build args are untrusted, so the rebuilding only binds the raw tx to them)
+func (b *Bridge) VerifyOfflineRawTx(rawTx interface{}, args *tokens.BuildTxArgs) error {
+	if b.Variant().ForbidOfflineSigning {
+		return tokens.ErrNotImplemented
+	}
+	tx, ok := rawTx.(*types.Transaction)
+	if !ok {
+		return tokens.ErrWrongRawTx
+	}
+	rebuilt, err := b.rebuildOfflineTx(args)
+	if err != nil {
+		return err
+	}
+	if b.Signer.Hash(rebuilt) != b.Signer.Hash(tx) {
+		return errOfflineRawTxMismatch
+	}
+	return b.verifyOfflineSwapin(args)
+}
+
+func (b *Bridge) verifyOfflineSwapin(args *tokens.BuildTxArgs) error {
+	if (args.SwapType != tokens.ERC20SwapType && args.SwapType != tokens.ERC20SwapTypeMixPool) || args.ERC20SwapInfo == nil {
+		return fmt.Errorf("%w: %v", errOfflineSwapTypeNotAllowed, args.SwapType.String())
+	}
+	if args.FromChainID == nil || args.OriginValue == nil {
+		return errIncompleteOfflineArgs
+	}
+	swapin, err := decodeOfflineSwapin(*args.Input)
+	if err != nil {
+		return err
+	}
+	log.Info("decode offline swapin success", "chainID", b.ChainConfig.ChainID,
+		"router", args.To, "tokenID", args.ERC20SwapInfo.TokenID, "token", swapin.Token.String(),
+		"receiver", swapin.Receiver.String(), "amount", swapin.Amount, "fromChainID", swapin.FromChainID,
+		"swapID", swapin.SwapID, "swapoutID", swapin.SwapoutID.String(), "isRefund", args.IsRefund())
+
+	chainCfg := params.GetMPCConfig(b.UseFastMPC).GetOfflineSignChainConfig(b.ChainConfig.ChainID)
+	if chainCfg == nil {
+		return fmt.Errorf("%w: no offline sign config of chain %v", errOfflineContractNotAllowed, b.ChainConfig.ChainID)
+	}
+	if !chainCfg.IsRouterContractAllowed(args.To) {
+		return fmt.Errorf("%w: router contract %v", errOfflineContractNotAllowed, args.To)
+	}
+	token := chainCfg.Tokens[args.ERC20SwapInfo.TokenID]
+	if !common.IsHexAddress(token) || common.HexToAddress(token) != swapin.Token {
+		return fmt.Errorf("%w: token %v of tokenID %v", errOfflineContractNotAllowed, swapin.Token.String(), args.ERC20SwapInfo.TokenID)
+	}
+
+	return checkOfflineSwapin(swapin, args)
+}
+
+// checkOfflineSwapin check the decoded swapin call is the one
+// the build args claimed, this is the same as building the input
+func checkOfflineSwapin(swapin *offlineSwapin, args *tokens.BuildTxArgs) error {
+	erc20SwapInfo := args.ERC20SwapInfo
+	isRefund := args.IsRefund()
+	isMixPool := args.SwapType == tokens.ERC20SwapTypeMixPool
+	isExec := erc20SwapInfo.CallProxy != ""
+
+	switch swapin.kind {
+	case swapinKindV6:
+		var swapIDHash common.Hash
+		if common.IsHexHash(args.SwapID) {
+			swapIDHash = common.HexToHash(args.SwapID)
+		} else {
+			swapIDHash = common.BytesToHash([]byte(args.SwapID))
+		}
+		if isMixPool || isExec || swapin.SwapID != swapIDHash.Hex() {
+			return fmt.Errorf("%w: swapID %v", errOfflineSwapinMismatch, swapin.SwapID)
+		}
+	case swapinKindV7, swapinKindV7AndExec:
+		if isMixPool || isExec != (swapin.kind == swapinKindV7AndExec) ||
+			swapin.SwapID != args.GetUniqueSwapIdentifier() ||
+			swapin.SwapoutID != common.HexToHash(erc20SwapInfo.SwapoutID) {
+			return fmt.Errorf("%w: swapID %v swapoutID %v", errOfflineSwapinMismatch, swapin.SwapID, swapin.SwapoutID.String())
+		}
+	case swapinKindMixPool:
+		if !isMixPool || swapin.SwapID != args.GetUniqueSwapIdentifier() {
+			return fmt.Errorf("%w: swapID %v", errOfflineSwapinMismatch, swapin.SwapID)
+		}
+	}
+	if isExec && (swapin.CallProxy != common.HexToAddress(erc20SwapInfo.CallProxy) ||
+		!bytes.Equal(swapin.CallData, erc20SwapInfo.CallData)) {
+		return fmt.Errorf("%w: call proxy %v", errOfflineSwapinMismatch, swapin.CallProxy.String())
+	}
+
+	if !common.IsHexAddress(args.Bind) || swapin.Receiver != common.HexToAddress(args.Bind) {
+		return fmt.Errorf("%w: receiver %v", errOfflineSwapinMismatch, swapin.Receiver.String())
+	}
+	if swapin.FromChainID.Cmp(args.FromChainID) != 0 {
+		return fmt.Errorf("%w: fromChainID %v", errOfflineSwapinMismatch, swapin.FromChainID)
+	}
+
+	amount := args.SwapValue
+	if isRefund {
+		// refund is built by `anySwapInAuto` of the source token
+		if !swapin.auto || args.Refund.Fee == nil ||
+			swapin.Token != common.HexToAddress(erc20SwapInfo.Token) {
+			return fmt.Errorf("%w: refund", errOfflineSwapinMismatch)
+		}
+		amount = new(big.Int).Sub(args.OriginValue, args.Refund.Fee)
+	}
+	if amount == nil || swapin.Amount.Cmp(amount) != 0 {
+		return fmt.Errorf("%w: amount %v", errOfflineSwapinMismatch, swapin.Amount)
+	}
+	return nil
+}
+
+// decodeOfflineSwapin decode the router swapin call (see BuildERC20SwapTxInput)
+func decodeOfflineSwapin(input []byte) (*offlineSwapin, error) {
+	if len(input) < 4 {
+		return nil, errUnknownOfflineSwapinFunc
+	}
+	funcHash, data := input[:4], input[4:]
+
+	swapin := &offlineSwapin{}
+	var pos uint64 // position of the (swapoutID,) token, receiver, amount, fromChainID
+	switch {
+	case bytes.Equal(funcHash, AnySwapInFuncHash),
+		bytes.Equal(funcHash, AnySwapInUnderlyingFuncHash),
+		bytes.Equal(funcHash, AnySwapInNativeFuncHash),
+		bytes.Equal(funcHash, AnySwapInAutoFuncHash):
+		swapin.kind = swapinKindV6
+		swapin.auto = bytes.Equal(funcHash, AnySwapInAutoFuncHash)
+		swapin.SwapID = common.BytesToHash(common.GetData(data, 0, 32)).Hex()
+		pos = 32
+	case bytes.Equal(funcHash, AnySwapInFuncHashV7),
+		bytes.Equal(funcHash, AnySwapInUnderlyingFuncHashV7),
+		bytes.Equal(funcHash, AnySwapInNativeFuncHashV7),
+		bytes.Equal(funcHash, AnySwapInAutoFuncHashV7):
+		swapin.kind = swapinKindV7
+		swapin.auto = bytes.Equal(funcHash, AnySwapInAutoFuncHashV7)
+		pos = 64
+	case bytes.Equal(funcHash, AnySwapInAndExecFuncHashV7),
+		bytes.Equal(funcHash, AnySwapInUnderlyingAndExecFuncHashV7):
+		swapin.kind = swapinKindV7AndExec
+		pos = 64
+	case bytes.Equal(funcHash, MixPoolAnySwapInFuncHash):
+		swapin.kind = swapinKindMixPool
+		pos = 32
+	default:
+		return nil, fmt.Errorf("%w: %x", errUnknownOfflineSwapinFunc, funcHash)
+	}
+	if uint64(len(data)) < pos+128 {
+		return nil, abicoder.ErrParseDataError
+	}
+
+	var err error
+	if swapin.kind != swapinKindV6 {
+		swapin.SwapID, err = abicoder.ParseStringInData(data, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if swapin.kind == swapinKindV7 || swapin.kind == swapinKindV7AndExec {
+		swapin.SwapoutID = common.BytesToHash(common.GetData(data, 32, 32))
+	}
+	swapin.Token = common.BytesToAddress(common.GetData(data, pos, 32))
+	swapin.Receiver = common.BytesToAddress(common.GetData(data, pos+32, 32))
+	swapin.Amount = common.GetBigInt(data, pos+64, 32)
+	swapin.FromChainID = common.GetBigInt(data, pos+96, 32)
+	if swapin.kind == swapinKindV7AndExec {
+		swapin.CallProxy = common.BytesToAddress(common.GetData(data, pos+128, 32))
+		swapin.CallData, err = abicoder.ParseBytesInData(data, pos+160)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return swapin, nil
+}
+
+func (b *Bridge) rebuildOfflineTx(args *tokens.BuildTxArgs) (*types.Transaction, error) {
+	if args.ToChainID == nil || args.ToChainID.String() != b.ChainConfig.ChainID {
+		return nil, tokens.ErrToChainIDMismatch
+	}
+	extra := args.Extra
+	if args.Input == nil || extra == nil || extra.Gas == nil || extra.Sequence == nil {
+		return nil, errIncompleteOfflineArgs
+	}
+	to := common.HexToAddress(args.To)
+	value := args.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	if params.IsDynamicFeeTxEnabled(b.ChainConfig.ChainID) {
+		if extra.GasTipCap == nil || extra.GasFeeCap == nil {
+			return nil, errIncompleteOfflineArgs
+		}
+		return types.NewDynamicFeeTx(b.SignerChainID, *extra.Sequence, &to, value, *extra.Gas, extra.GasTipCap, extra.GasFeeCap, *args.Input, nil), nil
+	}
+	if extra.GasPrice == nil {
+		return nil, errIncompleteOfflineArgs
+	}
+	return types.NewTransaction(*extra.Sequence, to, value, *extra.Gas, extra.GasPrice, *args.Input), nil
+}
@@ -0,0 +1,210 @@
+package eth
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+const (
+	testOfflineRouter   = "0x1111111111111111111111111111111111111111"
+	testOfflineToken    = "0x2222222222222222222222222222222222222222"
+	testOfflineReceiver = "0x3333333333333333333333333333333333333333"
+	testOfflineSwapID   = "0x4b0c6fe9cd6aa3fe2b47db0bbdc5c4b9ab2dc0ee2e8d30e7b1b2c1d6a3e5f789"
+)
+
+func setOfflineTestConfig(t *testing.T) {
+	t.Helper()
+	mpcConfig := params.GetRouterConfig().MPC
+	mpcConfig.OfflineSignChains = map[string]*params.OfflineSignChainConfig{
+		"56": {
+			RouterContracts: []string{testOfflineRouter},
+			Tokens:          map[string]string{"USDC": testOfflineToken},
+		},
+	}
+	t.Cleanup(func() { mpcConfig.OfflineSignChains = nil })
+}
+
+func newOfflineTestArgs(toChainID int64, nonce uint64) *tokens.BuildTxArgs {
+	gas := uint64(90000)
+	args := &tokens.BuildTxArgs{
+		To:          testOfflineRouter,
+		Value:       big.NewInt(0),
+		OriginValue: big.NewInt(1000),
+		SwapValue:   big.NewInt(990),
+		Extra: &tokens.AllExtras{
+			Gas:      &gas,
+			GasPrice: big.NewInt(5e9),
+			Sequence: &nonce,
+		},
+	}
+	args.SwapType = tokens.ERC20SwapType
+	args.SwapID = testOfflineSwapID
+	args.Bind = testOfflineReceiver
+	args.FromChainID = big.NewInt(1)
+	args.ToChainID = big.NewInt(toChainID)
+	args.ERC20SwapInfo = &tokens.ERC20SwapInfo{TokenID: "USDC"}
+	setOfflineTestInput(args, AnySwapInAutoFuncHash, testOfflineToken, testOfflineReceiver, args.SwapValue)
+	return args
+}
+
+func setOfflineTestInput(args *tokens.BuildTxArgs, funcHash []byte, token, receiver string, amount *big.Int) {
+	input := abicoder.PackDataWithFuncHash(funcHash,
+		common.HexToHash(args.SwapID),
+		common.HexToAddress(token),
+		common.HexToAddress(receiver),
+		amount,
+		args.FromChainID,
+	)
+	args.Input = (*hexutil.Bytes)(&input)
+}
+
+func newOfflineTestRawTx(t *testing.T, b *Bridge, args *tokens.BuildTxArgs) interface{} {
+	t.Helper()
+	tx := types.NewTransaction(*args.Extra.Sequence, common.HexToAddress(args.To), args.Value, *args.Extra.Gas, args.Extra.GasPrice, *args.Input)
+	data, err := b.EncodeOfflineRawTx(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawTx, err := b.DecodeOfflineRawTx(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rawTx
+}
+
+func TestVerifyOfflineRawTx(t *testing.T) {
+	setOfflineTestConfig(t)
+	b := newVariantTestBridge("56", "BSC")
+	if err := b.InitOfflineSigner(); err != nil {
+		t.Fatal(err)
+	}
+
+	args := newOfflineTestArgs(56, 10)
+	rawTx := newOfflineTestRawTx(t, b, args)
+	if err := b.VerifyOfflineRawTx(rawTx, args); err != nil {
+		t.Errorf("verify offline raw tx failed: %v", err)
+	}
+
+	noInput := newOfflineTestArgs(56, 10)
+	noInput.Input = nil
+	tests := []struct {
+		args *tokens.BuildTxArgs
+		err  error
+	}{
+		{newOfflineTestArgs(56, 11), errOfflineRawTxMismatch},
+		{newOfflineTestArgs(1, 10), tokens.ErrToChainIDMismatch},
+		{noInput, errIncompleteOfflineArgs},
+	}
+	for i, tt := range tests {
+		if err := b.VerifyOfflineRawTx(rawTx, tt.args); !errors.Is(err, tt.err) {
+			t.Errorf("case %d: verify offline raw tx error = %v, want %v", i, err, tt.err)
+		}
+	}
+
+	zksync := newVariantTestBridge("324", "ZKSYNC")
+	if zksync.IsOfflineSignSupported() || !b.IsOfflineSignSupported() {
+		t.Errorf("wrong offline sign supported of variants")
+	}
+	if err := zksync.VerifyOfflineRawTx(rawTx, args); !errors.Is(err, tokens.ErrNotImplemented) {
+		t.Errorf("verify offline raw tx of zksync error = %v, want %v", err, tokens.ErrNotImplemented)
+	}
+}
+
+// the build args are consistent with the raw tx, but the input
+// does not match the claimed swap or calls a disallowed contract
+func TestVerifyOfflineSwapin(t *testing.T) {
+	setOfflineTestConfig(t)
+	b := newVariantTestBridge("56", "BSC")
+	if err := b.InitOfflineSigner(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(args *tokens.BuildTxArgs)
+		err    error
+	}{
+		{"ok", func(args *tokens.BuildTxArgs) {}, nil},
+		{"other receiver", func(args *tokens.BuildTxArgs) {
+			setOfflineTestInput(args, AnySwapInAutoFuncHash, testOfflineToken, "0x4444444444444444444444444444444444444444", args.SwapValue)
+		}, errOfflineSwapinMismatch},
+		{"other amount", func(args *tokens.BuildTxArgs) {
+			setOfflineTestInput(args, AnySwapInAutoFuncHash, testOfflineToken, testOfflineReceiver, big.NewInt(1000000))
+		}, errOfflineSwapinMismatch},
+		{"other token", func(args *tokens.BuildTxArgs) {
+			setOfflineTestInput(args, AnySwapInAutoFuncHash, "0x4444444444444444444444444444444444444444", testOfflineReceiver, args.SwapValue)
+		}, errOfflineContractNotAllowed},
+		{"other token id", func(args *tokens.BuildTxArgs) {
+			args.ERC20SwapInfo.TokenID = "USDT"
+		}, errOfflineContractNotAllowed},
+		{"other router", func(args *tokens.BuildTxArgs) {
+			args.To = "0x4444444444444444444444444444444444444444"
+		}, errOfflineContractNotAllowed},
+		{"other swapID", func(args *tokens.BuildTxArgs) {
+			args.SwapID = "0x1234"
+		}, errOfflineSwapinMismatch},
+		{"other from chain", func(args *tokens.BuildTxArgs) {
+			args.FromChainID = big.NewInt(137)
+		}, errOfflineSwapinMismatch},
+		{"unknown func", func(args *tokens.BuildTxArgs) {
+			setOfflineTestInput(args, common.FromHex("0xa9059cbb"), testOfflineToken, testOfflineReceiver, args.SwapValue)
+		}, errUnknownOfflineSwapinFunc},
+		{"other swap type", func(args *tokens.BuildTxArgs) {
+			args.SwapType = tokens.NFTSwapType
+		}, errOfflineSwapTypeNotAllowed},
+		{"refund amount", func(args *tokens.BuildTxArgs) {
+			args.Refund = &tokens.RefundInfo{Fee: big.NewInt(10)}
+			args.ERC20SwapInfo.Token = testOfflineToken
+		}, nil},
+		{"refund with wrong fee", func(args *tokens.BuildTxArgs) {
+			args.Refund = &tokens.RefundInfo{Fee: big.NewInt(1)}
+			args.ERC20SwapInfo.Token = testOfflineToken
+		}, errOfflineSwapinMismatch},
+	}
+	for _, tt := range tests {
+		args := newOfflineTestArgs(56, 10)
+		tt.modify(args)
+		rawTx := newOfflineTestRawTx(t, b, args)
+		if err := b.VerifyOfflineRawTx(rawTx, args); !errors.Is(err, tt.err) {
+			t.Errorf("%v: verify offline raw tx error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestDecodeOfflineSwapinV7(t *testing.T) {
+	swapID := "56:" + testOfflineSwapID + ":1"
+	swapoutID := common.HexToHash("0x01")
+	callData := []byte{1, 2, 3}
+	input := abicoder.PackDataWithFuncHash(AnySwapInAndExecFuncHashV7,
+		swapID,
+		swapoutID,
+		common.HexToAddress(testOfflineToken),
+		common.HexToAddress(testOfflineReceiver),
+		big.NewInt(990),
+		big.NewInt(1),
+		common.HexToAddress(testOfflineRouter),
+		callData,
+	)
+	swapin, err := decodeOfflineSwapin(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swapin.kind != swapinKindV7AndExec || swapin.SwapID != swapID || swapin.SwapoutID != swapoutID ||
+		swapin.Token != common.HexToAddress(testOfflineToken) ||
+		swapin.Receiver != common.HexToAddress(testOfflineReceiver) ||
+		swapin.Amount.Int64() != 990 || swapin.FromChainID.Int64() != 1 ||
+		swapin.CallProxy != common.HexToAddress(testOfflineRouter) || string(swapin.CallData) != string(callData) {
+		t.Errorf("wrong decoded swapin %+v", swapin)
+	}
+	if _, err = decodeOfflineSwapin(input[:100]); !errors.Is(err, abicoder.ErrParseDataError) {
+		t.Errorf("decode short input error = %v, want %v", err, abicoder.ErrParseDataError)
+	}
+}
@@ -0,0 +1,135 @@
+package eth
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+// receiptDigest the receipt fields which must be same among gateways
+type receiptDigest struct {
+	BlockNumber string       `json:"blockNumber"`
+	BlockHash   string       `json:"blockHash"`
+	Status      uint64       `json:"status"`
+	From        string       `json:"from"`
+	To          string       `json:"to"`
+	Logs        []*logDigest `json:"logs"`
+}
+
+type logDigest struct {
+	Address string        `json:"address"`
+	Topics  []common.Hash `json:"topics"`
+	Data    string        `json:"data"`
+}
+
+func getReceiptDigest(receipt *types.RPCTxReceipt) string {
+	digest := &receiptDigest{
+		Logs: make([]*logDigest, 0, len(receipt.Logs)),
+	}
+	for _, rlog := range receipt.Logs {
+		ld := &logDigest{Topics: rlog.Topics}
+		if rlog.Address != nil {
+			ld.Address = rlog.Address.LowerHex()
+		}
+		if rlog.Data != nil {
+			ld.Data = rlog.Data.String()
+		}
+		digest.Logs = append(digest.Logs, ld)
+	}
+	if receipt.BlockNumber != nil {
+		digest.BlockNumber = receipt.BlockNumber.String()
+	}
+	if receipt.BlockHash != nil {
+		digest.BlockHash = receipt.BlockHash.Hex()
+	}
+	if receipt.Status != nil {
+		digest.Status = uint64(*receipt.Status)
+	}
+	if receipt.From != nil {
+		digest.From = receipt.From.LowerHex()
+	}
+	if receipt.Recipient != nil {
+		digest.To = receipt.Recipient.LowerHex()
+	}
+	data, _ := json.Marshal(digest)
+	return common.Keccak256Hash(data).Hex()
+}
+
+// getQuorumGatewayURLs the gateways ('Gateways') and the extra gateways ('GatewaysExt')
+// of the chain without duplicates, it is not affected by the adjusting of gateway order.
+func (b *Bridge) getQuorumGatewayURLs() []string {
+	cfg := b.GatewayConfig
+	if cfg == nil {
+		return nil
+	}
+	urls := make([]string, 0, len(cfg.APIAddress)+len(cfg.APIAddressExt))
+	exist := make(map[string]struct{})
+	for _, list := range [][]string{cfg.APIAddress, cfg.APIAddressExt} {
+		for _, url := range list {
+			if _, ok := exist[url]; ok {
+				continue
+			}
+			exist[url] = struct{}{}
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// checkReceiptQuorum require at least quorum gateways return the same receipt.
+// gateways which have not seen the tx are not counted,
+// gateways return different receipt are reported as security event.
+func (b *Bridge) checkReceiptQuorum(txHash string, receipt *types.RPCTxReceipt) error {
+	chainID := b.ChainConfig.ChainID
+	quorum := params.GetQuorumVerifyCount(chainID)
+	if quorum <= 0 {
+		return nil
+	}
+	urls := b.getQuorumGatewayURLs()
+	if quorum > len(urls) {
+		return fmt.Errorf("%w: quorum %v is larger than gateways count %v", tokens.ErrQuorumNotReached, quorum, len(urls))
+	}
+
+	start := time.Now()
+	want := getReceiptDigest(receipt)
+	matched := 0
+	disagrees := make(map[string]string)
+	for _, url := range urls {
+		var result *types.RPCTxReceipt
+		err := client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getTransactionReceipt", txHash)
+		if err != nil || result == nil {
+			continue
+		}
+		if digest := getReceiptDigest(result); digest == want {
+			matched++
+		} else {
+			disagrees[url] = fmt.Sprintf("block %v hash %v status %v logs %v", result.BlockNumber, result.BlockHash, result.Status, len(result.Logs))
+		}
+	}
+	log.Info("check receipt quorum finished", "chainID", chainID, "txhash", txHash, "quorum", quorum, "matched", matched, "disagrees", len(disagrees), "timespent", time.Since(start).String())
+
+	if len(disagrees) > 0 {
+		disagrees["expected"] = fmt.Sprintf("block %v hash %v status %v logs %v", receipt.BlockNumber, receipt.BlockHash, receipt.Status, len(receipt.Logs))
+		tokens.ReportSecurityEvent(&tokens.SecurityEvent{
+			Kind:    tokens.SecurityEventGatewayDisagree,
+			ChainID: chainID,
+			TxID:    txHash,
+			Message: "gateways disagree on tx receipt",
+			Details: disagrees,
+		})
+	}
+	if matched < quorum {
+		if len(disagrees) > 0 {
+			return fmt.Errorf("%w: gateways disagree on tx receipt, matched %v, quorum %v", tokens.ErrVerifyTxUnsafe, matched, quorum)
+		}
+		return fmt.Errorf("%w: matched %v, quorum %v", tokens.ErrQuorumNotReached, matched, quorum)
+	}
+	return nil
+}
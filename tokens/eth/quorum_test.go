@@ -0,0 +1,120 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+func newDigestTestReceipt(blockHash string, removed *bool) *types.RPCTxReceipt {
+	status := hexutil.Uint64(1)
+	bHash := common.HexToHash(blockHash)
+	address := common.HexToAddress(tRouterAddress)
+	data := hexutil.Bytes(common.FromHex("0x01"))
+	return &types.RPCTxReceipt{
+		BlockNumber: (*hexutil.Big)(common.Big1),
+		BlockHash:   &bHash,
+		Status:      &status,
+		Logs: []*types.RPCLog{
+			{
+				Address: &address,
+				Topics:  []common.Hash{common.HexToHash("0x1234")},
+				Data:    &data,
+				Removed: removed,
+			},
+		},
+	}
+}
+
+func TestGetReceiptDigest(t *testing.T) {
+	notRemoved := false
+	base := getReceiptDigest(newDigestTestReceipt("0x01", nil))
+	if base != getReceiptDigest(newDigestTestReceipt("0x01", &notRemoved)) {
+		t.Errorf("receipt digest should ignore optional fields of logs")
+	}
+	if base == getReceiptDigest(newDigestTestReceipt("0x02", nil)) {
+		t.Errorf("receipt digest should differ with different block hash")
+	}
+}
+
+func newReceiptTestGateway(t *testing.T, receipt *types.RPCTxReceipt) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID int `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  receipt,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestCheckReceiptQuorum(t *testing.T) {
+	const chainID = "56"
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		QuorumVerifyChains: map[string]int{chainID: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+
+	receipt := newDigestTestReceipt("0x01", nil)
+	good1 := newReceiptTestGateway(t, receipt)
+	good2 := newReceiptTestGateway(t, receipt)
+	good3 := newReceiptTestGateway(t, receipt)
+	forked := newReceiptTestGateway(t, newDigestTestReceipt("0x02", nil))
+	notFound := newReceiptTestGateway(t, nil)
+
+	tests := []struct {
+		gateways    []string
+		gatewaysExt []string
+		err         error
+	}{
+		// the extra gateway is counted to reach the quorum
+		{[]string{good1, good2}, []string{good3}, nil},
+		{[]string{good1, good2}, []string{good2}, tokens.ErrQuorumNotReached},
+		{[]string{good1, good2}, []string{notFound}, tokens.ErrQuorumNotReached},
+		{[]string{good1, good2}, []string{forked}, tokens.ErrVerifyTxUnsafe},
+		{[]string{good1, forked}, []string{good2, good3}, nil},
+	}
+	for i, tt := range tests {
+		b := newVariantTestBridge(chainID, "BSC")
+		b.RPCClientTimeout = 5
+		b.SetGatewayConfig(&tokens.GatewayConfig{
+			APIAddress:    tt.gateways,
+			APIAddressExt: tt.gatewaysExt,
+		})
+		if err = b.checkReceiptQuorum("0x1234", receipt); !errors.Is(err, tt.err) {
+			t.Errorf("case %d: check receipt quorum error = %v, want %v", i, err, tt.err)
+		}
+	}
+}
+
+func TestQuorumGatewayURLs(t *testing.T) {
+	b := newVariantTestBridge("56", "BSC")
+	b.SetGatewayConfig(&tokens.GatewayConfig{
+		APIAddress:    []string{"http://a", "http://b"},
+		APIAddressExt: []string{"http://b", "http://c"},
+	})
+	// adjusting gateway order does not affect quorum gateways
+	b.GatewayConfig.AllGatewayURLs = []string{"http://c"}
+	urls := b.getQuorumGatewayURLs()
+	if strings.Join(urls, ",") != "http://a,http://b,http://c" {
+		t.Errorf("quorum gateway urls = %v", urls)
+	}
+}
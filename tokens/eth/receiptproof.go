@@ -0,0 +1,356 @@
+package eth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+	"github.com/deltaswapio/swaprouter/v3/tools/rlp"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+var (
+	errReceiptsRootMismatch  = errors.New("receipts root mismatch with block header")
+	errReceiptNotInBlock     = errors.New("receipt mismatch with the one in block")
+	errBlockHeaderMismatch   = errors.New("block header mismatch among gateways")
+	errMissBlockReceiptsRoot = errors.New("block header missing receipts root")
+)
+
+// block header must be agreed by at least this number of gateways
+const minReceiptProofGateways = 2
+
+// proofReceipt receipt with consensus fields to rebuild receipts trie
+type proofReceipt struct {
+	Type              hexutil.Uint64  `json:"type"`
+	TxHash            *common.Hash    `json:"transactionHash"`
+	TxIndex           *hexutil.Uint   `json:"transactionIndex"`
+	Status            *hexutil.Uint64 `json:"status"`
+	Root              hexutil.Bytes   `json:"root"` // pre-byzantium
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	Bloom             hexutil.Bytes   `json:"logsBloom"`
+	Logs              []*types.RPCLog `json:"logs"`
+}
+
+type rlpLog struct {
+	Address common.Address
+	Topics  []common.Hash
+	Data    []byte
+}
+
+type rlpReceipt struct {
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             []byte
+	Logs              []*rlpLog
+}
+
+func convertToRLPLogs(logs []*types.RPCLog) []*rlpLog {
+	result := make([]*rlpLog, 0, len(logs))
+	for _, l := range logs {
+		rl := &rlpLog{Topics: l.Topics}
+		if rl.Topics == nil {
+			rl.Topics = []common.Hash{}
+		}
+		if l.Address != nil {
+			rl.Address = *l.Address
+		}
+		if l.Data != nil {
+			rl.Data = *l.Data
+		}
+		result = append(result, rl)
+	}
+	return result
+}
+
+func (r *proofReceipt) toRLPReceipt() *rlpReceipt {
+	var status []byte
+	switch {
+	case len(r.Root) > 0:
+		status = r.Root
+	case r.Status != nil && *r.Status == 1:
+		status = []byte{0x01}
+	default:
+		status = []byte{}
+	}
+	return &rlpReceipt{
+		PostStateOrStatus: status,
+		CumulativeGasUsed: uint64(r.CumulativeGasUsed),
+		Bloom:             r.Bloom,
+		Logs:              convertToRLPLogs(r.Logs),
+	}
+}
+
+// consensusEncode the consensus encoding of receipt (with type prefix for typed receipt)
+func (r *proofReceipt) consensusEncode() []byte {
+	var w bytes.Buffer
+	if r.Type != 0 {
+		w.WriteByte(byte(r.Type))
+	}
+	if err := rlp.Encode(&w, r.toRLPReceipt()); err != nil {
+		log.Warn("rlp encode receipt failed", "txHash", r.TxHash, "err", err)
+	}
+	return w.Bytes()
+}
+
+// DeriveReceiptsRoot rebuild receipts trie and return its root
+func DeriveReceiptsRoot(receipts []*proofReceipt) common.Hash {
+	values := make([][]byte, len(receipts))
+	for i, r := range receipts {
+		values[i] = r.consensusEncode()
+	}
+	return deriveListRoot(values)
+}
+
+// emptyTrieRoot is the root hash of empty trie
+var emptyTrieRoot = common.HexToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+// trieItem trie key (in nibbles) and value
+type trieItem struct {
+	key   []byte
+	value []byte
+}
+
+// deriveListRoot root of the merkle patricia trie of list,
+// whose keys are the rlp encoded indexes (same as the receipts trie)
+func deriveListRoot(values [][]byte) common.Hash {
+	if len(values) == 0 {
+		return emptyTrieRoot
+	}
+	items := make([]*trieItem, len(values))
+	for i, value := range values {
+		key, _ := rlp.EncodeToBytes(uint(i))
+		nibbles := make([]byte, 0, 2*len(key))
+		for _, b := range key {
+			nibbles = append(nibbles, b>>4, b&0x0f)
+		}
+		items[i] = &trieItem{key: nibbles, value: value}
+	}
+	return crypto.Keccak256Hash(encodeTrieNode(items, 0))
+}
+
+// encodeTrieNode rlp encode the trie node of items whose keys
+// have the same prefix before depth (the items' keys are unique)
+func encodeTrieNode(items []*trieItem, depth int) []byte {
+	var node interface{}
+	if len(items) == 1 {
+		// leaf node
+		node = [][]byte{hexPrefixEncode(items[0].key[depth:], true), items[0].value}
+	} else if prefix := trieItemsPrefixLen(items, depth); prefix > 0 {
+		// extension node
+		child := encodeTrieNode(items, depth+prefix)
+		node = []interface{}{hexPrefixEncode(items[0].key[depth:depth+prefix], false), trieNodeRef(child)}
+	} else {
+		// branch node
+		var groups [16][]*trieItem
+		branch := make([]interface{}, 17)
+		branch[16] = []byte{}
+		for _, item := range items {
+			if len(item.key) == depth {
+				branch[16] = item.value
+				continue
+			}
+			groups[item.key[depth]] = append(groups[item.key[depth]], item)
+		}
+		for i, group := range groups {
+			if len(group) == 0 {
+				branch[i] = []byte{}
+			} else {
+				branch[i] = trieNodeRef(encodeTrieNode(group, depth+1))
+			}
+		}
+		node = branch
+	}
+	enc, _ := rlp.EncodeToBytes(node)
+	return enc
+}
+
+// trieNodeRef child node is embedded if its encoding is less than 32 bytes
+func trieNodeRef(enc []byte) interface{} {
+	if len(enc) < 32 {
+		return rlp.RawValue(enc)
+	}
+	return crypto.Keccak256(enc)
+}
+
+// trieItemsPrefixLen the common prefix length of items' keys after depth
+func trieItemsPrefixLen(items []*trieItem, depth int) int {
+	first := items[0].key[depth:]
+	prefix := len(first)
+	for _, item := range items[1:] {
+		key := item.key[depth:]
+		i := 0
+		for i < prefix && i < len(key) && key[i] == first[i] {
+			i++
+		}
+		prefix = i
+	}
+	return prefix
+}
+
+// hexPrefixEncode compact encoding of nibbles with the leaf flag
+func hexPrefixEncode(nibbles []byte, isLeaf bool) []byte {
+	var flag byte
+	if isLeaf {
+		flag = 2
+	}
+	result := make([]byte, len(nibbles)/2+1)
+	if len(nibbles)%2 == 1 {
+		result[0] = (flag+1)<<4 | nibbles[0]
+		nibbles = nibbles[1:]
+	} else {
+		result[0] = flag << 4
+	}
+	for i := 0; i < len(nibbles); i += 2 {
+		result[i/2+1] = nibbles[i]<<4 | nibbles[i+1]
+	}
+	return result
+}
+
+// checkReceiptProof check the receipt is really in the block
+// by rebuilding receipts trie and comparing with the receipts root of
+// the block header, which is cross-checked among gateways.
+func (b *Bridge) checkReceiptProof(txHash string, receipt *types.RPCTxReceipt) error {
+	chainID := b.ChainConfig.ChainID
+	if !params.IsCheckReceiptProofEnabled(chainID) {
+		return nil
+	}
+	if receipt.BlockNumber == nil || receipt.BlockHash == nil || receipt.TxIndex == nil {
+		return errTxReceiptMissBlockInfo
+	}
+
+	start := time.Now()
+	blockNumber := receipt.BlockNumber.ToInt()
+	header, err := b.getCrossCheckedBlockHeader(blockNumber, txHash)
+	if err != nil {
+		return err
+	}
+	if *header.Hash != *receipt.BlockHash {
+		log.Warn("receipt block hash mismatch with header", "chainID", chainID, "txhash", txHash, "have", receipt.BlockHash.Hex(), "want", header.Hash.Hex())
+		return errTxBlockHashMismatch
+	}
+
+	receipts, err := b.getBlockReceipts(header)
+	if err != nil {
+		return err
+	}
+	root := DeriveReceiptsRoot(receipts)
+	if root != *header.ReceiptsRoot {
+		log.Warn("receipts root mismatch", "chainID", chainID, "txhash", txHash, "block", blockNumber, "have", root.Hex(), "want", header.ReceiptsRoot.Hex())
+		return fmt.Errorf("%w: %v", tokens.ErrVerifyTxUnsafe, errReceiptsRootMismatch)
+	}
+
+	// bind the tx to the receipt by the tx list of the cross checked header,
+	// not by the tx hash in the receipts which are returned by one gateway.
+	txIndex := int(*receipt.TxIndex)
+	if txIndex >= len(receipts) || txIndex >= len(header.Transactions) {
+		return fmt.Errorf("%w: tx index %v out of range", errReceiptNotInBlock, txIndex)
+	}
+	if inHeader := header.Transactions[txIndex]; inHeader == nil || *inHeader != common.HexToHash(txHash) {
+		return fmt.Errorf("%w: tx hash at index %v is %v, not %v", errReceiptNotInBlock, txIndex, inHeader, txHash)
+	}
+	inBlock := receipts[txIndex]
+	want, _ := rlp.EncodeToBytes(inBlock.toRLPReceipt().Logs)
+	have, _ := rlp.EncodeToBytes(convertToRLPLogs(receipt.Logs))
+	if !bytes.Equal(want, have) || (inBlock.Status != nil) != (receipt.Status != nil) ||
+		(receipt.Status != nil && *inBlock.Status != *receipt.Status) {
+		return fmt.Errorf("%w: %v", tokens.ErrVerifyTxUnsafe, errReceiptNotInBlock)
+	}
+
+	log.Info("check receipt proof success", "chainID", chainID, "txhash", txHash, "block", blockNumber, "receipts", len(receipts), "timespent", time.Since(start).String())
+	return nil
+}
+
+// getCrossCheckedBlockHeader get block header which is same among all the answered gateways
+func (b *Bridge) getCrossCheckedBlockHeader(blockNumber *big.Int, txHash string) (header *types.RPCBlock, err error) {
+	urls := b.GatewayConfig.OriginAllGatewayURLs
+	if len(urls) < minReceiptProofGateways {
+		return nil, fmt.Errorf("%w: check receipt proof require at least %v gateways, have %v", tokens.ErrQuorumNotReached, minReceiptProofGateways, len(urls))
+	}
+	minAgree := minReceiptProofGateways
+	agrees := 0
+	disagrees := make(map[string]string)
+	for _, url := range urls {
+		var result *types.RPCBlock
+		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getBlockByNumber", types.ToBlockNumArg(blockNumber), false)
+		if err != nil || result == nil || result.Hash == nil {
+			continue
+		}
+		if result.ReceiptsRoot == nil {
+			return nil, errMissBlockReceiptsRoot
+		}
+		if header == nil {
+			header = result
+			agrees++
+			continue
+		}
+		if *result.Hash == *header.Hash && *result.ReceiptsRoot == *header.ReceiptsRoot &&
+			isSameTxHashes(result.Transactions, header.Transactions) {
+			agrees++
+		} else {
+			disagrees[url] = fmt.Sprintf("hash %v receiptsRoot %v txs %v", result.Hash.Hex(), result.ReceiptsRoot.Hex(), len(result.Transactions))
+		}
+	}
+	if len(disagrees) > 0 {
+		disagrees["first"] = fmt.Sprintf("hash %v receiptsRoot %v txs %v", header.Hash.Hex(), header.ReceiptsRoot.Hex(), len(header.Transactions))
+		tokens.ReportSecurityEvent(&tokens.SecurityEvent{
+			Kind:    tokens.SecurityEventGatewayDisagree,
+			ChainID: b.ChainConfig.ChainID,
+			TxID:    txHash,
+			Message: fmt.Sprintf("gateways disagree on block header %v", blockNumber),
+			Details: disagrees,
+		})
+		return nil, fmt.Errorf("%w: %v", tokens.ErrVerifyTxUnsafe, errBlockHeaderMismatch)
+	}
+	if header == nil || agrees < minAgree {
+		return nil, fmt.Errorf("%w: block header %v agreed by %v gateways, require %v", tokens.ErrQuorumNotReached, blockNumber, agrees, minAgree)
+	}
+	return header, nil
+}
+
+func isSameTxHashes(a, b []*common.Hash) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, hash := range a {
+		if hash == nil || b[i] == nil || *hash != *b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getBlockReceipts get all receipts of block by `eth_getBlockReceipts`,
+// fallback to get receipt of each tx if the rpc is not supported.
+func (b *Bridge) getBlockReceipts(header *types.RPCBlock) (receipts []*proofReceipt, err error) {
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		var result []*proofReceipt
+		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getBlockReceipts", header.Number)
+		if err == nil && len(result) == len(header.Transactions) {
+			return result, nil
+		}
+	}
+	receipts = make([]*proofReceipt, len(header.Transactions))
+	for i, txHash := range header.Transactions {
+		for _, url := range b.GatewayConfig.AllGatewayURLs {
+			var result *proofReceipt
+			err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_getTransactionReceipt", txHash)
+			if err == nil && result != nil {
+				receipts[i] = result
+				break
+			}
+		}
+		if receipts[i] == nil {
+			return nil, wrapRPCQueryError(err, "eth_getTransactionReceipt", txHash)
+		}
+	}
+	return receipts, nil
+}
@@ -0,0 +1,107 @@
+package eth
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+func newProofTestReceipts(n int) []*proofReceipt {
+	address := common.HexToAddress(tRouterAddress)
+	topic := common.HexToHash("0x1234")
+	data := hexutil.Bytes(common.FromHex("0x0102"))
+	bloom := make([]byte, 256)
+	bloom[1] = 0x11
+
+	receipts := make([]*proofReceipt, 0, n)
+	for i := 0; i < n; i++ {
+		status := uint64(i % 2)
+		receipts = append(receipts, &proofReceipt{
+			Type:              hexutil.Uint64(i % 3), // legacy, access list and dynamic fee
+			Status:            (*hexutil.Uint64)(&status),
+			CumulativeGasUsed: hexutil.Uint64(21000 * (i + 1)),
+			Bloom:             bloom,
+			Logs: []*types.RPCLog{
+				{Address: &address, Topics: []common.Hash{topic}, Data: &data},
+			},
+		})
+	}
+	return receipts
+}
+
+// the wanted roots are derived by go-ethereum (core/types.DeriveSha)
+func TestDeriveReceiptsRoot(t *testing.T) {
+	tests := []struct {
+		count int
+		root  string
+	}{
+		{0, "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421"},
+		{1, "0x04d0e39b0ef055202e63b6ed6c4330a12212de19013963fe00766e3cf9a40f27"},
+		{3, "0x5f3edd985d2d9b7d2be39e17a19077ed8441fde38fe83607ac3a9409e841daad"},
+		{16, "0x752774937944fc96e0ca2ed41a652215710fbb353d863966fdf436eed5ab995d"},
+		{130, "0xfa972e59c2b23d48d547511028347d5c712ea367265afc3946f136e341935c7b"},
+		{300, "0x6066d21a5e8ec9843070ba0189d84e4256bf100364bb149df917b8fa74beb11a"},
+	}
+	for _, tt := range tests {
+		if have := DeriveReceiptsRoot(newProofTestReceipts(tt.count)); have.Hex() != tt.root {
+			t.Errorf("%d receipts: root mismatch, have %v want %v", tt.count, have.Hex(), tt.root)
+		}
+	}
+}
+
+// small values are embedded in their parent nodes
+func TestDeriveListRootWithSmallValues(t *testing.T) {
+	tests := []struct {
+		count int
+		root  string
+	}{
+		{1, "0x60f3186418dec9c3c1a62df382cd0918c9ff5955674e6218be6f9eff8ea99905"},
+		{2, "0xdc5f773516d1e820c67f65cd4daa1d4d76f33fc41d63f2f9766a94bb05cce803"},
+		{5, "0x94baf78d8451758e78b77cbb7d176b44cf4ede6bd0f3feaaf4895cb8e9821402"},
+		{20, "0x74b6789b183bc270951376dc715d8bfb9c5dcef4138f1c8be0976d307d405ad2"},
+		{200, "0xacbe590af19a828d048748e176235c2caca0c588275523741c2af9c46a9c2479"},
+	}
+	for _, tt := range tests {
+		values := make([][]byte, tt.count)
+		for i := range values {
+			values[i] = []byte{byte(i), 0xab}
+		}
+		if have := deriveListRoot(values); have.Hex() != tt.root {
+			t.Errorf("%d values: root mismatch, have %v want %v", tt.count, have.Hex(), tt.root)
+		}
+	}
+}
+
+func TestCrossCheckedBlockHeaderRequireGateways(t *testing.T) {
+	b := newVariantTestBridge("1", "ETH")
+	b.SetGatewayConfig(&tokens.GatewayConfig{APIAddress: []string{"http://127.0.0.1:1"}})
+	_, err := b.getCrossCheckedBlockHeader(big.NewInt(1), "0x01")
+	if !errors.Is(err, tokens.ErrQuorumNotReached) {
+		t.Errorf("check with single gateway should fail with quorum not reached, have %v", err)
+	}
+}
+
+func TestIsSameTxHashes(t *testing.T) {
+	hash1 := common.HexToHash("0x01")
+	hash2 := common.HexToHash("0x02")
+	tests := []struct {
+		a, b []*common.Hash
+		want bool
+	}{
+		{nil, nil, true},
+		{[]*common.Hash{&hash1, &hash2}, []*common.Hash{&hash1, &hash2}, true},
+		{[]*common.Hash{&hash1, &hash2}, []*common.Hash{&hash2, &hash1}, false},
+		{[]*common.Hash{&hash1}, []*common.Hash{&hash1, &hash2}, false},
+		{[]*common.Hash{nil}, []*common.Hash{nil}, false},
+	}
+	for i, tt := range tests {
+		if have := isSameTxHashes(tt.a, tt.b); have != tt.want {
+			t.Errorf("case %v: isSameTxHashes = %v, want %v", i, have, tt.want)
+		}
+	}
+}
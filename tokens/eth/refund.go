@@ -0,0 +1,104 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+)
+
+// BuildRefundTransaction impl tokens.IRefundBuilder
+// refund the swapped out token to the sender on the source chain by `anySwapInAuto`,
+// which releases underlying if liquidity is enough, otherwise mints the token.
+func (b *Bridge) BuildRefundTransaction(args *tokens.BuildTxArgs) (rawTx interface{}, err error) {
+	if !args.IsRefund() || args.Refund.Fee == nil {
+		return nil, tokens.ErrRefundNotAllowed
+	}
+	if args.SwapType != tokens.ERC20SwapType || args.ERC20SwapInfo == nil || b.Variant().ForbidRefund {
+		return nil, tokens.ErrRefundNotSupported
+	}
+	chainID := b.ChainConfig.ChainID
+	if args.FromChainID.String() != chainID || args.ToChainID.String() != chainID {
+		return nil, tokens.ErrToChainIDMismatch
+	}
+	if args.Input != nil {
+		return nil, fmt.Errorf("forbid build raw swap tx with input data")
+	}
+	if args.From == "" {
+		return nil, fmt.Errorf("forbid empty sender")
+	}
+
+	token := args.ERC20SwapInfo.Token
+	routerContract, err := router.GetRefundRouterContract(token, chainID)
+	if err != nil {
+		return nil, err
+	}
+	routerMPC, err := router.GetRefundRouterMPC(token, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsEqualIgnoreCase(args.From, routerMPC) {
+		log.Error("build refund tx mpc mismatch", "have", args.From, "want", routerMPC)
+		return nil, tokens.ErrSenderMismatch
+	}
+
+	receiver := common.HexToAddress(args.Bind)
+	if receiver == (common.Address{}) || !common.IsHexAddress(args.Bind) {
+		return nil, errors.New("can not refund to empty or invalid receiver")
+	}
+	if args.OriginValue == nil {
+		return nil, tokens.ErrNilSwapValue
+	}
+	amount := new(big.Int).Sub(args.OriginValue, args.Refund.Fee)
+	if amount.Sign() <= 0 {
+		return nil, tokens.ErrTxWithWrongValue
+	}
+
+	routerVersion := b.ChainConfig.RouterVersion
+	if b.GetTokenConfig(token) != nil {
+		routerVersion = b.GetRouterVersion(token)
+	}
+
+	var input []byte
+	if routerVersion == "v7" {
+		input = abicoder.PackDataWithFuncHash(AnySwapInAutoFuncHashV7,
+			args.GetUniqueSwapIdentifier(),
+			common.HexToHash(args.ERC20SwapInfo.SwapoutID),
+			common.HexToAddress(token),
+			receiver,
+			amount,
+			args.FromChainID,
+		)
+	} else {
+		var swapIDHash common.Hash
+		if common.IsHexHash(args.SwapID) {
+			swapIDHash = common.HexToHash(args.SwapID)
+		} else {
+			swapIDHash = common.BytesToHash([]byte(args.SwapID))
+		}
+		input = abicoder.PackDataWithFuncHash(AnySwapInAutoFuncHash,
+			swapIDHash,
+			common.HexToAddress(token),
+			receiver,
+			amount,
+			args.FromChainID,
+		)
+	}
+	args.Input = (*hexutil.Bytes)(&input) // input
+	args.To = routerContract              // to
+	args.SwapValue = amount               // swapValue
+
+	err = b.setDefaults(args)
+	if err != nil {
+		return nil, err
+	}
+	args.Extra.BridgeFee = args.Refund.Fee
+
+	return b.buildTx(args)
+}
@@ -0,0 +1,32 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// CheckSourceTx impl tokens.ISourceTxChecker
+// the tx is reorged if its receipt is missing, failed,
+// or its block is not on the canonical chain any more.
+func (b *Bridge) CheckSourceTx(txHash string) error {
+	receipt, err := b.EvmContractBridge.GetTransactionReceipt(txHash)
+	switch {
+	case err == nil:
+	case errors.Is(err, tokens.ErrNotFound),
+		errors.Is(err, errTxInOrphanBlock),
+		errors.Is(err, errTxBlockHashMismatch):
+		return fmt.Errorf("%w: %v", tokens.ErrSourceTxReorged, err)
+	default:
+		return err
+	}
+	if receipt.Status == nil || *receipt.Status != 1 {
+		return fmt.Errorf("%w: tx receipt status is not success", tokens.ErrSourceTxReorged)
+	}
+	err = b.checkTxBlockHash(receipt.BlockNumber.ToInt(), *receipt.BlockHash)
+	if errors.Is(err, errTxBlockHashMismatch) {
+		return fmt.Errorf("%w: %v", tokens.ErrSourceTxReorged, err)
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package eth
+
+import (
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns txs in blocks [start, end] which emitted logs from router contracts
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	routerContracts := b.getAllRouterContracts()
+	if len(routerContracts) == 0 {
+		return nil, tokens.ErrMissRouterInfo
+	}
+	filter := &types.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(start),
+		ToBlock:   new(big.Int).SetUint64(end),
+		Addresses: routerContracts,
+	}
+	logs, err := b.GetLogs(filter)
+	if err != nil {
+		return nil, err
+	}
+	exist := make(map[common.Hash]struct{})
+	for _, rlog := range logs {
+		if rlog.TxHash == nil || (rlog.Removed != nil && *rlog.Removed) {
+			continue
+		}
+		if _, ok := exist[*rlog.TxHash]; ok {
+			continue
+		}
+		exist[*rlog.TxHash] = struct{}{}
+		txHashes = append(txHashes, rlog.TxHash.Hex())
+	}
+	return txHashes, nil
+}
+
+func (b *Bridge) getAllRouterContracts() []common.Address {
+	result := make([]common.Address, 0, 1)
+	exist := make(map[common.Address]struct{})
+	addRouter := func(router string) {
+		if !common.IsHexAddress(router) {
+			return
+		}
+		addr := common.HexToAddress(router)
+		if _, ok := exist[addr]; !ok {
+			exist[addr] = struct{}{}
+			result = append(result, addr)
+		}
+	}
+	addRouter(b.ChainConfig.RouterContract)
+	addRouter(tokens.GetSwapTypeRouterContract(tokens.NFTSwapType, b.ChainConfig.ChainID))
+	addRouter(tokens.GetSwapTypeRouterContract(tokens.AnyCallSwapType, b.ChainConfig.ChainID))
+	b.TokenConfigMap.Range(func(_, value interface{}) bool {
+		if tokenCfg, ok := value.(*tokens.TokenConfig); ok {
+			addRouter(tokenCfg.RouterContract)
+		}
+		return true
+	})
+	return result
+}
@@ -26,8 +26,8 @@ func (b *Bridge) SendZKSyncTransaction(signedTx interface{}) (txHash string, err
 
 // SendTransaction send signed tx
 func (b *Bridge) SendTransaction(signedTx interface{}) (txHash string, err error) {
-	if b.IsZKSync() {
-		return b.SendZKSyncTransaction(signedTx)
+	if v := b.Variant(); v.SendTransaction != nil {
+		return v.SendTransaction(b, signedTx)
 	}
 	tx, ok := signedTx.(*types.Transaction)
 	if !ok {
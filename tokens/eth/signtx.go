@@ -21,7 +21,7 @@ import (
 	"github.com/zksync-sdk/zksync2-go"
 )
 
-func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*types.Transaction, error) {
+func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, args *tokens.BuildTxArgs) (*types.Transaction, error) {
 	tx, ok := rawTx.(*types.Transaction)
 	if !ok {
 		return nil, errors.New("[sign] wrong raw tx param")
@@ -29,7 +29,16 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 	if tx.To() == nil || *tx.To() == (common.Address{}) {
 		return nil, errors.New("[sign] tx receiver is empty")
 	}
-	checkReceiver, err := router.GetTokenRouterContract(tokenID, b.ChainConfig.ChainID)
+	var checkReceiver string
+	var err error
+	switch {
+	case args.IsRefund():
+		checkReceiver, err = router.GetRefundRouterContract(args.GetToken(), b.ChainConfig.ChainID)
+	case args.IsGasDrop():
+		checkReceiver = args.Bind
+	default:
+		checkReceiver, err = router.GetTokenRouterContract(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +48,7 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 	return tx, nil
 }
 
-func (b *Bridge) verifyZkSyncTransactionReceiver(rawTx interface{}, tokenID string) (*zksync2.Transaction712, error) {
+func (b *Bridge) verifyZkSyncTransactionReceiver(rawTx interface{}, args *tokens.BuildTxArgs) (*zksync2.Transaction712, error) {
 	tx, ok := rawTx.(*zksync2.Transaction712)
 	if !ok {
 		return nil, errors.New("[sign] wrong raw tx param")
@@ -47,7 +56,7 @@ func (b *Bridge) verifyZkSyncTransactionReceiver(rawTx interface{}, tokenID stri
 	if tx.To == nil || *tx.To == (ethcommon.Address{}) {
 		return nil, errors.New("[sign] tx receiver is empty")
 	}
-	checkReceiver, err := router.GetTokenRouterContract(tokenID, b.ChainConfig.ChainID)
+	checkReceiver, err := router.GetTokenRouterContract(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +85,7 @@ type SignedZKSyncTx struct {
 }
 
 func (b *Bridge) MPCSignZkSyncTransaction(rawTx interface{}, args *tokens.BuildTxArgs) (signTx interface{}, txHash string, err error) {
-	tx, err := b.verifyZkSyncTransactionReceiver(rawTx, args.GetTokenID())
+	tx, err := b.verifyZkSyncTransactionReceiver(rawTx, args)
 	if err != nil {
 		return nil, "", err
 	}
@@ -97,7 +106,7 @@ func (b *Bridge) MPCSignZkSyncTransaction(rawTx interface{}, args *tokens.BuildT
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
@@ -160,10 +169,10 @@ func (b *Bridge) MPCSignZkSyncTransaction(rawTx interface{}, args *tokens.BuildT
 
 // MPCSignTransaction mpc sign raw tx
 func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs) (signTx interface{}, txHash string, err error) {
-	if b.IsZKSync() {
-		return b.MPCSignZkSyncTransaction(rawTx, args)
+	if v := b.Variant(); v.MPCSignTransaction != nil {
+		return v.MPCSignTransaction(b, rawTx, args)
 	}
-	tx, err := b.verifyTransactionReceiver(rawTx, args.GetTokenID())
+	tx, err := b.verifyTransactionReceiver(rawTx, args)
 	if err != nil {
 		return nil, "", err
 	}
@@ -178,7 +187,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
@@ -197,7 +206,17 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 	logPrefix := b.ChainConfig.BlockChain + " MPCSignTransaction "
 	log.Info(logPrefix+"start", "txid", txid, "msghash", msgHash.String())
 	mpcConfig := mpc.GetMPCConfig(b.UseFastMPC)
-	keyID, rsvs, err := mpcConfig.DoSignOneEC(mpcPubkey, msgHash.String(), msgContext)
+	var keyID string
+	var rsvs []string
+	if mpcConfig.IsOfflineSign() {
+		offlineRawTx, errf := b.EncodeOfflineRawTx(tx)
+		if errf != nil {
+			return nil, "", errf
+		}
+		keyID, rsvs, err = mpcConfig.DoSignOneECOffline(b.ChainConfig.ChainID, mpcPubkey, msgHash.String(), msgContext, offlineRawTx, args)
+	} else {
+		keyID, rsvs, err = mpcConfig.DoSignOneEC(mpcPubkey, msgHash.String(), msgContext)
+	}
 	if err != nil {
 		log.Info(logPrefix+"failed", "keyID", keyID, "txid", txid, "err", err)
 		return nil, "", err
@@ -0,0 +1,135 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+var errNoRevertData = errors.New("no revert data")
+
+func initRouterCustomErrors() {
+	serverCfg := params.GetRouterServerConfig()
+	if serverCfg == nil {
+		return
+	}
+	for _, signature := range serverCfg.RouterCustomErrors {
+		abicoder.RegisterCustomError(signature)
+	}
+}
+
+// SimulateTx simulate tx by `eth_call` against the pending state,
+// returns the decoded revert reason if the tx reverts.
+// rpc errors other than revert are returned with nil reason.
+func (b *Bridge) SimulateTx(from string, tx *types.Transaction) (*abicoder.RevertReason, error) {
+	reqArgs := map[string]interface{}{
+		"from":  from,
+		"to":    tx.To().LowerHex(),
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"data":  hexutil.Bytes(tx.Data()),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		reqArgs["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		reqArgs["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		reqArgs["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+	var err error
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		var result hexutil.Bytes
+		err = client.RPCPostWithTimeout(b.RPCClientTimeout, &result, url, "eth_call", reqArgs, "pending")
+		if err == nil {
+			return nil, nil
+		}
+		if reason, errd := getRevertReason(err); errd == nil {
+			return reason, nil
+		}
+	}
+	return nil, wrapRPCQueryError(err, "eth_call", from, "pending")
+}
+
+// getRevertReason get revert reason from rpc error of `eth_call`
+func getRevertReason(err error) (*abicoder.RevertReason, error) {
+	var dataErr client.DataError
+	if errors.As(err, &dataErr) {
+		if dataStr, ok := dataErr.ErrorData().(string); ok {
+			if data, errd := hexutil.Decode(dataStr); errd == nil {
+				if reason, errr := abicoder.DecodeRevertReason(data); errr == nil {
+					return reason, nil
+				}
+			}
+		}
+	}
+	// some nodes only return the reason in message
+	if msg := err.Error(); strings.Contains(msg, "revert") {
+		return &abicoder.RevertReason{Reason: msg}, nil
+	}
+	return nil, errNoRevertData
+}
+
+// permanentPanicCodes panics which do not depend on the chain state.
+// others (eg. 0x11 overflow, 0x12 division by zero, 0x32 out of bounds)
+// may be caused by the current state and are retried.
+var permanentPanicCodes = map[uint64]struct{}{
+	0x01: {}, // assert failed
+	0x21: {}, // invalid enum value
+}
+
+// isPermanentRevert the panics in `permanentPanicCodes`, and the reasons
+// configed in `PermanentRevertReasons` are treated as permanent.
+func isPermanentRevert(reason *abicoder.RevertReason) bool {
+	if reason.IsPanic() && reason.PanicCode.IsUint64() {
+		if _, exist := permanentPanicCodes[reason.PanicCode.Uint64()]; exist {
+			return true
+		}
+	}
+	serverCfg := params.GetRouterServerConfig()
+	if serverCfg == nil {
+		return false
+	}
+	reasonStr := strings.ToLower(reason.String())
+	for _, pattern := range serverCfg.PermanentRevertReasons {
+		if strings.Contains(reasonStr, strings.ToLower(pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+// simulateTx simulate the built payout before mpc signing (server only).
+// replacing txs are not simulated as the pending state may include the replaced tx.
+func (b *Bridge) simulateTx(rawTx interface{}, args *tokens.BuildTxArgs) error {
+	serverCfg := params.GetRouterServerConfig()
+	if !params.IsSwapServer || serverCfg == nil || !serverCfg.EnableSimulateTx {
+		return nil
+	}
+	if args.GetReplaceNum() > 0 {
+		return nil
+	}
+	tx, ok := rawTx.(*types.Transaction)
+	if !ok {
+		return nil
+	}
+	reason, err := b.SimulateTx(args.From, tx)
+	if err != nil {
+		log.Warn("simulate tx failed", "chainID", b.ChainConfig.ChainID, "swapID", args.SwapID, "err", err)
+		return nil
+	}
+	if reason == nil {
+		return nil
+	}
+	log.Warn("simulate tx reverted", "chainID", b.ChainConfig.ChainID, "swapID", args.SwapID, "logIndex", args.LogIndex, "reason", reason.String())
+	if isPermanentRevert(reason) {
+		return fmt.Errorf("%w: %v", tokens.ErrTxRevertPermanently, reason.String())
+	}
+	return fmt.Errorf("%w %v: %v", tokens.ErrBuildTxErrorAndDelay, tokens.ErrTxSimulateReverted, reason.String())
+}
@@ -0,0 +1,35 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/abicoder"
+)
+
+func TestIsPermanentRevert(t *testing.T) {
+	config := params.GetRouterConfig()
+	config.Server = &params.RouterServerConfig{
+		PermanentRevertReasons: []string{"SwapIDExist", "panic: 0x12"},
+	}
+	defer func() { config.Server = nil }()
+
+	tests := []struct {
+		reason    *abicoder.RevertReason
+		permanent bool
+	}{
+		{&abicoder.RevertReason{PanicCode: big.NewInt(0x01)}, true},
+		{&abicoder.RevertReason{PanicCode: big.NewInt(0x21)}, true},
+		{&abicoder.RevertReason{PanicCode: big.NewInt(0x11)}, false},
+		{&abicoder.RevertReason{PanicCode: big.NewInt(0x32)}, false},
+		{&abicoder.RevertReason{PanicCode: big.NewInt(0x12)}, true}, // configed
+		{&abicoder.RevertReason{Custom: "SwapIDExist(bytes32)"}, true},
+		{&abicoder.RevertReason{Reason: "insufficient balance"}, false},
+	}
+	for i, tt := range tests {
+		if have := isPermanentRevert(tt.reason); have != tt.permanent {
+			t.Errorf("case %d: isPermanentRevert(%v) = %v, want %v", i, tt.reason, have, tt.permanent)
+		}
+	}
+}
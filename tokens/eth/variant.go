@@ -0,0 +1,158 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/types"
+)
+
+// builtin evm chain variants
+const (
+	StandardVariant = "standard"
+	ConfluxVariant  = "conflux"
+	KusamaVariant   = "kusama"
+	ArbitrumVariant = "arbitrum"
+	ZKSyncVariant   = "zksync"
+	SapphireVariant = "sapphire"
+	ETCVariant      = "etc"
+)
+
+var (
+	variantsLock       sync.RWMutex
+	variants           = make(map[string]*Variant) // key is variant name
+	chainVariants      = make(map[string]*Variant) // key is chain ID
+	blockChainVariants = make(map[string]*Variant) // key is upper case block chain
+
+	standardVariant = &Variant{Name: StandardVariant}
+)
+
+// Variant evm chain variant which declares the behaviors differ from the common evm chains.
+// chains map to variants by 'EVMVariant' in local chain config, or by the default
+// 'ChainIDs' and 'BlockChains' of the variant. nil hooks use the common implementations.
+type Variant struct {
+	Name        string
+	ChainIDs    []string // chain IDs use this variant by default
+	BlockChains []string // block chains use this variant by default
+
+	// finality query
+	GetBlockConfirmations   func(b *Bridge, receipt *types.RPCTxReceipt) (uint64, error)
+	IsL2Finalized           func(b *Bridge, receipt *types.RPCTxReceipt, level string) (bool, error)
+	NeedsFinalizeAPIAddress bool
+
+	// tx encoding and signing
+	GetSignerChainID   func(b *Bridge) (*big.Int, error)
+	NewRawTx           func(b *Bridge, args *tokens.BuildTxArgs, nonce, gasLimit uint64, to common.Address, value, gasPrice *big.Int, input []byte) interface{}
+	MPCSignTransaction func(b *Bridge, rawTx interface{}, args *tokens.BuildTxArgs) (signedTx interface{}, txHash string, err error)
+	VerifyMsgHash      func(b *Bridge, rawTx interface{}, msgHashes []string) error
+
+	// send path
+	SendTransaction       func(b *Bridge, signedTx interface{}) (txHash string, err error)
+	SendSignedTransaction func(b *Bridge, tx *types.Transaction) (txHash string, err error)
+
+	// contract call and gas estimation
+	CallContract      func(b *Bridge, contract string, data hexutil.Bytes, blockNumber string) (string, error)
+	EstimateGas       func(b *Bridge, from, to string, value *big.Int, data []byte) (uint64, error)
+	AlwaysEstimateGas bool // estimate gas limit if not specified
+
+	// receipt quirks
+	GetTransactionReceipt func(b *Bridge, txHash string) (*types.RPCTxReceipt, error)
+
+	// unsupported features
+	ForbidRefund         bool
+	ForbidGasDrop        bool
+	ForbidOfflineSigning bool
+}
+
+// RegisterVariant register evm chain variant
+func RegisterVariant(v *Variant) error {
+	if v == nil || v.Name == "" {
+		return fmt.Errorf("register evm variant with empty name")
+	}
+	name := strings.ToLower(v.Name)
+	variantsLock.Lock()
+	defer variantsLock.Unlock()
+	if _, exist := variants[name]; exist {
+		return fmt.Errorf("evm variant '%v' is already registered", v.Name)
+	}
+	for _, chainID := range v.ChainIDs {
+		if old, exist := chainVariants[chainID]; exist {
+			return fmt.Errorf("chain ID %v of evm variant '%v' is already used by '%v'", chainID, v.Name, old.Name)
+		}
+	}
+	variants[name] = v
+	for _, chainID := range v.ChainIDs {
+		chainVariants[chainID] = v
+	}
+	for _, blockChain := range v.BlockChains {
+		blockChainVariants[strings.ToUpper(blockChain)] = v
+	}
+	return nil
+}
+
+func mustRegisterVariant(v *Variant) {
+	if err := RegisterVariant(v); err != nil {
+		panic(err)
+	}
+}
+
+// GetVariant get registered evm chain variant by name
+func GetVariant(name string) *Variant {
+	name = strings.ToLower(name)
+	if name == StandardVariant {
+		return standardVariant
+	}
+	variantsLock.RLock()
+	defer variantsLock.RUnlock()
+	return variants[name]
+}
+
+// Variant get the evm chain variant of this bridge
+func (b *Bridge) Variant() *Variant {
+	if b.ChainConfig == nil {
+		return standardVariant
+	}
+	if name := params.GetEVMVariant(b.ChainConfig.ChainID); name != "" {
+		if v := GetVariant(name); v != nil {
+			return v
+		}
+		return standardVariant
+	}
+	variantsLock.RLock()
+	defer variantsLock.RUnlock()
+	if v, exist := chainVariants[b.ChainConfig.ChainID]; exist {
+		return v
+	}
+	if v, exist := blockChainVariants[strings.ToUpper(b.ChainConfig.BlockChain)]; exist {
+		return v
+	}
+	return standardVariant
+}
+
+func (b *Bridge) checkVariant() error {
+	if name := params.GetEVMVariant(b.ChainConfig.ChainID); name != "" && GetVariant(name) == nil {
+		return fmt.Errorf("unknown evm variant '%v'", name)
+	}
+	return nil
+}
+
+// NeedsFinalizeAPIAddress need special finalize api
+func (b *Bridge) NeedsFinalizeAPIAddress() bool {
+	return b.Variant().NeedsFinalizeAPIAddress
+}
+
+// IsSapphireChain is oasis sapphire chain (confidential evm)
+func (b *Bridge) IsSapphireChain() bool {
+	return b.Variant().Name == SapphireVariant
+}
+
+// IsZKSync is zksync chain
+func (b *Bridge) IsZKSync() bool {
+	return b.Variant().Name == ZKSyncVariant
+}
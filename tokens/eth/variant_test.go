@@ -0,0 +1,52 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+func newVariantTestBridge(chainID, blockChain string) *Bridge {
+	b := NewCrossChainBridge()
+	b.SetChainConfig(&tokens.ChainConfig{ChainID: chainID, BlockChain: blockChain})
+	return b
+}
+
+func TestDefaultVariant(t *testing.T) {
+	tests := []struct {
+		chainID    string
+		blockChain string
+		want       string
+	}{
+		{"1", "ETH", StandardVariant},
+		{"1030", "CONFLUX", ConfluxVariant},
+		{"1285", "MOONRIVER", KusamaVariant},
+		{"42161", "ARBITRUM", ArbitrumVariant},
+		{"324", "ZKSYNC", ZKSyncVariant},
+		{"23294", "SAPPHIRE", SapphireVariant},
+		{"61", "ETHCLASSIC", ETCVariant},
+	}
+	for _, tt := range tests {
+		if have := newVariantTestBridge(tt.chainID, tt.blockChain).Variant().Name; have != tt.want {
+			t.Errorf("variant of chain %v mismatch, have %v want %v", tt.chainID, have, tt.want)
+		}
+	}
+	if newVariantTestBridge("1071", "CONFLUX").Variant().Name != StandardVariant {
+		t.Errorf("unregistered chain should use standard variant")
+	}
+}
+
+func TestRegisterVariant(t *testing.T) {
+	if err := RegisterVariant(&Variant{Name: ZKSyncVariant}); err == nil {
+		t.Errorf("register duplicate variant name should fail")
+	}
+	if err := RegisterVariant(&Variant{Name: "testvariant", ChainIDs: []string{"324"}}); err == nil {
+		t.Errorf("register variant with used chain ID should fail")
+	}
+	if err := RegisterVariant(&Variant{Name: "testvariant2", ChainIDs: []string{"99999999"}, ForbidRefund: true}); err != nil {
+		t.Fatalf("register variant failed: %v", err)
+	}
+	if !newVariantTestBridge("99999999", "TEST").Variant().ForbidRefund {
+		t.Errorf("chain should use the registered variant")
+	}
+}
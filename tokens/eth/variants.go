@@ -0,0 +1,114 @@
+package eth
+
+import (
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/eth/callapi"
+	"github.com/deltaswapio/swaprouter/v3/types"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zksync-sdk/zksync2-go"
+)
+
+func init() {
+	mustRegisterVariant(&Variant{
+		Name:     KusamaVariant,
+		ChainIDs: []string{"1285", "336"}, // moonriver, shiden
+		GetBlockConfirmations: func(b *Bridge, receipt *types.RPCTxReceipt) (uint64, error) {
+			return callapi.KsmGetBlockConfirmations(b, receipt)
+		},
+	})
+
+	mustRegisterVariant(&Variant{
+		Name:     ConfluxVariant,
+		ChainIDs: []string{"1030", "71"}, // mainnet, testnet
+		GetBlockConfirmations: func(b *Bridge, receipt *types.RPCTxReceipt) (uint64, error) {
+			return callapi.CfxGetBlockConfirmations(b, receipt)
+		},
+		NeedsFinalizeAPIAddress: true,
+	})
+
+	mustRegisterVariant(&Variant{
+		Name:     ArbitrumVariant,
+		ChainIDs: []string{"42161"},
+		GetBlockConfirmations: func(b *Bridge, receipt *types.RPCTxReceipt) (uint64, error) {
+			return callapi.ArbGetBlockConfirmations(b, receipt)
+		},
+		IsL2Finalized: func(b *Bridge, receipt *types.RPCTxReceipt, level string) (bool, error) {
+			return callapi.ArbIsL2Finalized(b, receipt, level)
+		},
+	})
+
+	mustRegisterVariant(&Variant{
+		Name:     ZKSyncVariant,
+		ChainIDs: []string{"280", "324"}, // testnet, mainnet
+		NewRawTx: newZKSyncRawTx,
+		MPCSignTransaction: func(b *Bridge, rawTx interface{}, args *tokens.BuildTxArgs) (interface{}, string, error) {
+			return b.MPCSignZkSyncTransaction(rawTx, args)
+		},
+		VerifyMsgHash: func(b *Bridge, rawTx interface{}, msgHashes []string) error {
+			return b.verifyZKSyncMsgHash(rawTx, msgHashes)
+		},
+		SendTransaction: func(b *Bridge, signedTx interface{}) (string, error) {
+			return b.SendZKSyncTransaction(signedTx)
+		},
+		ForbidRefund:         true,
+		ForbidGasDrop:        true,
+		ForbidOfflineSigning: true,
+	})
+
+	mustRegisterVariant(&Variant{
+		Name:     SapphireVariant,
+		ChainIDs: []string{"23294", "23295"}, // mainnet, testnet
+		VerifyMsgHash: func(b *Bridge, rawTx interface{}, msgHashes []string) error {
+			return b.verifySapphireMsgHash(rawTx, msgHashes)
+		},
+		SendSignedTransaction: func(b *Bridge, tx *types.Transaction) (string, error) {
+			return b.SendSignedTransactionSapphire(tx)
+		},
+		CallContract: func(b *Bridge, contract string, data hexutil.Bytes, blockNumber string) (string, error) {
+			return b.CallContractSapphire(contract, data, blockNumber)
+		},
+		EstimateGas: func(b *Bridge, from, to string, value *big.Int, data []byte) (uint64, error) {
+			return b.EstimateGasSapphire(from, to, value, data)
+		},
+		AlwaysEstimateGas:    true,
+		ForbidOfflineSigning: true,
+	})
+
+	mustRegisterVariant(&Variant{
+		Name:        ETCVariant,
+		BlockChains: []string{"ETHCLASSIC"},
+		GetSignerChainID: func(b *Bridge) (*big.Int, error) {
+			return b.getETCSignerChainID()
+		},
+	})
+}
+
+func newZKSyncRawTx(b *Bridge, args *tokens.BuildTxArgs, nonce, gasLimit uint64, to common.Address, value, gasPrice *big.Int, input []byte) interface{} {
+	chainId, _ := new(big.Int).SetString(b.ChainConfig.ChainID, 0)
+	tx := zksync2.CreateFunctionCallTransaction(
+		ethcommon.HexToAddress(args.From),
+		ethcommon.HexToAddress(to.Hex()),
+		big.NewInt(0),
+		big.NewInt(0),
+		value,
+		input,
+		nil, nil,
+	)
+	return zksync2.NewTransaction712(
+		chainId,
+		big.NewInt(int64(nonce)),
+		big.NewInt(int64(gasLimit)),
+		ethcommon.HexToAddress(to.Hex()),
+		value,
+		input,
+		big.NewInt(100000000), // TODO: Estimate correct one
+		gasPrice,
+		ethcommon.HexToAddress(args.From),
+		tx.Eip712Meta,
+	)
+}
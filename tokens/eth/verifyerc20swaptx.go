@@ -84,6 +84,24 @@ func (b *Bridge) VerifyERC20SwapTx(txHash string, logIndex int, allowUnstable bo
 	}
 
 	if !allowUnstable {
+		fromTokenCfg := b.GetTokenConfig(swapInfo.ERC20SwapInfo.Token)
+		if fromTokenCfg == nil {
+			return swapInfo, tokens.ErrMissTokenConfig
+		}
+		tier := tokens.GetConfirmationTier(swapInfo, fromTokenCfg.Decimals)
+		err = tokens.CheckTierConfirmations(swapInfo, tier)
+		if err != nil {
+			return swapInfo, err
+		}
+		level := tokens.GetL2FinalityOfValue(swapInfo, fromTokenCfg.Decimals)
+		if tier != nil && params.GetL2FinalityRank(tier.Finality) > params.GetL2FinalityRank(level) {
+			level = tier.Finality
+		}
+		err = b.checkL2Finality(swapInfo, receipt, level)
+		if err != nil {
+			return swapInfo, err
+		}
+
 		ctx := []interface{}{
 			"identifier", params.GetIdentifier(),
 			"from", swapInfo.From, "to", swapInfo.To,
@@ -161,6 +179,23 @@ func (b *Bridge) checkERC20SwapInfo(swapInfo *tokens.SwapTxInfo) error {
 	return nil
 }
 
+// checkL2Finality check the source tx reached the required l2 finality level
+func (b *Bridge) checkL2Finality(swapInfo *tokens.SwapTxInfo, receipt *types.RPCTxReceipt, level string) error {
+	if params.GetL2FinalityRank(level) <= 0 {
+		return nil
+	}
+	finalized, err := b.IsL2Finalized(receipt, level)
+	if err != nil {
+		log.Warn("check l2 finality failed", "chainID", b.ChainConfig.ChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex, "level", level, "err", err)
+		return fmt.Errorf("%w: check %v finality failed", tokens.ErrTxNotStable, level)
+	}
+	if !finalized {
+		log.Info("tx has not reached l2 finality", "chainID", b.ChainConfig.ChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex, "level", level, "height", swapInfo.Height)
+		return fmt.Errorf("%w: wait for %v finality", tokens.ErrTxNotStable, level)
+	}
+	return nil
+}
+
 func (b *Bridge) getSwapTxReceipt(swapInfo *tokens.SwapTxInfo, allowUnstable bool) (receipt *types.RPCTxReceipt, err error) {
 	txStatus, err := b.GetTransactionStatus(swapInfo.Hash)
 	if err != nil {
@@ -180,9 +215,11 @@ func (b *Bridge) getSwapTxReceipt(swapInfo *tokens.SwapTxInfo, allowUnstable boo
 
 	swapInfo.Height = txStatus.BlockHeight  // Height
 	swapInfo.Timestamp = txStatus.BlockTime // Timestamp
+	swapInfo.Confirmations = txStatus.Confirmations
 
 	if !allowUnstable && txStatus.Confirmations < b.ChainConfig.Confirmations {
-		return nil, tokens.ErrTxNotStable
+		return nil, fmt.Errorf("%w: wait for %v confirmations",
+			tokens.ErrTxNotStable, b.ChainConfig.Confirmations)
 	}
 
 	receipt, ok := txStatus.Receipt.(*types.RPCTxReceipt)
@@ -190,6 +227,21 @@ func (b *Bridge) getSwapTxReceipt(swapInfo *tokens.SwapTxInfo, allowUnstable boo
 		return receipt, tokens.ErrTxWithWrongReceipt
 	}
 
+	if !allowUnstable {
+		err = b.checkL2Finality(swapInfo, receipt, params.GetL2Finality(b.ChainConfig.ChainID))
+		if err != nil {
+			return nil, err
+		}
+		err = b.checkReceiptQuorum(swapInfo.Hash, receipt)
+		if err != nil {
+			return nil, err
+		}
+		err = b.checkReceiptProof(swapInfo.Hash, receipt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if receipt.Recipient == nil {
 		if !params.AllowCallByConstructor() {
 			log.Warn("disallow constructor tx", "chainID", b.ChainConfig.ChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex, "err", tokens.ErrTxWithWrongContract)
@@ -44,27 +44,32 @@ func (b *Bridge) GetTransactionStatus(txHash string) (*tokens.TxStatus, error) {
 
 // VerifyMsgHash verify msg hash
 func (b *Bridge) VerifyMsgHash(rawTx interface{}, msgHashes []string) error {
-	if b.IsZKSync() {
-		return b.verifyZKSyncMsgHash(rawTx, msgHashes)
-	}
-	if b.IsSapphireChain() {
-		rawSapphire, ok := rawTx.(*SapphireRPCTx)
-		if ok {
-			tx2 := new(ethtypes.Transaction)
-			err := tx2.UnmarshalBinary(rawSapphire.Raw)
-			if err != nil {
-				return tokens.ErrWrongRawTx
-			}
-			chainId := b.ChainConfig.GetChainID()
-			signer := ethtypes.LatestSignerForChainID(chainId)
-			msg, _ := tx2.AsMessage(signer, nil)
-			if msg.From().Hex() == common.HexToAddress(rawSapphire.Sender).Hex() {
-				return nil
-			} else {
-				return tokens.ErrWrongRawTx
-			}
-		}
+	if v := b.Variant(); v.VerifyMsgHash != nil {
+		return v.VerifyMsgHash(b, rawTx, msgHashes)
+	}
+	return b.verifyMsgHash(rawTx, msgHashes)
+}
+
+func (b *Bridge) verifySapphireMsgHash(rawTx interface{}, msgHashes []string) error {
+	rawSapphire, ok := rawTx.(*SapphireRPCTx)
+	if !ok {
+		return b.verifyMsgHash(rawTx, msgHashes)
 	}
+	tx2 := new(ethtypes.Transaction)
+	err := tx2.UnmarshalBinary(rawSapphire.Raw)
+	if err != nil {
+		return tokens.ErrWrongRawTx
+	}
+	chainId := b.ChainConfig.GetChainID()
+	signer := ethtypes.LatestSignerForChainID(chainId)
+	msg, _ := tx2.AsMessage(signer, nil)
+	if msg.From().Hex() != common.HexToAddress(rawSapphire.Sender).Hex() {
+		return tokens.ErrWrongRawTx
+	}
+	return nil
+}
+
+func (b *Bridge) verifyMsgHash(rawTx interface{}, msgHashes []string) error {
 	tx, ok := rawTx.(*types.Transaction)
 	if !ok {
 		return tokens.ErrWrongRawTx
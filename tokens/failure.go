@@ -0,0 +1,73 @@
+package tokens
+
+import "strings"
+
+// FailureCategory category of failed destination tx
+type FailureCategory string
+
+// FailureCategory constants
+const (
+	FailureUnknown               FailureCategory = "unknown"
+	FailureOutOfGas              FailureCategory = "outOfGas"
+	FailureInsufficientLiquidity FailureCategory = "insufficientLiquidity"
+	FailureNonceConflict         FailureCategory = "nonceConflict"
+	FailurePausedToken           FailureCategory = "pausedToken"
+	FailureReceiverRejected      FailureCategory = "receiverRejected"
+	FailureFeeTooLow             FailureCategory = "feeTooLow"
+)
+
+// AllFailureCategories all failure categories
+var AllFailureCategories = []FailureCategory{
+	FailureUnknown,
+	FailureOutOfGas,
+	FailureInsufficientLiquidity,
+	FailureNonceConflict,
+	FailurePausedToken,
+	FailureReceiverRejected,
+	FailureFeeTooLow,
+}
+
+// IsValid is valid failure category
+func (c FailureCategory) IsValid() bool {
+	for _, category := range AllFailureCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// TxFailure classified failure of destination tx
+type TxFailure struct {
+	Category FailureCategory `json:"category"`
+	Reason   string          `json:"reason"`
+	GasLimit uint64          `json:"gasLimit,omitempty"`
+	GasUsed  uint64          `json:"gasUsed,omitempty"`
+}
+
+// keywords (lower case) of revert reasons to classify failures,
+// checked in order and the first matched category is used.
+var failureKeywords = []struct {
+	category FailureCategory
+	keywords []string
+}{
+	{FailureOutOfGas, []string{"out of gas", "out_of_energy", "exceeded compute", "gas exhausted"}},
+	{FailureFeeTooLow, []string{"fee too low", "underpriced", "insufficient fee", "less than block base fee", "insufficientfundsforfee"}},
+	{FailureNonceConflict, []string{"nonce too low", "nonce too high", "invalid nonce", "bad sequence"}},
+	{FailurePausedToken, []string{"paused", "pausable", "suspended", "frozen"}},
+	{FailureReceiverRejected, []string{"receiver", "non erc721", "non erc1155", "non-erc721", "non-erc1155", "blacklist", "recipient", "trustline", "no_line"}},
+	{FailureInsufficientLiquidity, []string{"exceeds balance", "insufficient balance", "insufficient liquidity", "insufficient funds", "unfunded"}},
+}
+
+// ClassifyFailureReason classify failure by the keywords of revert reason
+func ClassifyFailureReason(reason string) FailureCategory {
+	reason = strings.ToLower(reason)
+	for _, item := range failureKeywords {
+		for _, keyword := range item.keywords {
+			if strings.Contains(reason, keyword) {
+				return item.category
+			}
+		}
+	}
+	return FailureUnknown
+}
@@ -0,0 +1,44 @@
+package tokens
+
+import "testing"
+
+func TestClassifyFailureReason(t *testing.T) {
+	tests := []struct {
+		reason string
+		want   FailureCategory
+	}{
+		{"", FailureUnknown},
+		{"execution reverted", FailureUnknown},
+		{"out of gas", FailureOutOfGas},
+		{"OUT_OF_ENERGY", FailureOutOfGas},
+		{"Program failed: exceeded compute units", FailureOutOfGas},
+		{"transaction underpriced", FailureFeeTooLow},
+		{"max fee per gas less than block base fee", FailureFeeTooLow},
+		{"nonce too low", FailureNonceConflict},
+		{"tefPAST_SEQ: bad sequence", FailureNonceConflict},
+		{"Pausable: paused", FailurePausedToken},
+		{"ERC1155: transfer to non ERC1155Receiver implementer", FailureReceiverRejected},
+		{"tecNO_LINE", FailureReceiverRejected},
+		{"ERC20: transfer amount exceeds balance", FailureInsufficientLiquidity},
+		{"tecUNFUNDED_PAYMENT", FailureInsufficientLiquidity},
+		// the first matched category is used
+		{"out of gas: insufficient balance", FailureOutOfGas},
+		{"insufficient funds for gas * price + value: fee too low", FailureFeeTooLow},
+	}
+	for _, tt := range tests {
+		if have := ClassifyFailureReason(tt.reason); have != tt.want {
+			t.Errorf("ClassifyFailureReason(%q) = %v, want %v", tt.reason, have, tt.want)
+		}
+	}
+}
+
+func TestFailureCategoryIsValid(t *testing.T) {
+	for _, category := range AllFailureCategories {
+		if !category.IsValid() {
+			t.Errorf("category %v should be valid", category)
+		}
+	}
+	if FailureCategory("other").IsValid() {
+		t.Error("category other should be invalid")
+	}
+}
@@ -36,6 +36,15 @@ func GetLatestBlock(url string) (*sdk.Block, error) {
 	return latestBlock, nil
 }
 
+// GetEventsForHeightRange get events of type in blocks [start, end]
+func GetEventsForHeightRange(url, eventType string, start, end uint64) ([]sdk.BlockEvents, error) {
+	flowClient, err := grpc.NewClient(url)
+	if err != nil {
+		return nil, err
+	}
+	return flowClient.GetEventsForHeightRange(ctx, eventType, start, end)
+}
+
 // GetLatestBlockNumber get latest block height
 func GetAccount(url, address string) (*sdk.Account, error) {
 	flowClient, err := grpc.NewClient(url)
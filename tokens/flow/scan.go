@@ -0,0 +1,39 @@
+package flow
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	sdk "github.com/onflow/flow-go-sdk"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns txs in blocks [start, end] which emitted router swapout events
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	mpc, err := b.GetMPCAddress()
+	if err != nil {
+		return nil, err
+	}
+	eventType := fmt.Sprintf(Event_Type, mpc[2:])
+	var blockEvents []sdk.BlockEvents
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		blockEvents, err = GetEventsForHeightRange(url, eventType, start, end)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, tokens.WrapRPCQueryError(err, "GetEventsForHeightRange", eventType, start, end)
+	}
+	exist := make(map[sdk.Identifier]struct{})
+	for _, block := range blockEvents {
+		for _, event := range block.Events {
+			if _, ok := exist[event.TransactionID]; ok {
+				continue
+			}
+			exist[event.TransactionID] = struct{}{}
+			txHashes = append(txHashes, event.TransactionID.String())
+		}
+	}
+	return txHashes, nil
+}
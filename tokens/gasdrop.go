@@ -0,0 +1,85 @@
+package tokens
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+var (
+	// GasDropFuncHash gasDrop(uint256)
+	// the call data of swapout (with empty call proxy) to request gas drop
+	GasDropFuncHash = common.FromHex("0xb057c8c1")
+
+	gasDropPriceUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+// EncodeGasDropRequest encode gas drop request into call data
+func EncodeGasDropRequest(amount *big.Int) hexutil.Bytes {
+	data := make([]byte, 0, 36)
+	data = append(data, GasDropFuncHash...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return data
+}
+
+// GetGasDropRequest get the requested gas drop amount (nil if not requested)
+func GetGasDropRequest(info *ERC20SwapInfo) *big.Int {
+	if info == nil || info.CallProxy != "" || len(info.CallData) != 36 ||
+		!bytes.Equal(info.CallData[:4], GasDropFuncHash) {
+		return nil
+	}
+	amount := new(big.Int).SetBytes(info.CallData[4:])
+	if amount.Sign() <= 0 {
+		return nil
+	}
+	return amount
+}
+
+// SetGasDropRequest set gas drop request from memo field (native amount in smallest unit)
+func SetGasDropRequest(info *ERC20SwapInfo, memoField string) bool {
+	amount, err := common.GetBigIntFromStr(memoField)
+	if err != nil || amount.Sign() <= 0 {
+		return false
+	}
+	info.CallData = EncodeGasDropRequest(amount)
+	return true
+}
+
+// CalcGasDrop calc the gas drop amount bounded by the cap of dest chain,
+// and the fee in source token charged for it (zero if gas drop is not supported)
+func CalcGasDrop(tokenID, toChainID string, requested *big.Int, fromDecimals uint8) (gasDrop, fee *big.Int) {
+	gasDrop, fee = big.NewInt(0), big.NewInt(0)
+	if requested == nil || requested.Sign() <= 0 {
+		return gasDrop, fee
+	}
+	maxGasDrop, price := params.GetGasDropConfig(toChainID, tokenID)
+	if maxGasDrop == nil {
+		return gasDrop, fee
+	}
+	gasDrop.Set(requested)
+	if gasDrop.Cmp(maxGasDrop) > 0 {
+		gasDrop.Set(maxGasDrop)
+	}
+	fee.Mul(gasDrop, price)
+	fee.Div(fee, gasDropPriceUnit)
+	fee = ConvertTokenValue(fee, 18, fromDecimals)
+	return gasDrop, fee
+}
+
+func chargeGasDropFee(tokenID, toChainID string, valueLeft, requested *big.Int, fromDecimals uint8) (*big.Int, *big.Int) {
+	gasDrop, fee := CalcGasDrop(tokenID, toChainID, requested, fromDecimals)
+	if gasDrop.Sign() == 0 {
+		return valueLeft, gasDrop
+	}
+	if valueLeft.Cmp(fee) <= 0 {
+		log.Warn("ignore gas drop as swap value is not enough",
+			"tokenID", tokenID, "toChainID", toChainID,
+			"valueLeft", valueLeft, "gasDrop", gasDrop, "gasDropFee", fee)
+		return valueLeft, big.NewInt(0)
+	}
+	return new(big.Int).Sub(valueLeft, fee), gasDrop
+}
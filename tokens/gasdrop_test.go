@@ -0,0 +1,126 @@
+package tokens
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+const (
+	testGasDropChainID = "56"
+	testGasDropTokenID = "USDC"
+)
+
+func setGasDropTestConfig(t *testing.T) {
+	t.Helper()
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		LocalChainConfig: map[string]*params.LocalChainConfig{
+			testGasDropChainID: {
+				// cap 0.01 native, price 300 token (18 decimals) per 1e18 native
+				MaxGasDrop: "10000000000000000",
+				GasDropPrices: map[string]string{
+					"usdc": "300000000000000000000",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("set extra config failed: %v", err)
+	}
+}
+
+func TestGasDropRequest(t *testing.T) {
+	amount := big.NewInt(5e15)
+	data := EncodeGasDropRequest(amount)
+	if len(data) != 36 {
+		t.Fatalf("encoded length = %d, want 36", len(data))
+	}
+
+	info := &ERC20SwapInfo{CallData: data}
+	if have := GetGasDropRequest(info); have == nil || have.Cmp(amount) != 0 {
+		t.Errorf("GetGasDropRequest = %v, want %v", have, amount)
+	}
+
+	tests := []*ERC20SwapInfo{
+		nil,
+		{},
+		{CallData: data, CallProxy: "0x1111111111111111111111111111111111111111"},
+		{CallData: data[:35]},
+		{CallData: EncodeGasDropRequest(big.NewInt(0))},
+		{CallData: append([]byte{0, 0, 0, 0}, data[4:]...)},
+	}
+	for i, tt := range tests {
+		if have := GetGasDropRequest(tt); have != nil {
+			t.Errorf("case %d: GetGasDropRequest = %v, want nil", i, have)
+		}
+	}
+
+	info = &ERC20SwapInfo{}
+	if !SetGasDropRequest(info, "5000000000000000") {
+		t.Fatal("SetGasDropRequest failed")
+	}
+	if have := GetGasDropRequest(info); have == nil || have.Cmp(amount) != 0 {
+		t.Errorf("GetGasDropRequest after set = %v, want %v", have, amount)
+	}
+	for _, memo := range []string{"", "0", "-1", "abc"} {
+		if SetGasDropRequest(&ERC20SwapInfo{}, memo) {
+			t.Errorf("SetGasDropRequest(%q) should fail", memo)
+		}
+	}
+}
+
+func TestCalcGasDrop(t *testing.T) {
+	setGasDropTestConfig(t)
+
+	tests := []struct {
+		tokenID      string
+		toChainID    string
+		requested    *big.Int
+		fromDecimals uint8
+		gasDrop      string
+		fee          string
+	}{
+		// 0.005 native * 300 = 1.5 token
+		{testGasDropTokenID, testGasDropChainID, big.NewInt(5e15), 18, "5000000000000000", "1500000000000000000"},
+		{testGasDropTokenID, testGasDropChainID, big.NewInt(5e15), 6, "5000000000000000", "1500000"},
+		// capped by 0.01 native
+		{testGasDropTokenID, testGasDropChainID, big.NewInt(1e17), 6, "10000000000000000", "3000000"},
+		// not requested, no price of token, no config of chain
+		{testGasDropTokenID, testGasDropChainID, nil, 6, "0", "0"},
+		{testGasDropTokenID, testGasDropChainID, big.NewInt(0), 6, "0", "0"},
+		{"USDT", testGasDropChainID, big.NewInt(5e15), 6, "0", "0"},
+		{testGasDropTokenID, "1", big.NewInt(5e15), 6, "0", "0"},
+	}
+	for i, tt := range tests {
+		gasDrop, fee := CalcGasDrop(tt.tokenID, tt.toChainID, tt.requested, tt.fromDecimals)
+		if gasDrop.String() != tt.gasDrop || fee.String() != tt.fee {
+			t.Errorf("case %d: CalcGasDrop = (%v, %v), want (%v, %v)", i, gasDrop, fee, tt.gasDrop, tt.fee)
+		}
+	}
+}
+
+func TestChargeGasDropFee(t *testing.T) {
+	setGasDropTestConfig(t)
+
+	tests := []struct {
+		valueLeft *big.Int
+		requested *big.Int
+		left      string
+		gasDrop   string
+	}{
+		// 10 token left, charge 1.5 token
+		{big.NewInt(10e6), big.NewInt(5e15), "8500000", "5000000000000000"},
+		// value not enough to pay the fee, ignore gas drop
+		{big.NewInt(1e6), big.NewInt(5e15), "1000000", "0"},
+		{big.NewInt(1500000), big.NewInt(5e15), "1500000", "0"},
+		// not requested
+		{big.NewInt(10e6), nil, "10000000", "0"},
+	}
+	for i, tt := range tests {
+		left, gasDrop := chargeGasDropFee(testGasDropTokenID, testGasDropChainID, tt.valueLeft, tt.requested, 6)
+		if left.String() != tt.left || gasDrop.String() != tt.gasDrop {
+			t.Errorf("case %d: chargeGasDropFee = (%v, %v), want (%v, %v)", i, left, gasDrop, tt.left, tt.gasDrop)
+		}
+	}
+}
@@ -79,3 +79,72 @@ type ReSwapable interface {
 	SetTimeoutConfig(txTimeout uint64)
 	GetTimeoutConfig() uint64
 }
+
+// ISwapTxScanner interface (optional)
+// scan a block range for txs which may contain swaps
+// (used to find missed deposits)
+type ISwapTxScanner interface {
+	ScanSwapTxs(start, end uint64) (txHashes []string, err error)
+}
+
+// IOfflineSigner interface (optional)
+// encode and decode raw tx in offline sign bundles,
+// and verify the raw tx by the build args without rpc
+// (used by air-gapped signing)
+type IOfflineSigner interface {
+	IsOfflineSignSupported() bool
+	InitOfflineSigner() error
+	EncodeOfflineRawTx(rawTx interface{}) ([]byte, error)
+	DecodeOfflineRawTx(data []byte) (rawTx interface{}, err error)
+	VerifyOfflineRawTx(rawTx interface{}, args *BuildTxArgs) error
+}
+
+// ILiquidityChecker interface (optional)
+// check the destination liquidity of underlying tokens before swapin
+type ILiquidityChecker interface {
+	// GetTokenLiquidity returns the underlying balance which can be paid by the token,
+	// nil liquidity means unlimited (eg. mintable token without underlying)
+	GetTokenLiquidity(tokenAddr string) (*big.Int, error)
+	// IsLiquidityRequired returns true if swapin reverts when liquidity is insufficient
+	IsLiquidityRequired(tokenAddr string, withCall bool) bool
+}
+
+// ISourceTxChecker interface (optional)
+// check whether the source tx is still on the canonical chain
+// (used to watch reorgs after the swap is paid)
+type ISourceTxChecker interface {
+	// CheckSourceTx returns ErrSourceTxReorged if the tx is removed from the chain
+	CheckSourceTx(txHash string) error
+}
+
+// IRefundBuilder interface (optional)
+// build refund tx which pays back to the sender on the source chain
+type IRefundBuilder interface {
+	BuildRefundTransaction(args *BuildTxArgs) (rawTx interface{}, err error)
+}
+
+// IAccountResourceGetter interface (optional)
+// get the resources (eg. tron energy and bandwidth) of account
+type IAccountResourceGetter interface {
+	GetAccountResource(account string) (*AccountResource, error)
+}
+
+// ITrustlineChecker interface (optional)
+// check the receiver has created trustline of the issued asset before payout
+type ITrustlineChecker interface {
+	// CheckTrustline returns the trustline required to receive the token,
+	// nil info means no trustline is required (eg. native asset)
+	CheckTrustline(tokenAddr, receiver string) (*TrustlineInfo, error)
+}
+
+// IFailureClassifier interface (optional)
+// classify failed destination tx by its receipt, trace or revert data
+type IFailureClassifier interface {
+	ClassifyFailedTx(txHash string) (*TxFailure, error)
+}
+
+// IGasDropBuilder interface (optional)
+// build native coin transfer tx which tops up the receiver on the dest chain
+type IGasDropBuilder interface {
+	BuildGasDropTransaction(args *BuildTxArgs) (rawTx interface{}, err error)
+}
@@ -37,6 +37,15 @@ func GetTransactionByHash(url string, msgID [32]byte) (*iotago.Message, error) {
 	}
 }
 
+func GetMessageIDsByIndex(url string, index []byte) ([]string, error) {
+	nodeHTTPAPIClient := iotago.NewNodeHTTPAPIClient(url)
+	if messages, err := nodeHTTPAPIClient.MessageIDsByIndex(ctx, index); err != nil {
+		return nil, err
+	} else {
+		return messages.MessageIDs, nil
+	}
+}
+
 func GetLatestBlockNumber(url string) (uint64, error) {
 	nodeHTTPAPIClient := iotago.NewNodeHTTPAPIClient(url)
 	if nodeInfoResponse, err := nodeHTTPAPIClient.Info(ctx); err != nil {
@@ -0,0 +1,32 @@
+package iota
+
+import (
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// iota has no block, the swapout messages are queried by index,
+// and filtered by referenced milestone index in [start, end]
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	var messageIDs []string
+	for _, url := range b.GetGatewayConfig().AllGatewayURLs {
+		messageIDs, err = GetMessageIDsByIndex(url, []byte(SWAPOUT))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, tokens.WrapRPCQueryError(err, "MessageIDsByIndex", SWAPOUT)
+	}
+	for _, messageID := range messageIDs {
+		metadata, errm := b.GetTransactionMetadata(messageID)
+		if errm != nil || metadata.ReferencedByMilestoneIndex == nil {
+			continue
+		}
+		milestone := uint64(*metadata.ReferencedByMilestoneIndex)
+		if milestone >= start && milestone <= end {
+			txHashes = append(txHashes, messageID)
+		}
+	}
+	return txHashes, nil
+}
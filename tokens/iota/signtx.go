@@ -22,7 +22,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 		return nil, "", tokens.ErrWrongRawTx
 	} else {
 		mpcParams := params.GetMPCConfig(b.UseFastMPC)
-		if mpcParams.SignWithPrivateKey {
+		if mpcParams.IsSignWithPrivateKey() {
 			priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 			return b.SignTransactionWithPrivateKey(rawTx, priKey)
 		}
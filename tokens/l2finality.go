@@ -0,0 +1,36 @@
+package tokens
+
+import (
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+// GetL2FinalityOfValue get the highest l2 finality level whose value tier is reached by the swap,
+// value tier is the percent of big value threshold (after 'BigValueDiscount').
+// returns empty if no tier is reached.
+func GetL2FinalityOfValue(swapInfo *SwapTxInfo, fromDecimals uint8) (level string) {
+	if swapInfo.ERC20SwapInfo == nil || swapInfo.Value == nil {
+		return ""
+	}
+	fromChainID := swapInfo.FromChainID.String()
+	tiers := params.GetL2FinalityTiers(fromChainID)
+	if len(tiers) == 0 {
+		return ""
+	}
+	bigValue := GetBigValueThreshold(swapInfo.GetTokenID(), fromChainID, swapInfo.ToChainID.String(), fromDecimals)
+	if bigValue == nil || bigValue.Sign() <= 0 {
+		return ""
+	}
+	for tierLevel, percent := range tiers {
+		if params.GetL2FinalityRank(tierLevel) <= params.GetL2FinalityRank(level) {
+			continue
+		}
+		threshold := new(big.Int).Mul(bigValue, new(big.Int).SetUint64(percent))
+		threshold.Div(threshold, big.NewInt(100))
+		if swapInfo.Value.Cmp(threshold) >= 0 {
+			level = tierLevel
+		}
+	}
+	return level
+}
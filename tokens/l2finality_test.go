@@ -0,0 +1,104 @@
+package tokens
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+const (
+	testL2FinalityChainID = "10"
+	testL2FinalityTokenID = "USDC"
+)
+
+func setL2FinalityTestConfig(t *testing.T, discount uint64) {
+	t.Helper()
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		LocalChainConfig: map[string]*params.LocalChainConfig{
+			testL2FinalityChainID: {
+				BigValueDiscount: discount,
+				L2Finality:       params.L2FinalityUnsafe,
+				L2FinalityTiers: map[string]uint64{
+					params.L2FinalitySafe:      10,
+					params.L2FinalityFinalized: 100,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("set extra config failed: %v", err)
+	}
+}
+
+func setL2FinalityTestSwapConfig(bigValueThreshold *big.Int) {
+	toChainMap := new(sync.Map)
+	toChainMap.Store("56", &SwapConfig{BigValueThreshold: bigValueThreshold})
+	fromChainMap := new(sync.Map)
+	fromChainMap.Store(testL2FinalityChainID, toChainMap)
+	swapCfgs := new(sync.Map)
+	swapCfgs.Store(testL2FinalityTokenID, fromChainMap)
+	SetSwapConfigs(swapCfgs)
+}
+
+func newL2FinalityTestSwap(value int64) *SwapTxInfo {
+	return &SwapTxInfo{
+		SwapInfo:    SwapInfo{ERC20SwapInfo: &ERC20SwapInfo{TokenID: testL2FinalityTokenID}},
+		Value:       big.NewInt(value),
+		FromChainID: big.NewInt(10),
+		ToChainID:   big.NewInt(56),
+	}
+}
+
+func TestGetL2FinalityOfValue(t *testing.T) {
+	oldSwapCfgs := swapConfigMap
+	defer SetSwapConfigs(oldSwapCfgs)
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+
+	// big value threshold is 1000 tokens (in 18 decimals), the token has 6 decimals
+	threshold := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	setL2FinalityTestSwapConfig(threshold)
+
+	tests := []struct {
+		discount uint64
+		value    int64
+		level    string
+	}{
+		{0, 99e6, ""},
+		{0, 100e6, params.L2FinalitySafe},
+		{0, 999e6, params.L2FinalitySafe},
+		{0, 1000e6, params.L2FinalityFinalized},
+		{0, 5000e6, params.L2FinalityFinalized},
+		// tiers are the percent of the discounted threshold (500 tokens)
+		{50, 49e6, ""},
+		{50, 50e6, params.L2FinalitySafe},
+		{50, 500e6, params.L2FinalityFinalized},
+	}
+	for i, tt := range tests {
+		setL2FinalityTestConfig(t, tt.discount)
+		if level := GetL2FinalityOfValue(newL2FinalityTestSwap(tt.value), 6); level != tt.level {
+			t.Errorf("case %d: GetL2FinalityOfValue = %q, want %q", i, level, tt.level)
+		}
+	}
+
+	// the discount does not modify the cached swap config
+	want := new(big.Int).Mul(big.NewInt(500), big.NewInt(1e18))
+	for i := 0; i < 2; i++ {
+		have := GetBigValueThreshold(testL2FinalityTokenID, testL2FinalityChainID, "56", 18)
+		if have.Cmp(want) != 0 {
+			t.Errorf("round %d: GetBigValueThreshold = %v, want %v", i, have, want)
+		}
+	}
+
+	// no tier is reached without swap config or threshold
+	setL2FinalityTestConfig(t, 0)
+	setL2FinalityTestSwapConfig(nil)
+	if level := GetL2FinalityOfValue(newL2FinalityTestSwap(5000e6), 6); level != "" {
+		t.Errorf("GetL2FinalityOfValue without threshold = %q, want empty", level)
+	}
+	SetSwapConfigs(new(sync.Map))
+	if level := GetL2FinalityOfValue(newL2FinalityTestSwap(5000e6), 6); level != "" {
+		t.Errorf("GetL2FinalityOfValue without swap config = %q, want empty", level)
+	}
+}
@@ -27,6 +27,8 @@ const (
 // Bridge near bridge
 type Bridge struct {
 	*base.NonceSetterBase
+
+	storageDeposit storageDepositConfig
 }
 
 // SupportsChainID supports chainID
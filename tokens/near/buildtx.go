@@ -76,15 +76,25 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 		return nil, err
 	}
 	args.SwapValue = amount // SwapValue
+	isNewTx := args.Extra == nil || args.Extra.BlockHash == nil
 	if extra, err := b.initExtra(args); err != nil {
 		return nil, err
 	} else {
+		if tokenCfg.ContractVersion != 999 {
+			if amount, err = b.setStorageDeposit(args, multichainToken, receiver, amount, isNewTx); err != nil {
+				return nil, err
+			}
+			args.SwapValue = amount
+		}
 		if blockHashBytes, err := base58.Decode(*extra.BlockHash); err != nil {
 			return nil, err
 		} else {
 			if to, actions, err := b.CreateFunctionCall(args.SwapID, multichainToken, receiver, amount.String(), args.FromChainID.String(), args.LogIndex, *extra.Gas, tokenCfg.ContractVersion); err != nil {
 				return nil, err
 			} else {
+				if extra.StorageDeposit != nil {
+					actions = append([]Action{buildStorageDepositAction(receiver, extra.StorageDeposit)}, actions...)
+				}
 				rawTx = CreateTransaction(args.From, nearPubKey, to, *extra.Sequence, blockHashBytes, actions)
 			}
 			return rawTx, nil
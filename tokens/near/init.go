@@ -13,11 +13,16 @@ var (
 	GetFtMetadata = "ft_metadata"
 	GetFtBalance  = "ft_balance_of"
 	EmptyArgs     = "e30="
+
+	GetStorageBalance = "storage_balance_of"
+	GetStorageBounds  = "storage_balance_bounds"
+	StorageDeposit    = "storage_deposit"
 )
 
 // InitAfterConfig init variables (ie. extra members) after loading config
 func (b *Bridge) InitAfterConfig() {
 	b.CrossChainBridgeBase.InitAfterConfig()
+	b.initStorageDepositConfig()
 }
 
 // InitRouterInfo init router info
@@ -2,6 +2,7 @@ package near
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,6 +20,7 @@ var (
 
 const (
 	blockMethod             = "block"
+	chunkMethod             = "chunk"
 	txMethod                = "tx"
 	queryMethod             = "query"
 	broadcastTxCommitMethod = "broadcast_tx_commit"
@@ -57,6 +59,36 @@ func GetLatestBlockHash(url string) (string, error) {
 	return result.Header.Hash, nil
 }
 
+// GetBlockByNumber get block detail by height
+func GetBlockByNumber(url string, height uint64) (*BlockDetail, error) {
+	request := &client.Request{}
+	request.Method = blockMethod
+	request.Params = map[string]uint64{"block_id": height}
+	request.ID = int(time.Now().UnixNano())
+	request.Timeout = rpcTimeout
+	var result BlockDetail
+	err := client.RPCPostRequest(url, request, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetChunkByHash get chunk detail by chunk hash
+func GetChunkByHash(url, chunkHash string) (*ChunkDetail, error) {
+	request := &client.Request{}
+	request.Method = chunkMethod
+	request.Params = map[string]string{"chunk_id": chunkHash}
+	request.ID = int(time.Now().UnixNano())
+	request.Timeout = rpcTimeout
+	var result ChunkDetail
+	err := client.RPCPostRequest(url, request, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // GetLatestBlockNumber get latest block height
 func GetLatestBlockNumber(url string) (uint64, error) {
 	request := &client.Request{}
@@ -189,3 +221,31 @@ func CheckBalance(url, account, amount string) error {
 	}
 	return err
 }
+
+// GetStorageBalanceOf call `storage_balance_of`, returns nil if not registered
+func GetStorageBalanceOf(url, token, account string) (*StorageBalance, error) {
+	argsStr := fmt.Sprintf("{\"account_id\":\"%s\"}", account)
+	argsBase64 := base64.StdEncoding.EncodeToString([]byte(argsStr))
+	result, err := functionCall(url, token, GetStorageBalance, argsBase64)
+	if err != nil {
+		return nil, err
+	}
+	var balance *StorageBalance
+	if err = json.Unmarshal(result, &balance); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
+// GetStorageBalanceBounds call `storage_balance_bounds`
+func GetStorageBalanceBounds(url, token string) (*StorageBalanceBounds, error) {
+	result, err := functionCall(url, token, GetStorageBounds, EmptyArgs)
+	if err != nil {
+		return nil, err
+	}
+	var bounds StorageBalanceBounds
+	if err = json.Unmarshal(result, &bounds); err != nil {
+		return nil, err
+	}
+	return &bounds, nil
+}
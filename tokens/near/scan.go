@@ -0,0 +1,57 @@
+package near
+
+import (
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns txs in blocks [start, end] whose receiver is the router or a token contract
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	receivers := map[string]struct{}{
+		b.ChainConfig.RouterContract: {},
+	}
+	b.TokenConfigMap.Range(func(key, _ interface{}) bool {
+		if token, ok := key.(string); ok {
+			receivers[token] = struct{}{}
+		}
+		return true
+	})
+	for height := start; height <= end; height++ {
+		block, errt := b.getBlockByNumber(height)
+		if errt != nil {
+			return txHashes, errt
+		}
+		for _, chunk := range block.Chunks {
+			detail, errc := b.getChunkByHash(chunk.ChunkHash)
+			if errc != nil {
+				return txHashes, errc
+			}
+			for _, tx := range detail.Transactions {
+				if _, exist := receivers[tx.ReceiverID]; exist {
+					txHashes = append(txHashes, tx.Hash)
+				}
+			}
+		}
+	}
+	return txHashes, nil
+}
+
+func (b *Bridge) getBlockByNumber(height uint64) (result *BlockDetail, err error) {
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		result, err = GetBlockByNumber(url, height)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, tokens.WrapRPCQueryError(err, blockMethod, height)
+}
+
+func (b *Bridge) getChunkByHash(chunkHash string) (result *ChunkDetail, err error) {
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		result, err = GetChunkByHash(url, chunkHash)
+		if err == nil {
+			return result, nil
+		}
+	}
+	return nil, tokens.WrapRPCQueryError(err, chunkMethod, chunkHash)
+}
@@ -0,0 +1,162 @@
+package near
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	storageDepositGas uint64 = 10_000_000_000_000
+)
+
+var (
+	// 0.0125 NEAR
+	defaultMaxStorageDeposit, _ = new(big.Int).SetString("12500000000000000000000", 10)
+
+	errStorageDepositDisabled = errors.New("storage deposit is not enabled")
+	errStorageDepositTooLarge = errors.New("storage deposit is too large")
+	errReceiverRegistered     = errors.New("receiver has registered storage")
+	errSwapValueTooSmall      = errors.New("swap value is too small to pay storage deposit fee")
+)
+
+// storageDepositConfig auto storage registration of nep141 receivers.
+// configed in `Extra.Customs.<chainID>`:
+// `AutoStorageDeposit` enable or not,
+// `MaxStorageDeposit` cap of deposit (in yoctoNEAR) paid by the mpc,
+// `StorageDepositFee:<tokenID>` deducted from the swapped amount (in token unit).
+type storageDepositConfig struct {
+	enabled    bool
+	maxDeposit *big.Int
+}
+
+func (b *Bridge) initStorageDepositConfig() {
+	chainID := b.ChainConfig.ChainID
+	cfg := &b.storageDeposit
+	cfg.enabled, _ = strconv.ParseBool(params.GetCustom(chainID, "AutoStorageDeposit"))
+	cfg.maxDeposit = defaultMaxStorageDeposit
+	if maxDepositStr := params.GetCustom(chainID, "MaxStorageDeposit"); maxDepositStr != "" {
+		maxDeposit, err := common.GetBigIntFromStr(maxDepositStr)
+		if err != nil {
+			log.Fatal("near MaxStorageDeposit config failed", "chainID", chainID, "value", maxDepositStr, "err", err)
+		}
+		cfg.maxDeposit = maxDeposit
+	}
+	if cfg.enabled {
+		log.Info("near auto storage deposit enabled", "chainID", chainID, "maxDeposit", cfg.maxDeposit)
+	}
+}
+
+// getStorageDepositFee get the fee deducted from the swapped amount when paying storage deposit
+func (b *Bridge) getStorageDepositFee(tokenID string) (*big.Int, error) {
+	feeStr := params.GetCustom(b.ChainConfig.ChainID, "StorageDepositFee:"+tokenID)
+	if feeStr == "" {
+		return big.NewInt(0), nil
+	}
+	return common.GetBigIntFromStr(feeStr)
+}
+
+// IsStorageRegistered check whether the account has registered storage in token
+func (b *Bridge) IsStorageRegistered(token, account string) (registered bool, err error) {
+	var balance *StorageBalance
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		balance, err = GetStorageBalanceOf(url, token, account)
+		if err == nil {
+			return balance != nil, nil
+		}
+	}
+	return false, tokens.WrapRPCQueryError(err, GetStorageBalance, token, account)
+}
+
+// GetStorageDepositMin get the min storage deposit of token
+func (b *Bridge) GetStorageDepositMin(token string) (*big.Int, error) {
+	var err error
+	var bounds *StorageBalanceBounds
+	for _, url := range b.GatewayConfig.AllGatewayURLs {
+		bounds, err = GetStorageBalanceBounds(url, token)
+		if err == nil {
+			return common.GetBigIntFromStr(bounds.Min)
+		}
+	}
+	return nil, tokens.WrapRPCQueryError(err, GetStorageBounds, token)
+}
+
+// setStorageDeposit decide storage deposit for the receiver when building new tx,
+// or check the storage deposit in args when rebuilding tx (eg. oracle verifying).
+// the fee is deducted from the swapped amount if storage deposit is paid.
+func (b *Bridge) setStorageDeposit(args *tokens.BuildTxArgs, token, receiver string, amount *big.Int, isNewTx bool) (*big.Int, error) {
+	extra := args.Extra
+	cfg := &b.storageDeposit
+	if isNewTx {
+		extra.StorageDeposit = nil
+		if !cfg.enabled {
+			return amount, nil
+		}
+		registered, err := b.IsStorageRegistered(token, receiver)
+		if err != nil || registered {
+			return amount, err
+		}
+		deposit, err := b.GetStorageDepositMin(token)
+		if err != nil {
+			return amount, err
+		}
+		if deposit.Cmp(cfg.maxDeposit) > 0 {
+			return amount, fmt.Errorf("%w: %v > %v", errStorageDepositTooLarge, deposit, cfg.maxDeposit)
+		}
+		if err = b.CheckBalance(args.From, deposit.String()); err != nil {
+			return amount, err
+		}
+		extra.StorageDeposit = deposit
+	} else {
+		if extra.StorageDeposit == nil {
+			return amount, nil
+		}
+		if !cfg.enabled {
+			return amount, errStorageDepositDisabled
+		}
+		if extra.StorageDeposit.Sign() <= 0 || extra.StorageDeposit.Cmp(cfg.maxDeposit) > 0 {
+			return amount, fmt.Errorf("%w: %v > %v", errStorageDepositTooLarge, extra.StorageDeposit, cfg.maxDeposit)
+		}
+		registered, err := b.IsStorageRegistered(token, receiver)
+		if err != nil {
+			return amount, err
+		}
+		if registered {
+			return amount, errReceiverRegistered
+		}
+	}
+
+	fee, err := b.getStorageDepositFee(args.GetTokenID())
+	if err != nil {
+		return amount, err
+	}
+	if amount.Cmp(fee) <= 0 {
+		return amount, fmt.Errorf("%w: value %v fee %v", errSwapValueTooSmall, amount, fee)
+	}
+	log.Info("near pay storage deposit for receiver", "swapID", args.SwapID, "token", token, "receiver", receiver, "deposit", extra.StorageDeposit, "fee", fee)
+	return new(big.Int).Sub(amount, fee), nil
+}
+
+func buildStorageDepositAction(receiver string, deposit *big.Int) Action {
+	callArgs := &FtStorageDeposit{
+		AccountId:        receiver,
+		RegistrationOnly: true,
+	}
+	argsBytes, _ := json.Marshal(callArgs)
+	return Action{
+		Enum: 2,
+		FunctionCall: FunctionCall{
+			MethodName: StorageDeposit,
+			Args:       argsBytes,
+			Gas:        storageDepositGas,
+			Deposit:    *deposit,
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package near
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	testStorageChainID  = "1001313161554"
+	testStorageToken    = "usdc.near"
+	testStorageReceiver = "receiver.near"
+	testStorageFee      = 1000000
+)
+
+// 0.00125 NEAR
+var testStorageMinDeposit, _ = new(big.Int).SetString("1250000000000000000000", 10)
+
+type storageTestNode struct {
+	registered bool
+	minDeposit string
+	balance    string // of the mpc
+}
+
+func (n *storageTestNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Params map[string]string `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var result interface{}
+	switch req.Params["request_type"] {
+	case "view_account":
+		result = map[string]string{"amount": n.balance}
+	case "call_function":
+		var data string
+		switch req.Params["method_name"] {
+		case GetStorageBalance:
+			data = "null"
+			if n.registered {
+				data = `{"total":"1250000000000000000000","available":"0"}`
+			}
+		case GetStorageBounds:
+			data = fmt.Sprintf(`{"min":%q,"max":%q}`, n.minDeposit, n.minDeposit)
+		}
+		result = &FunctionCallResult{Result: []byte(data)}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func newStorageTestBridge(t *testing.T, node *storageTestNode, customs map[string]string) *Bridge {
+	t.Helper()
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		Customs: map[string]map[string]string{testStorageChainID: customs},
+	})
+	if err != nil {
+		t.Fatalf("set extra config failed: %v", err)
+	}
+	srv := httptest.NewServer(node)
+	t.Cleanup(srv.Close)
+
+	b := NewCrossChainBridge()
+	b.SetChainConfig(&tokens.ChainConfig{ChainID: testStorageChainID})
+	b.GatewayConfig = &tokens.GatewayConfig{AllGatewayURLs: []string{srv.URL}}
+	b.initStorageDepositConfig()
+	return b
+}
+
+func newStorageTestArgs(deposit *big.Int) *tokens.BuildTxArgs {
+	args := &tokens.BuildTxArgs{
+		From:  "mpc.near",
+		Extra: &tokens.AllExtras{StorageDeposit: deposit},
+	}
+	args.ERC20SwapInfo = &tokens.ERC20SwapInfo{TokenID: "USDC"}
+	return args
+}
+
+func TestSetStorageDeposit(t *testing.T) {
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+
+	enabled := map[string]string{
+		"AutoStorageDeposit":     "true",
+		"StorageDepositFee:USDC": fmt.Sprint(testStorageFee),
+	}
+	disabled := map[string]string{
+		"StorageDepositFee:USDC": fmt.Sprint(testStorageFee),
+	}
+	smallMaxDeposit := map[string]string{
+		"AutoStorageDeposit":     "true",
+		"MaxStorageDeposit":      "1000000000000000000000",
+		"StorageDepositFee:USDC": fmt.Sprint(testStorageFee),
+	}
+	unregistered := &storageTestNode{minDeposit: testStorageMinDeposit.String(), balance: "1000000000000000000000000"}
+	registered := &storageTestNode{registered: true, minDeposit: testStorageMinDeposit.String(), balance: "1000000000000000000000000"}
+	poor := &storageTestNode{minDeposit: testStorageMinDeposit.String(), balance: "1000"}
+
+	tests := []struct {
+		name    string
+		customs map[string]string
+		node    *storageTestNode
+		isNewTx bool
+		deposit *big.Int // in args
+		amount  int64
+
+		wantAmount  int64
+		wantDeposit *big.Int
+		wantErr     error
+	}{
+		// build new tx
+		{"disabled", disabled, unregistered, true, testStorageMinDeposit, 5000000, 5000000, nil, nil},
+		{"registered", enabled, registered, true, nil, 5000000, 5000000, nil, nil},
+		{"pay deposit", enabled, unregistered, true, nil, 5000000, 4000000, testStorageMinDeposit, nil},
+		{"deposit too large", smallMaxDeposit, unregistered, true, nil, 5000000, 5000000, nil, errStorageDepositTooLarge},
+		{"mpc balance not enough", enabled, poor, true, nil, 5000000, 5000000, nil, tokens.ErrTokenBalanceNotEnough},
+		{"value too small", enabled, unregistered, true, nil, testStorageFee, testStorageFee, testStorageMinDeposit, errSwapValueTooSmall},
+		// rebuild tx (eg. oracle verifying)
+		{"verify without deposit", enabled, unregistered, false, nil, 5000000, 5000000, nil, nil},
+		{"verify deposit", enabled, unregistered, false, testStorageMinDeposit, 5000000, 4000000, testStorageMinDeposit, nil},
+		{"verify disabled", disabled, unregistered, false, testStorageMinDeposit, 5000000, 5000000, testStorageMinDeposit, errStorageDepositDisabled},
+		{"verify deposit too large", smallMaxDeposit, unregistered, false, testStorageMinDeposit, 5000000, 5000000, testStorageMinDeposit, errStorageDepositTooLarge},
+		{"verify zero deposit", enabled, unregistered, false, big.NewInt(0), 5000000, 5000000, big.NewInt(0), errStorageDepositTooLarge},
+		{"verify registered", enabled, registered, false, testStorageMinDeposit, 5000000, 5000000, testStorageMinDeposit, errReceiverRegistered},
+	}
+	for _, tt := range tests {
+		b := newStorageTestBridge(t, tt.node, tt.customs)
+		args := newStorageTestArgs(tt.deposit)
+		amount, err := b.setStorageDeposit(args, testStorageToken, testStorageReceiver, big.NewInt(tt.amount), tt.isNewTx)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v: setStorageDeposit error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if amount.Int64() != tt.wantAmount {
+			t.Errorf("%v: setStorageDeposit amount = %v, want %v", tt.name, amount, tt.wantAmount)
+		}
+		deposit := args.Extra.StorageDeposit
+		if (deposit == nil) != (tt.wantDeposit == nil) || (deposit != nil && deposit.Cmp(tt.wantDeposit) != 0) {
+			t.Errorf("%v: storage deposit = %v, want %v", tt.name, deposit, tt.wantDeposit)
+		}
+	}
+}
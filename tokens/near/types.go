@@ -14,7 +14,16 @@ type TransactionResult struct {
 }
 
 type BlockDetail struct {
-	Header BlockHeader `json:"header"`
+	Header BlockHeader   `json:"header"`
+	Chunks []ChunkHeader `json:"chunks"`
+}
+
+type ChunkHeader struct {
+	ChunkHash string `json:"chunk_hash"`
+}
+
+type ChunkDetail struct {
+	Transactions []Transaction `json:"transactions"`
 }
 
 type BlockHeader struct {
@@ -179,6 +188,21 @@ type FtSwapIn struct {
 	FromChainId string `json:"from_chain_id"`
 }
 
+type FtStorageDeposit struct {
+	AccountId        string `json:"account_id"`
+	RegistrationOnly bool   `json:"registration_only"`
+}
+
+type StorageBalance struct {
+	Total     string `json:"total"`
+	Available string `json:"available"`
+}
+
+type StorageBalanceBounds struct {
+	Min string  `json:"min"`
+	Max *string `json:"max"`
+}
+
 type FunctionCallResult struct {
 	BlockHash   string   `json:"block_hash"`
 	BlockHeight uint64   `json:"block_height"`
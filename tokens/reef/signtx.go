@@ -15,7 +15,7 @@ import (
 	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
 )
 
-func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*ReefTransaction, error) {
+func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, args *tokens.BuildTxArgs) (*ReefTransaction, error) {
 	tx, ok := rawTx.(*ReefTransaction)
 	if !ok {
 		return nil, errors.New("[sign] wrong raw tx param")
@@ -23,7 +23,7 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 	if tx == nil || tx.To == nil {
 		return nil, errors.New("[sign] tx receiver is empty")
 	}
-	checkReceiver, err := router.GetTokenRouterContract(tokenID, b.ChainConfig.ChainID)
+	checkReceiver, err := router.GetTokenRouterContract(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +35,13 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 
 // MPCSignTransaction mpc sign raw tx
 func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs) (signTx interface{}, txHash string, err error) {
-	tx, err := b.verifyTransactionReceiver(rawTx, args.GetTokenID())
+	tx, err := b.verifyTransactionReceiver(rawTx, args)
 	if err != nil {
 		return nil, "", err
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
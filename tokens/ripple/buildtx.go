@@ -30,7 +30,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, fmt.Errorf("forbid empty sender")
 	}
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -259,11 +259,14 @@ func (b *Bridge) checkNonNativeBalance(currency, issuer, account, receiver strin
 	if !params.IsSwapServer {
 		return nil
 	}
-	_, err := b.GetAccountLine(currency, issuer, receiver)
-	if err != nil {
+	recvl, err := b.GetAccountLine(currency, issuer, receiver)
+	if err != nil && !errors.Is(err, tokens.ErrNotFound) {
 		log.Error("get receiver account line failed", "currency", currency, "issuer", issuer, "receiver", receiver, "err", err)
 		return fmt.Errorf("%w %v", tokens.ErrBuildTxErrorAndDelay, "get receiver account line failed")
 	}
+	if recvl == nil || recvl.Limit.IsZero() {
+		return fmt.Errorf("%w: receiver %v, asset %v/%v", tokens.ErrMissTrustline, receiver, currency, issuer)
+	}
 
 	if issuer == account {
 		return nil
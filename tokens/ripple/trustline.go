@@ -0,0 +1,44 @@
+package ripple
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/ripple/rubblelabs/ripple/data"
+)
+
+// ensure Bridge impl tokens.ITrustlineChecker
+var _ tokens.ITrustlineChecker = &Bridge{}
+
+// CheckTrustline impl tokens.ITrustlineChecker
+func (b *Bridge) CheckTrustline(tokenAddr, receiver string) (*tokens.TrustlineInfo, error) {
+	assetI, exist := assetMap.Load(tokenAddr)
+	if !exist {
+		return nil, fmt.Errorf("non exist asset %v", tokenAddr)
+	}
+	asset := assetI.(*data.Asset)
+	if asset.IsNative() {
+		return nil, nil
+	}
+	account, _, err := GetAddressAndTag(receiver)
+	if err != nil {
+		return nil, err
+	}
+	info := &tokens.TrustlineInfo{
+		Receiver: account,
+		Token:    tokenAddr,
+		Currency: asset.Currency,
+		Issuer:   asset.Issuer,
+	}
+	accl, err := b.GetAccountLine(asset.Currency, asset.Issuer, account)
+	if err != nil {
+		if errors.Is(err, tokens.ErrNotFound) {
+			return info, nil
+		}
+		return nil, err
+	}
+	// a line with zero limit is not trusted by the receiver
+	info.Exist = !accl.Limit.IsZero()
+	return info, nil
+}
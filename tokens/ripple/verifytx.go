@@ -210,6 +210,10 @@ func parseSwapMemos(swapInfo *tokens.SwapTxInfo, memos data.Memos) bool {
 		if dstBridge.IsValidAddress(bindStr) {
 			swapInfo.Bind = bindStr          // Bind
 			swapInfo.ToChainID = biToChainID // ToChainID
+			if len(parts) > 2 {
+				// optional gas drop (native amount on dest chain)
+				_ = tokens.SetGasDropRequest(swapInfo.ERC20SwapInfo, parts[2])
+			}
 			return true
 		}
 	}
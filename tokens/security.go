@@ -0,0 +1,47 @@
+package tokens
+
+import (
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+)
+
+const securityAlertTimeout = 10 // seconds
+
+// security event kinds
+const (
+	SecurityEventGatewayDisagree = "GatewayDisagree"
+)
+
+// SecurityEvent security event
+type SecurityEvent struct {
+	Kind      string            `json:"kind"`
+	ChainID   string            `json:"chainID"`
+	TxID      string            `json:"txid"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// ReportSecurityEvent log security event and post it to the webhook if configed
+func ReportSecurityEvent(event *SecurityEvent) {
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().Unix()
+	}
+	log.Error("[security] "+event.Message, "kind", event.Kind, "chainID", event.ChainID, "txid", event.TxID, "details", event.Details)
+
+	url := params.GetSecurityAlertWebhook()
+	if url == "" {
+		return
+	}
+	go func() {
+		resp, err := client.HTTPPost(url, event, nil, nil, securityAlertTimeout)
+		if err != nil {
+			log.Warn("[security] post security event failed", "kind", event.Kind, "txid", event.TxID, "err", err)
+			return
+		}
+		_ = resp.Body.Close()
+	}()
+}
@@ -27,6 +27,8 @@ var (
 type Bridge struct {
 	*tokens.CrossChainBridgeBase
 	*base.ReSwapableBridgeBase
+
+	computeBudget computeBudgetConfig
 }
 
 // NewCrossChainBridge new bridge
@@ -72,6 +74,7 @@ func (b *Bridge) InitAfterConfig() {
 			b.ReSwapableBridgeBase.SetReswapMaxValueRate(reswapMaxAmountRate)
 		}
 	}
+	b.initComputeBudgetConfig()
 }
 
 // SetGatewayConfig set gateway config
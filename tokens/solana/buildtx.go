@@ -90,7 +90,7 @@ func (b *Bridge) BuildSwapinMintTransaction(args *tokens.BuildTxArgs, tokenCfg *
 	if err != nil {
 		return nil, err
 	}
-	routerInfo, err := router.GetTokenRouterInfo(tokenCfg.TokenID, b.ChainConfig.ChainID)
+	routerInfo, err := router.GetTokenRouterInfo(tokens.ERC20SwapType, tokenCfg.TokenID, b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -118,12 +118,12 @@ func (b *Bridge) BuildSwapinMintTransaction(args *tokens.BuildTxArgs, tokenCfg *
 	)
 	log.Info("BuildSwapinMintTransaction", "mpc", mpc.String(), "routerAccount", routerAccount.String(), "receiver", receiver.String(), "tokenMint", tokenMint.String())
 	instruction.RouterProgramID = routerContractPubkey
-	instructions := []types.TransactionInstruction{instruction}
 
-	err = b.setExtraArgs(args)
+	err = b.setExtraArgs(args, instruction)
 	if err != nil {
 		return nil, err
 	}
+	instructions := withComputeBudget(args, instruction)
 	recentBlockHash, err := types.PublicKeyFromBase58(*args.Extra.BlockHash)
 	if err != nil {
 		return nil, err
@@ -137,7 +137,7 @@ func (b *Bridge) BuildSwapinTransferTransaction(args *tokens.BuildTxArgs, tokenC
 	if err != nil {
 		return nil, err
 	}
-	routerInfo, err := router.GetTokenRouterInfo(tokenCfg.TokenID, b.ChainConfig.ChainID)
+	routerInfo, err := router.GetTokenRouterInfo(tokens.ERC20SwapType, tokenCfg.TokenID, b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -170,12 +170,12 @@ func (b *Bridge) BuildSwapinTransferTransaction(args *tokens.BuildTxArgs, tokenC
 
 	log.Info("BuildSwapinTransferTransaction", "mpc", mpc.String(), "routerAccount", routerAccount.String(), "ata", ata.String(), "receiver", receiver.String(), "tokenMint", tokenMint.String())
 	instruction.RouterProgramID = routerContractPubkey
-	instructions := []types.TransactionInstruction{instruction}
 
-	err = b.setExtraArgs(args)
+	err = b.setExtraArgs(args, instruction)
 	if err != nil {
 		return nil, err
 	}
+	instructions := withComputeBudget(args, instruction)
 	recentBlockHash, err := types.PublicKeyFromBase58(*args.Extra.BlockHash)
 	if err != nil {
 		return nil, err
@@ -189,7 +189,7 @@ func (b *Bridge) BuildSwapinNativeTransaction(args *tokens.BuildTxArgs, tokenCfg
 	if err != nil {
 		return nil, err
 	}
-	routerInfo, err := router.GetTokenRouterInfo(tokenCfg.TokenID, b.ChainConfig.ChainID)
+	routerInfo, err := router.GetTokenRouterInfo(tokens.ERC20SwapType, tokenCfg.TokenID, b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -213,12 +213,12 @@ func (b *Bridge) BuildSwapinNativeTransaction(args *tokens.BuildTxArgs, tokenCfg
 	)
 	log.Info("BuildSwapinNativeTransaction", "mpc", mpc.String(), "routerAccount", routerAccount.String(), "receiver", receiver.String())
 	instruction.RouterProgramID = routerContractPubkey
-	instructions := []types.TransactionInstruction{instruction}
 
-	err = b.setExtraArgs(args)
+	err = b.setExtraArgs(args, instruction)
 	if err != nil {
 		return nil, err
 	}
+	instructions := withComputeBudget(args, instruction)
 	blockHash, err := types.PublicKeyFromBase58(*args.Extra.BlockHash)
 	if err != nil {
 		return nil, err
@@ -226,7 +226,7 @@ func (b *Bridge) BuildSwapinNativeTransaction(args *tokens.BuildTxArgs, tokenCfg
 	return types.NewTransaction(instructions, blockHash, types.TransactionPayer(mpc))
 }
 
-func (b *Bridge) setExtraArgs(args *tokens.BuildTxArgs) error {
+func (b *Bridge) setExtraArgs(args *tokens.BuildTxArgs, instruction types.TransactionInstruction) error {
 	if args.Extra == nil {
 		args.Extra = &tokens.AllExtras{}
 	}
@@ -241,7 +241,11 @@ func (b *Bridge) setExtraArgs(args *tokens.BuildTxArgs) error {
 		extra.BlockHash = &blockhash
 		b.ReSwapableBridgeBase.SetTxTimeout(args, &blockHeight)
 	}
-	log.Info("BuildSwapin", "BlockHash", extra.BlockHash, "blockHeight", extra.Sequence)
+	err := b.setComputeBudgetArgs(args, instruction.Accounts())
+	if err != nil {
+		return err
+	}
+	log.Info("BuildSwapin", "BlockHash", extra.BlockHash, "blockHeight", extra.Sequence, "computeUnitLimit", extra.Gas, "computeUnitPrice", extra.GasPrice)
 	return nil
 }
 
@@ -89,6 +89,15 @@ func (b *Bridge) GetFees() (result *types.GetFeesResult, err error) {
 	return result, err
 }
 
+// GetRecentPrioritizationFees get prioritization fees of recent slots,
+// the fees are in micro-lamports per compute unit paid by txs
+// which lock all the specified writable accounts.
+func (b *Bridge) GetRecentPrioritizationFees(accounts []string) (result []*types.PrioritizationFee, err error) {
+	callMethod := "getRecentPrioritizationFees"
+	err = RPCCall(&result, b.GatewayConfig.AllGatewayURLs, callMethod, accounts)
+	return result, err
+}
+
 // GetBlock get block
 func (b *Bridge) GetBlock(slot uint64, fullTx bool) (result *types.GetBlockResult, err error) {
 	transactionDetails := "full"
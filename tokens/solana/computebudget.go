@@ -0,0 +1,223 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tokens/solana/programs/computebudget"
+	"github.com/deltaswapio/swaprouter/v3/tokens/solana/types"
+	bin "github.com/streamingfast/binary"
+)
+
+const (
+	defaultPriorityFeePercentile      = 75
+	defaultPriorityFeeEscalatePercent = 50
+)
+
+var (
+	errComputeBudgetDisabled      = errors.New("compute budget is not enabled")
+	errComputeUnitLimitTooLarge   = errors.New("compute unit limit is too large")
+	errComputeUnitPriceTooHigh    = errors.New("compute unit price is too high")
+	errWrongComputeBudgetInstruct = errors.New("wrong compute budget instruction")
+)
+
+// computeBudgetConfig compute budget config of payout txs.
+// configed in `Extra.Customs.<chainID>`, and is enabled if `ComputeUnitLimit` is positive.
+type computeBudgetConfig struct {
+	unitLimit       uint32 // ComputeUnitLimit
+	percentile      uint64 // PriorityFeePercentile
+	minUnitPrice    uint64 // MinComputeUnitPrice (micro-lamports)
+	maxUnitPrice    uint64 // MaxComputeUnitPrice (micro-lamports)
+	escalatePercent uint64 // PriorityFeeEscalatePercent
+}
+
+func (c *computeBudgetConfig) isEnabled() bool {
+	return c.unitLimit > 0
+}
+
+func (b *Bridge) initComputeBudgetConfig() {
+	chainID := b.ChainConfig.ChainID
+	getUint64 := func(key string, defVal uint64) uint64 {
+		valStr := params.GetCustom(chainID, key)
+		if valStr == "" {
+			return defVal
+		}
+		val, err := common.GetUint64FromStr(valStr)
+		if err != nil {
+			log.Fatal("solana compute budget config failed", "chainID", chainID, "key", key, "value", valStr, "err", err)
+		}
+		return val
+	}
+
+	cfg := &b.computeBudget
+	unitLimit := getUint64("ComputeUnitLimit", 0)
+	if unitLimit > 1_400_000 {
+		log.Fatal("solana compute unit limit is too large", "chainID", chainID, "limit", unitLimit)
+	}
+	cfg.unitLimit = uint32(unitLimit)
+	cfg.percentile = getUint64("PriorityFeePercentile", defaultPriorityFeePercentile)
+	cfg.minUnitPrice = getUint64("MinComputeUnitPrice", 0)
+	cfg.maxUnitPrice = getUint64("MaxComputeUnitPrice", 0)
+	cfg.escalatePercent = getUint64("PriorityFeeEscalatePercent", defaultPriorityFeeEscalatePercent)
+	if cfg.percentile > 100 || cfg.minUnitPrice > cfg.maxUnitPrice {
+		log.Fatal("solana compute budget config is wrong", "chainID", chainID, "percentile", cfg.percentile, "minUnitPrice", cfg.minUnitPrice, "maxUnitPrice", cfg.maxUnitPrice)
+	}
+	if cfg.isEnabled() {
+		log.Info("solana compute budget enabled", "chainID", chainID, "unitLimit", cfg.unitLimit, "percentile", cfg.percentile,
+			"minUnitPrice", cfg.minUnitPrice, "maxUnitPrice", cfg.maxUnitPrice, "escalatePercent", cfg.escalatePercent)
+	}
+}
+
+// setComputeBudgetArgs set compute unit limit and price in args extra.
+// the limit and price in args are used directly if exist (eg. oracle rebuilding tx),
+// otherwise the price is calculated from recent prioritization fees
+// and escalated by the count of previous sent txs of this swap.
+func (b *Bridge) setComputeBudgetArgs(args *tokens.BuildTxArgs, accounts []*types.AccountMeta) error {
+	extra := args.Extra
+	cfg := &b.computeBudget
+	if !cfg.isEnabled() {
+		if extra.Gas != nil || extra.GasPrice != nil {
+			return errComputeBudgetDisabled
+		}
+		return nil
+	}
+	if extra.Gas == nil {
+		unitLimit := uint64(cfg.unitLimit)
+		extra.Gas = &unitLimit
+	}
+	if extra.GasPrice == nil {
+		unitPrice, err := b.getComputeUnitPrice(accounts, args.GetEscalateNum())
+		if err != nil {
+			return err
+		}
+		extra.GasPrice = new(big.Int).SetUint64(unitPrice)
+	}
+	return b.checkComputeBudget(*extra.Gas, extra.GasPrice)
+}
+
+func (b *Bridge) checkComputeBudget(unitLimit uint64, unitPrice *big.Int) error {
+	cfg := &b.computeBudget
+	if unitLimit > uint64(cfg.unitLimit) {
+		return fmt.Errorf("%w: %v > %v", errComputeUnitLimitTooLarge, unitLimit, cfg.unitLimit)
+	}
+	if unitPrice.Sign() < 0 || !unitPrice.IsUint64() || unitPrice.Uint64() > cfg.maxUnitPrice {
+		return fmt.Errorf("%w: %v > %v", errComputeUnitPriceTooHigh, unitPrice, cfg.maxUnitPrice)
+	}
+	return nil
+}
+
+// getComputeUnitPrice get the percentile of recent prioritization fees
+// of the writable accounts, escalate it on reswapping, and bound it by the config.
+func (b *Bridge) getComputeUnitPrice(accounts []*types.AccountMeta, escalateNum uint64) (uint64, error) {
+	cfg := &b.computeBudget
+	writables := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		if account.IsWritable {
+			writables = append(writables, account.PublicKey.String())
+		}
+	}
+	fees, err := b.GetRecentPrioritizationFees(writables)
+	if err != nil {
+		return 0, err
+	}
+	price := new(big.Int).SetUint64(calcFeePercentile(fees, cfg.percentile))
+	for i := uint64(0); i < escalateNum; i++ {
+		price.Mul(price, new(big.Int).SetUint64(100+cfg.escalatePercent))
+		price.Div(price, big.NewInt(100))
+		if price.Cmp(new(big.Int).SetUint64(cfg.maxUnitPrice)) >= 0 {
+			break
+		}
+	}
+	unitPrice := cfg.maxUnitPrice
+	if price.IsUint64() && price.Uint64() < unitPrice {
+		unitPrice = price.Uint64()
+	}
+	if unitPrice < cfg.minUnitPrice {
+		unitPrice = cfg.minUnitPrice
+	}
+	log.Info("get solana compute unit price", "chainID", b.ChainConfig.ChainID, "slots", len(fees), "percentile", cfg.percentile, "escalateNum", escalateNum, "unitPrice", unitPrice)
+	return unitPrice, nil
+}
+
+func calcFeePercentile(fees []*types.PrioritizationFee, percentile uint64) uint64 {
+	if len(fees) == 0 {
+		return 0
+	}
+	values := make([]uint64, len(fees))
+	for i, fee := range fees {
+		values[i] = uint64(fee.PrioritizationFee)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	index := (uint64(len(values)) * percentile) / 100
+	if index >= uint64(len(values)) {
+		index = uint64(len(values)) - 1
+	}
+	return values[index]
+}
+
+// withComputeBudget prepend compute budget instructions if exist in args extra
+func withComputeBudget(args *tokens.BuildTxArgs, instruction types.TransactionInstruction) []types.TransactionInstruction {
+	extra := args.Extra
+	if extra == nil || extra.Gas == nil || extra.GasPrice == nil {
+		return []types.TransactionInstruction{instruction}
+	}
+	return []types.TransactionInstruction{
+		computebudget.NewSetComputeUnitLimitInstruction(uint32(*extra.Gas)),
+		computebudget.NewSetComputeUnitPriceInstruction(extra.GasPrice.Uint64()),
+		instruction,
+	}
+}
+
+// verifyComputeBudgetInstructions check the leading compute budget instructions
+// are the allowed ones and are bounded by the config,
+// return the index of the first non compute budget instruction.
+func (b *Bridge) verifyComputeBudgetInstructions(tx *types.Transaction) (int, error) {
+	var unitLimit, unitPrice *uint64
+	for i, instruction := range tx.Message.Instructions {
+		programID, err := tx.Message.ResolveProgramIDIndex(instruction.ProgramIDIndex)
+		if err != nil {
+			return 0, err
+		}
+		if !programID.Equals(computebudget.ComputeBudgetProgramID) {
+			if unitLimit != nil || unitPrice != nil {
+				if !b.computeBudget.isEnabled() {
+					return 0, errComputeBudgetDisabled
+				}
+				if unitLimit == nil || unitPrice == nil {
+					return 0, fmt.Errorf("%w: require both limit and price", errWrongComputeBudgetInstruct)
+				}
+				if err = b.checkComputeBudget(*unitLimit, new(big.Int).SetUint64(*unitPrice)); err != nil {
+					return 0, err
+				}
+			}
+			return i, nil
+		}
+		var inst computebudget.Instruction
+		if err = inst.UnmarshalBinary(bin.NewDecoder(instruction.Data)); err != nil {
+			return 0, fmt.Errorf("unable to decode compute budget instruction: %w", err)
+		}
+		switch impl := inst.Impl.(type) {
+		case *computebudget.SetComputeUnitLimit:
+			if unitLimit != nil {
+				return 0, fmt.Errorf("%w: duplicate limit", errWrongComputeBudgetInstruct)
+			}
+			units := uint64(impl.Units)
+			unitLimit = &units
+		case *computebudget.SetComputeUnitPrice:
+			if unitPrice != nil {
+				return 0, fmt.Errorf("%w: duplicate price", errWrongComputeBudgetInstruct)
+			}
+			microLamports := impl.MicroLamports
+			unitPrice = &microLamports
+		default:
+			return 0, fmt.Errorf("%w: type %v", errWrongComputeBudgetInstruct, inst.TypeID)
+		}
+	}
+	return 0, fmt.Errorf("%w: no other instruction", errWrongComputeBudgetInstruct)
+}
@@ -0,0 +1,92 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	routerprog "github.com/deltaswapio/swaprouter/v3/tokens/solana/programs/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens/solana/types"
+	bin "github.com/streamingfast/binary"
+)
+
+var (
+	errIncompleteOfflineArgs = errors.New("incomplete build args of offline raw tx")
+	errOfflineRawTxMismatch  = errors.New("offline raw tx mismatch with build args")
+)
+
+// IsOfflineSignSupported impl tokens.IOfflineSigner
+func (b *Bridge) IsOfflineSignSupported() bool {
+	return true
+}
+
+// InitOfflineSigner impl tokens.IOfflineSigner
+// the compute budget config is required to verify the compute budget instructions
+func (b *Bridge) InitOfflineSigner() error {
+	b.initComputeBudgetConfig()
+	return nil
+}
+
+// EncodeOfflineRawTx impl tokens.IOfflineSigner
+func (b *Bridge) EncodeOfflineRawTx(rawTx interface{}) ([]byte, error) {
+	tx, ok := rawTx.(*types.Transaction)
+	if !ok {
+		return nil, tokens.ErrWrongRawTx
+	}
+	return tx.SerializeAll()
+}
+
+// DecodeOfflineRawTx impl tokens.IOfflineSigner
+func (b *Bridge) DecodeOfflineRawTx(data []byte) (rawTx interface{}, err error) {
+	return types.DecodeTransaction(string(data), "")
+}
+
+// VerifyOfflineRawTx impl tokens.IOfflineSigner
+// the swapin instruction, payer and recent block hash are checked by the build args
+// (the token accounts are derived from the router config which is not available offline)
+func (b *Bridge) VerifyOfflineRawTx(rawTx interface{}, args *tokens.BuildTxArgs) error {
+	tx, ok := rawTx.(*types.Transaction)
+	if !ok {
+		return tokens.ErrWrongRawTx
+	}
+	if args.ToChainID == nil || args.ToChainID.String() != b.ChainConfig.ChainID {
+		return tokens.ErrToChainIDMismatch
+	}
+	if args.FromChainID == nil || args.SwapValue == nil || args.Extra == nil || args.Extra.BlockHash == nil {
+		return errIncompleteOfflineArgs
+	}
+	message := tx.Message
+	if len(message.AccountKeys) == 0 || message.AccountKeys[0].String() != args.From {
+		return tokens.ErrSenderMismatch
+	}
+	if message.RecentBlockhash.String() != *args.Extra.BlockHash {
+		return fmt.Errorf("%w: recent block hash", errOfflineRawTxMismatch)
+	}
+	receiver, err := types.PublicKeyFromBase58(args.Bind)
+	if err != nil || !tx.TouchAccount(receiver) {
+		return tokens.ErrTxWithWrongReceiver
+	}
+
+	index, err := b.verifyComputeBudgetInstructions(tx)
+	if err != nil {
+		return err
+	}
+	if index != len(message.Instructions)-1 {
+		return fmt.Errorf("%w: instructions count", errOfflineRawTxMismatch)
+	}
+	var inst routerprog.Instruction
+	if err = inst.UnmarshalBinary(bin.NewDecoder(message.Instructions[index].Data)); err != nil {
+		return fmt.Errorf("unable to decode router instruction: %w", err)
+	}
+	swapin, ok := inst.Impl.(routerprog.ISwapinParams)
+	if !ok {
+		return fmt.Errorf("%w: not swapin instruction", errOfflineRawTxMismatch)
+	}
+	swapinParams := swapin.GetSwapinParams()
+	if swapinParams.Tx.String() != args.SwapID ||
+		!args.SwapValue.IsUint64() || swapinParams.Amount != args.SwapValue.Uint64() ||
+		swapinParams.FromChainID != args.FromChainID.Uint64() {
+		return fmt.Errorf("%w: swapin params %v", errOfflineRawTxMismatch, swapinParams.String())
+	}
+	return nil
+}
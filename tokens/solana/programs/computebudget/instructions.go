@@ -0,0 +1,142 @@
+package computebudget
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/tokens/solana/types"
+	bin "github.com/streamingfast/binary"
+)
+
+// programID constants
+var (
+	ComputeBudgetProgramID = types.MustPublicKeyFromBase58("ComputeBudget111111111111111111111111111111")
+)
+
+// typeID constants
+const (
+	RequestUnitsDeprecatedTypeID         uint32 = iota
+	RequestHeapFrameTypeID                      // 1
+	SetComputeUnitLimitTypeID                   // 2
+	SetComputeUnitPriceTypeID                   // 3
+	SetLoadedAccountsDataSizeLimitTypeID        // 4
+)
+
+func init() {
+	types.RegisterInstructionDecoder(ComputeBudgetProgramID, registryDecodeInstruction)
+}
+
+func registryDecodeInstruction(accounts []*types.AccountMeta, data []byte) (interface{}, error) {
+	inst, err := DecodeInstruction(accounts, data)
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+// DecodeInstruction decode instruction
+func DecodeInstruction(accounts []*types.AccountMeta, data []byte) (*Instruction, error) {
+	var inst Instruction
+	if err := bin.NewDecoder(data).Decode(&inst); err != nil {
+		return nil, fmt.Errorf("unable to decode instruction: %w", err)
+	}
+	return &inst, nil
+}
+
+// InstructionDefVariant default variant
+var InstructionDefVariant = bin.NewVariantDefinition(bin.Uint8TypeIDEncoding, []bin.VariantType{
+	{Name: "RequestUnitsDeprecated", Type: (*RequestUnitsDeprecated)(nil)},
+	{Name: "RequestHeapFrame", Type: (*RequestHeapFrame)(nil)},
+	{Name: "SetComputeUnitLimit", Type: (*SetComputeUnitLimit)(nil)},
+	{Name: "SetComputeUnitPrice", Type: (*SetComputeUnitPrice)(nil)},
+	{Name: "SetLoadedAccountsDataSizeLimit", Type: (*SetLoadedAccountsDataSizeLimit)(nil)},
+})
+
+// Instruction type
+type Instruction struct {
+	bin.BaseVariant
+}
+
+// Accounts get accounts (compute budget instructions have no accounts)
+func (i *Instruction) Accounts() (out []*types.AccountMeta) {
+	return []*types.AccountMeta{}
+}
+
+// ProgramID get proram ID
+func (i *Instruction) ProgramID() types.PublicKey {
+	return ComputeBudgetProgramID
+}
+
+// Data get data
+func (i *Instruction) Data() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := bin.NewEncoder(buf).Encode(i); err != nil {
+		return nil, fmt.Errorf("unable to encode instruction: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary unmarshal binary
+func (i *Instruction) UnmarshalBinary(decoder *bin.Decoder) (err error) {
+	return i.BaseVariant.UnmarshalBinaryVariant(decoder, InstructionDefVariant)
+}
+
+// MarshalBinary marshal binary
+func (i *Instruction) MarshalBinary(encoder *bin.Encoder) error {
+	err := encoder.WriteUint8(uint8(i.TypeID))
+	if err != nil {
+		return fmt.Errorf("unable to write variant type: %w", err)
+	}
+	return encoder.Encode(i.Impl)
+}
+
+// RequestUnitsDeprecated type
+type RequestUnitsDeprecated struct {
+	Units         uint32
+	AdditionalFee uint32
+}
+
+// RequestHeapFrame type
+type RequestHeapFrame struct {
+	Bytes uint32
+}
+
+// SetComputeUnitLimit type
+type SetComputeUnitLimit struct {
+	Units uint32
+}
+
+// SetComputeUnitPrice type
+// the price is in micro-lamports per compute unit
+type SetComputeUnitPrice struct {
+	MicroLamports uint64
+}
+
+// SetLoadedAccountsDataSizeLimit type
+type SetLoadedAccountsDataSizeLimit struct {
+	Bytes uint32
+}
+
+// NewSetComputeUnitLimitInstruction new SetComputeUnitLimit instruction
+func NewSetComputeUnitLimitInstruction(units uint32) *Instruction {
+	return &Instruction{
+		BaseVariant: bin.BaseVariant{
+			TypeID: SetComputeUnitLimitTypeID,
+			Impl: &SetComputeUnitLimit{
+				Units: units,
+			},
+		},
+	}
+}
+
+// NewSetComputeUnitPriceInstruction new SetComputeUnitPrice instruction
+func NewSetComputeUnitPriceInstruction(microLamports uint64) *Instruction {
+	return &Instruction{
+		BaseVariant: bin.BaseVariant{
+			TypeID: SetComputeUnitPriceTypeID,
+			Impl: &SetComputeUnitPrice{
+				MicroLamports: microLamports,
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package solana
+
+import (
+	"github.com/deltaswapio/swaprouter/v3/log"
+)
+
+// ScanSwapTxs impl tokens.ISwapTxScanner
+// returns txs in slots [start, end] which invoked the router program
+func (b *Bridge) ScanSwapTxs(start, end uint64) (txHashes []string, err error) {
+	routerProgramID := b.ChainConfig.RouterContract
+	slots, err := b.GetBlocks(start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range *slots {
+		block, errt := b.GetBlock(slot, true)
+		if errt != nil {
+			log.Warn("scan swap txs get block failed", "slot", slot, "err", errt)
+			return txHashes, errt
+		}
+		for _, txm := range block.Transactions {
+			tx := txm.Transaction
+			if tx == nil || len(tx.Signatures) == 0 {
+				continue
+			}
+			for _, ins := range tx.Message.Instructions {
+				programID, errf := tx.ResolveProgramIDIndex(ins.ProgramIDIndex)
+				if errf == nil && programID.String() == routerProgramID {
+					txHashes = append(txHashes, tx.Signatures[0].String())
+					break
+				}
+			}
+		}
+	}
+	return txHashes, nil
+}
@@ -17,10 +17,16 @@ import (
 )
 
 func (b *Bridge) verifyTransactionWithArgs(tx *types.Transaction, args *tokens.BuildTxArgs) error {
-	fmt.Println(tx.Message.Instructions[0].Data)
+	index, err := b.verifyComputeBudgetInstructions(tx)
+	if err != nil {
+		return err
+	}
+	if index+1 != len(tx.Message.Instructions) {
+		return fmt.Errorf("[sign] verify instructions count failed")
+	}
 
 	var inst routerprog.Instruction
-	if err := inst.UnmarshalBinary(bin.NewDecoder(tx.Message.Instructions[0].Data)); err != nil {
+	if err := inst.UnmarshalBinary(bin.NewDecoder(tx.Message.Instructions[index].Data)); err != nil {
 		return fmt.Errorf("unable to decode instruction: %w", err)
 	}
 	params, ok := inst.Impl.(routerprog.ISwapinParams)
@@ -59,7 +65,7 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
@@ -86,7 +92,17 @@ func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs)
 	log.Info(logPrefix+"start", "txid", txid, "fromChainID", args.FromChainID, "toChainID", args.ToChainID)
 
 	mpcConfig := mpc.GetMPCConfig(b.UseFastMPC)
-	keyID, rsvs, err := mpcConfig.DoSignOneED(mpcPubkey, common.ToHex(msgContent), msgContext)
+	var keyID string
+	var rsvs []string
+	if mpcConfig.IsOfflineSign() {
+		offlineRawTx, errf := b.EncodeOfflineRawTx(tx)
+		if errf != nil {
+			return nil, "", errf
+		}
+		keyID, rsvs, err = mpcConfig.DoSignOneEDOffline(b.ChainConfig.ChainID, mpcPubkey, common.ToHex(msgContent), msgContext, offlineRawTx, args)
+	} else {
+		keyID, rsvs, err = mpcConfig.DoSignOneED(mpcPubkey, common.ToHex(msgContent), msgContext)
+	}
 	if err != nil {
 		log.Info(logPrefix+"failed", "keyID", keyID, "txid", txid, "err", err)
 		return nil, "", err
@@ -82,6 +82,12 @@ type LatestBlockhashResult struct {
 	LastValidBlockHeight bin.Uint64 `json:"lastValidBlockHeight"`
 }
 
+// PrioritizationFee recent prioritization fee of slot
+type PrioritizationFee struct {
+	Slot              bin.Uint64 `json:"slot"`
+	PrioritizationFee bin.Uint64 `json:"prioritizationFee"`
+}
+
 // GetBlockResult get block result
 type GetBlockResult struct {
 	Blockhash         Hash                  `json:"blockhash"`
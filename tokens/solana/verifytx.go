@@ -49,7 +49,8 @@ func (b *Bridge) VerifyMsgHash(rawTx interface{}, msgHashes []string) (err error
 		log.Trace("message hash mismatch", "want", msgHash, "have", common.ToHex(msgContent))
 		return tokens.ErrMsgHashMismatch
 	}
-	return nil
+	_, err = b.verifyComputeBudgetInstructions(tx)
+	return err
 }
 
 // VerifyTransaction impl
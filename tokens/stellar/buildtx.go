@@ -26,7 +26,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, fmt.Errorf("forbid empty sender")
 	}
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +71,11 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	args.SwapValue = amount // SwapValue
 	amt := getPaymentAmount(amount, token)
 
+	err = b.checkReceiverTrustline(token.ContractAddress, receiver)
+	if err != nil {
+		return nil, err
+	}
+
 	fromAccount, err := b.GetAccount(args.From)
 	if err != nil {
 		return nil, err
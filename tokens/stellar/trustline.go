@@ -0,0 +1,61 @@
+package stellar
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/stellar/go/txnbuild"
+)
+
+// ensure Bridge impl tokens.ITrustlineChecker
+var _ tokens.ITrustlineChecker = &Bridge{}
+
+// CheckTrustline impl tokens.ITrustlineChecker
+func (b *Bridge) CheckTrustline(tokenAddr, receiver string) (*tokens.TrustlineInfo, error) {
+	assetI, exist := assetMap.Load(tokenAddr)
+	if !exist {
+		return nil, fmt.Errorf("non exist asset %v", tokenAddr)
+	}
+	asset := assetI.(txnbuild.Asset)
+	if asset.IsNative() {
+		return nil, nil
+	}
+	acct, err := b.GetAccount(receiver)
+	if err != nil {
+		return nil, err
+	}
+	info := &tokens.TrustlineInfo{
+		Receiver: receiver,
+		Token:    tokenAddr,
+		Currency: asset.GetCode(),
+		Issuer:   asset.GetIssuer(),
+	}
+	for i := 0; i < len(acct.Balances); i++ {
+		balance := acct.Balances[i]
+		if balance.Code != info.Currency || balance.Issuer != info.Issuer {
+			continue
+		}
+		// the issuer may require authorizing the trustline
+		info.Exist = balance.IsAuthorized == nil || *balance.IsAuthorized
+		break
+	}
+	return info, nil
+}
+
+// checkReceiverTrustline check receiver has trustline before building payment
+func (b *Bridge) checkReceiverTrustline(tokenAddr, receiver string) error {
+	if !params.IsSwapServer {
+		return nil
+	}
+	info, err := b.CheckTrustline(tokenAddr, receiver)
+	if err != nil {
+		log.Warn("check receiver trustline failed", "token", tokenAddr, "receiver", receiver, "err", err)
+		return fmt.Errorf("%w %v", tokens.ErrBuildTxErrorAndDelay, "check receiver trustline failed")
+	}
+	if info != nil && !info.Exist {
+		return fmt.Errorf("%w: receiver %v, asset %v/%v", tokens.ErrMissTrustline, receiver, info.Currency, info.Issuer)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package tokens
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+const (
+	testERC20Router   = "0x1111111111111111111111111111111111111111"
+	testNFTRouter     = "0x2222222222222222222222222222222222222222"
+	testAnyCallRouter = "0x3333333333333333333333333333333333333333"
+)
+
+func setSwapTypesTestConfig(t *testing.T, swapTypes ...string) {
+	t.Helper()
+	config := params.GetRouterConfig()
+	oldSwapTypes := config.SwapTypes
+	config.SwapTypes = map[string]*params.SwapTypeConfig{
+		"nftswap":     {RouterContracts: map[string]string{"56": testNFTRouter}},
+		"anycallswap": {SwapSubType: AnycallSubTypeV7, RouterContracts: map[string]string{"56": testAnyCallRouter}},
+	}
+	t.Cleanup(func() {
+		config.SwapTypes = oldSwapTypes
+		routerSwapTypes = map[SwapType]bool{}
+	})
+	InitRouterSwapTypes(swapTypes)
+}
+
+func TestGetTokenSwapType(t *testing.T) {
+	tests := []struct {
+		swapTypes      []string
+		routerContract string
+		want           SwapType
+	}{
+		{[]string{"erc20swap", "nftswap", "anycallswap"}, testERC20Router, ERC20SwapType},
+		{[]string{"erc20swap", "nftswap", "anycallswap"}, testNFTRouter, NFTSwapType},
+		{[]string{"erc20swap", "nftswap", "anycallswap"}, testAnyCallRouter, AnyCallSwapType},
+		{[]string{"erc20swap", "nftswap"}, "", ERC20SwapType},
+		{[]string{"erc20swap"}, testNFTRouter, ERC20SwapType}, // nft swap type is not enabled
+		{[]string{"nftswap"}, testERC20Router, NFTSwapType},
+		{[]string{"anycallswap", "nftswap"}, testERC20Router, AnyCallSwapType},
+	}
+	for i, tt := range tests {
+		setSwapTypesTestConfig(t, tt.swapTypes...)
+		if have := GetTokenSwapType("56", tt.routerContract); have != tt.want {
+			t.Errorf("case %d: GetTokenSwapType = %v, want %v", i, have.String(), tt.want.String())
+		}
+	}
+}
+
+func TestCheckTokenConfigDecimals(t *testing.T) {
+	// erc20 and nft tokens in one router process
+	setSwapTypesTestConfig(t, "erc20swap", "nftswap")
+
+	erc20Token := &TokenConfig{TokenID: "USDC", ContractAddress: testERC20Router, Decimals: 6}
+	if err := erc20Token.CheckConfig(GetTokenSwapType("56", testERC20Router)); err != nil {
+		t.Errorf("check erc20 token config failed: %v", err)
+	}
+	nftToken := &TokenConfig{TokenID: "NFT", ContractAddress: testNFTRouter, Decimals: 18}
+	if err := nftToken.CheckConfig(GetTokenSwapType("56", testNFTRouter)); err == nil {
+		t.Errorf("check nft token config with nonzero decimals passed")
+	}
+	nftToken.Decimals = 0
+	if err := nftToken.CheckConfig(GetTokenSwapType("56", testNFTRouter)); err != nil {
+		t.Errorf("check nft token config failed: %v", err)
+	}
+}
@@ -138,7 +138,7 @@ func LoadTestConfig(configFile string) {
 
 	TestConfig = config
 
-	tokens.InitRouterSwapType(TestConfig.SwapType)
+	tokens.InitRouterSwapTypes([]string{TestConfig.SwapType})
 
 	checkConfig()
 }
@@ -163,7 +163,8 @@ func checkConfig() {
 		log.Fatal("check chain config failed", "err", err)
 	}
 
-	if err = TestConfig.Token.CheckConfig(); err != nil {
+	tokenSwapType := tokens.GetTokenSwapType(TestConfig.Chain.ChainID, TestConfig.Token.RouterContract)
+	if err = TestConfig.Token.CheckConfig(tokenSwapType); err != nil {
 		log.Fatal("check token config failed", "err", err)
 	}
 
@@ -3,6 +3,7 @@ package tron
 import (
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/deltaswapio/swaprouter/v3/common"
 	"github.com/deltaswapio/swaprouter/v3/log"
@@ -13,6 +14,8 @@ import (
 var (
 	// ensure Bridge impl tokens.CrossChainBridge
 	_ tokens.IBridge = &Bridge{}
+	// ensure Bridge impl tokens.ILiquidityChecker
+	_ tokens.ILiquidityChecker = &Bridge{}
 )
 
 var TronMainnetChainID = uint64(112233)
@@ -23,6 +26,8 @@ type Bridge struct {
 	*tokens.CrossChainBridgeBase
 	SignerChainID *big.Int
 	TronChainID   *big.Int
+
+	trackedResources sync.Map // key is account
 }
 
 // NewCrossChainBridge new bridge
@@ -63,7 +68,7 @@ func (b *Bridge) InitRouterInfo(routerContract, routerVersion string) (err error
 		return nil
 	}
 	var routerWNative string
-	if tokens.IsERC20Router() {
+	if tokens.IsERC20Router() && !tokens.IsSwapTypeRouterContract(b.ChainConfig.ChainID, routerContract) {
 		routerWNative, err = b.GetWNativeAddress(routerContract)
 		if err != nil {
 			log.Warn("get router wNative address failed", "routerContract", routerContract, "err", err)
@@ -34,7 +34,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	if args.From == "" {
 		return nil, fmt.Errorf("forbid empty sender")
 	}
-	routerMPC, err := router.GetRouterMPC(args.GetTokenID(), b.ChainConfig.ChainID)
+	routerMPC, err := router.GetRouterMPC(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +63,7 @@ func (b *Bridge) BuildRawTransaction(args *tokens.BuildTxArgs) (rawTx interface{
 	return b.buildTx(args)
 }
 
+// SwapinFeeLimit default max fee limit of swapin tx
 var SwapinFeeLimit int64 = 300000000 // 300 TRX
 
 func (b *Bridge) buildTx(args *tokens.BuildTxArgs) (rawTx interface{}, err error) {
@@ -85,7 +86,10 @@ func (b *Bridge) buildTx(args *tokens.BuildTxArgs) (rawTx interface{}, err error
 		parameter = hex.EncodeToString(*args.Input)
 	}
 
-	rawTx, err = b.BuildTriggerConstantContractTx(args.From, args.To, args.Selector, parameter, SwapinFeeLimit)
+	feeLimit, err := b.calcFeeLimit(args, parameter)
+	if err == nil {
+		rawTx, err = b.BuildTriggerConstantContractTx(args.From, args.To, args.Selector, parameter, feeLimit)
+	}
 
 	ctx := []interface{}{
 		"identifier", args.Identifier, "swapID", args.SwapID,
@@ -93,7 +97,7 @@ func (b *Bridge) buildTx(args *tokens.BuildTxArgs) (rawTx interface{}, err error
 		"from", args.From, "to", args.To, "bind", args.Bind,
 		"replaceNum", args.GetReplaceNum(),
 		"selector", strings.Split(args.Selector, "(")[0],
-		"feeLimit", SwapinFeeLimit,
+		"feeLimit", feeLimit,
 	}
 	switch {
 	case args.ERC20SwapInfo != nil:
@@ -136,10 +140,10 @@ func (b *Bridge) verifyRawTransaction(tx *core.Transaction, args *tokens.BuildTx
 		return fmt.Errorf("tx input is nil")
 	}
 
-	feeLimit := tx.GetRawData().GetFeeLimit()
-	if feeLimit != SwapinFeeLimit {
-		log.Error("tx fee limit mismatch", "have", feeLimit, "want", SwapinFeeLimit)
-		return fmt.Errorf("tx fee limit mismatch")
+	tokenID := args.GetTokenID()
+	err := b.checkFeeLimit(tx.GetRawData().GetFeeLimit(), tokenID)
+	if err != nil {
+		return err
 	}
 
 	contract, err := getTriggerSmartContract(tx)
@@ -147,9 +151,8 @@ func (b *Bridge) verifyRawTransaction(tx *core.Transaction, args *tokens.BuildTx
 		return err
 	}
 
-	tokenID := args.GetTokenID()
 	txRecipient := tronaddress.Address(contract.ContractAddress).String()
-	checkReceiver, err := router.GetTokenRouterContract(tokenID, b.ChainConfig.ChainID)
+	checkReceiver, err := router.GetTokenRouterContract(args.SwapType, tokenID, b.ChainConfig.ChainID)
 	if err != nil {
 		return err
 	}
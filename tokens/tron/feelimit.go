@@ -0,0 +1,145 @@
+package tron
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	defaultFeeLimitHeadroom = 20 // percent
+)
+
+var (
+	errFeeLimitTooLarge  = errors.New("estimated fee limit is too large")
+	errFeeLimitOutBounds = errors.New("tx fee limit out of bounds")
+)
+
+type rpcConstantCallResult struct {
+	Result struct {
+		Result  bool   `json:"result"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"result"`
+	EnergyUsed int64 `json:"energy_used"`
+}
+
+type rpcChainParameters struct {
+	ChainParameter []struct {
+		Key   string `json:"key"`
+		Value int64  `json:"value"`
+	} `json:"chainParameter"`
+}
+
+// EstimateEnergy estimate energy of calling contract by `triggerconstantcontract`
+func (b *Bridge) EstimateEnergy(from, contract, selector, parameter string) (int64, error) {
+	rpcError := &RPCError{[]error{}, "EstimateEnergy"}
+	txdata := `{"owner_address":"` + tronToEthWithPrefix(from) + `","contract_address":"` + tronToEthWithPrefix(contract) + `","function_selector":"` + selector + `","parameter":"` + parameter + `"}`
+	for _, endpoint := range b.GatewayConfig.AllGatewayURLs {
+		apiurl := strings.TrimSuffix(endpoint, "/") + `/wallet/triggerconstantcontract`
+		res, err := post(apiurl, txdata)
+		if err != nil {
+			rpcError.log(fmt.Errorf("post error: %w", err))
+			continue
+		}
+		var result rpcConstantCallResult
+		err = json.Unmarshal(res, &result)
+		if err != nil {
+			rpcError.log(errors.New("parse error: json"))
+			continue
+		}
+		if !result.Result.Result {
+			msg, _ := hex.DecodeString(result.Result.Message)
+			return 0, fmt.Errorf("%w: %v %v", tokens.ErrEstimateGasFailed, result.Result.Code, string(msg))
+		}
+		return result.EnergyUsed, nil
+	}
+	return 0, rpcError.Error()
+}
+
+// GetEnergyFee get energy price (in sun) from chain parameters
+func (b *Bridge) GetEnergyFee() (int64, error) {
+	rpcError := &RPCError{[]error{}, "GetEnergyFee"}
+	for _, endpoint := range b.GatewayConfig.AllGatewayURLs {
+		apiurl := strings.TrimSuffix(endpoint, "/") + `/wallet/getchainparameters`
+		res, err := post(apiurl, `{}`)
+		if err != nil {
+			rpcError.log(fmt.Errorf("post error: %w", err))
+			continue
+		}
+		var result rpcChainParameters
+		err = json.Unmarshal(res, &result)
+		if err != nil {
+			rpcError.log(errors.New("parse error: json"))
+			continue
+		}
+		for _, param := range result.ChainParameter {
+			if param.Key == "getEnergyFee" && param.Value > 0 {
+				return param.Value, nil
+			}
+		}
+		rpcError.log(errors.New("energy fee not found"))
+	}
+	return 0, rpcError.Error()
+}
+
+// getFeeLimitBounds get fee limit bounds of token,
+// defaults to [0, SwapinFeeLimit] if not configed.
+func (b *Bridge) getFeeLimitBounds(tokenID string) (minFeeLimit, maxFeeLimit int64) {
+	minFeeLimit, maxFeeLimit = params.GetFeeLimitBounds(b.ChainConfig.ChainID, tokenID)
+	if maxFeeLimit == 0 {
+		maxFeeLimit = SwapinFeeLimit
+	}
+	return minFeeLimit, maxFeeLimit
+}
+
+// calcFeeLimit estimate energy and derive fee limit with headroom,
+// the fee limit is bounded by the token config.
+func (b *Bridge) calcFeeLimit(args *tokens.BuildTxArgs, parameter string) (int64, error) {
+	energy, err := b.EstimateEnergy(args.From, args.To, args.Selector, parameter)
+	if err != nil {
+		return 0, err
+	}
+	b.trackAccountResource(args.From, energy)
+
+	energyFee, err := b.GetEnergyFee()
+	if err != nil {
+		return 0, err
+	}
+	headroom := params.GetFeeLimitHeadroom(b.ChainConfig.ChainID)
+	if headroom == 0 {
+		headroom = defaultFeeLimitHeadroom
+	}
+	feeLimit := new(big.Int).Mul(big.NewInt(energy), big.NewInt(energyFee))
+	feeLimit.Mul(feeLimit, new(big.Int).SetUint64(100+headroom))
+	feeLimit.Div(feeLimit, big.NewInt(100))
+
+	tokenID := args.GetTokenID()
+	minFeeLimit, maxFeeLimit := b.getFeeLimitBounds(tokenID)
+	log.Info("calc tron fee limit", "swapID", args.SwapID, "tokenID", tokenID, "energy", energy, "energyFee", energyFee,
+		"headroom", headroom, "feeLimit", feeLimit, "minFeeLimit", minFeeLimit, "maxFeeLimit", maxFeeLimit)
+	if feeLimit.Cmp(big.NewInt(maxFeeLimit)) > 0 {
+		return 0, fmt.Errorf("%w: %v > %v", errFeeLimitTooLarge, feeLimit, maxFeeLimit)
+	}
+	if feeLimit.Int64() < minFeeLimit {
+		return minFeeLimit, nil
+	}
+	return feeLimit.Int64(), nil
+}
+
+// checkFeeLimit check fee limit is in the bounds of token config
+func (b *Bridge) checkFeeLimit(feeLimit int64, tokenID string) error {
+	minFeeLimit, maxFeeLimit := b.getFeeLimitBounds(tokenID)
+	if feeLimit < minFeeLimit || feeLimit > maxFeeLimit {
+		log.Error("tx fee limit out of bounds", "feeLimit", feeLimit, "minFeeLimit", minFeeLimit, "maxFeeLimit", maxFeeLimit, "tokenID", tokenID)
+		return fmt.Errorf("%w: %v not in [%v, %v]", errFeeLimitOutBounds, feeLimit, minFeeLimit, maxFeeLimit)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package tron
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	testFeeLimitChainID = "112233"
+	testFeeLimitFrom    = "TJRyWwFs9wTFGZg3JbrVriFbNfCug5tDeC"
+	testFeeLimitTo      = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	testEnergyFee       = 420 // sun
+	testStakedEnergy    = 80000
+)
+
+// newFeeLimitTestBridge new bridge with a fake tron node,
+// which returns the energy in 'energy' as the estimated energy
+func newFeeLimitTestBridge(t *testing.T, energy *int64) *Bridge {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wallet/triggerconstantcontract":
+			if used := atomic.LoadInt64(energy); used > 0 {
+				fmt.Fprintf(w, `{"result":{"result":true},"energy_used":%d}`, used)
+			} else {
+				fmt.Fprint(w, `{"result":{"result":false,"code":"CONTRACT_VALIDATE_ERROR","message":"7265766572746564"}}`)
+			}
+		case "/wallet/getchainparameters":
+			fmt.Fprintf(w, `{"chainParameter":[{"key":"getTransactionFee","value":1000},{"key":"getEnergyFee","value":%d}]}`, testEnergyFee)
+		case "/wallet/getaccountresource":
+			fmt.Fprintf(w, `{"freeNetLimit":1500,"freeNetUsed":100,"EnergyLimit":%d}`, testStakedEnergy)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	b := NewCrossChainBridge()
+	b.SetChainConfig(&tokens.ChainConfig{ChainID: testFeeLimitChainID})
+	b.GatewayConfig = &tokens.GatewayConfig{AllGatewayURLs: []string{srv.URL}}
+	return b
+}
+
+func setFeeLimitTestConfig(t *testing.T, headroom uint64) {
+	t.Helper()
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		LocalChainConfig: map[string]*params.LocalChainConfig{
+			testFeeLimitChainID: {
+				FeeLimitHeadroom: headroom,
+				MinFeeLimit:      map[string]int64{"default": 10000000},
+				MaxFeeLimit:      map[string]int64{"default": 100000000, "USDT": 50000000},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("set extra config failed: %v", err)
+	}
+}
+
+func newFeeLimitTestArgs(tokenID string) *tokens.BuildTxArgs {
+	args := &tokens.BuildTxArgs{
+		From:     testFeeLimitFrom,
+		To:       testFeeLimitTo,
+		Selector: "anySwapIn(bytes32,address,address,uint256,uint256)",
+	}
+	args.ERC20SwapInfo = &tokens.ERC20SwapInfo{TokenID: tokenID}
+	return args
+}
+
+func TestCalcFeeLimit(t *testing.T) {
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+
+	var energy int64
+	b := newFeeLimitTestBridge(t, &energy)
+
+	tests := []struct {
+		headroom uint64
+		tokenID  string
+		energy   int64
+		feeLimit int64
+		err      error
+	}{
+		// energy * energy fee * (100 + headroom) / 100
+		{50, "USDC", 100000, 63000000, nil},
+		{0, "USDC", 100000, 50400000, nil}, // default headroom 20
+		{50, "USDC", 10000, 10000000, nil}, // raised to the min fee limit
+		{50, "USDC", 200000, 0, errFeeLimitTooLarge},
+		{50, "USDT", 100000, 0, errFeeLimitTooLarge}, // token specific max fee limit
+		{50, "USDT", 50000, 31500000, nil},
+		{50, "USDC", 0, 0, tokens.ErrEstimateGasFailed},
+	}
+	for i, tt := range tests {
+		setFeeLimitTestConfig(t, tt.headroom)
+		atomic.StoreInt64(&energy, tt.energy)
+		feeLimit, err := b.calcFeeLimit(newFeeLimitTestArgs(tt.tokenID), "")
+		if !errors.Is(err, tt.err) {
+			t.Errorf("case %d: calcFeeLimit error = %v, want %v", i, err, tt.err)
+			continue
+		}
+		if feeLimit != tt.feeLimit {
+			t.Errorf("case %d: calcFeeLimit = %v, want %v", i, feeLimit, tt.feeLimit)
+		}
+	}
+}
+
+func TestTrackAccountResource(t *testing.T) {
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+	setFeeLimitTestConfig(t, 0)
+
+	var energy int64
+	b := newFeeLimitTestBridge(t, &energy)
+	if tracked := b.GetTrackedAccountResource(testFeeLimitFrom); tracked != nil {
+		t.Fatalf("tracked resource before building tx = %+v, want nil", tracked)
+	}
+
+	tests := []struct {
+		energy       int64
+		insufficient bool
+	}{
+		{testStakedEnergy, false},
+		{testStakedEnergy + 1, true},
+	}
+	for i, tt := range tests {
+		atomic.StoreInt64(&energy, tt.energy)
+		if _, err := b.calcFeeLimit(newFeeLimitTestArgs("USDC"), ""); err != nil {
+			t.Fatalf("case %d: calcFeeLimit failed: %v", i, err)
+		}
+		tracked := b.GetTrackedAccountResource(testFeeLimitFrom)
+		if tracked == nil {
+			t.Fatalf("case %d: resource is not tracked", i)
+		}
+		if tracked.EstimatedEnergy != tt.energy || tracked.Insufficient != tt.insufficient {
+			t.Errorf("case %d: tracked estimated energy %v insufficient %v, want %v %v",
+				i, tracked.EstimatedEnergy, tracked.Insufficient, tt.energy, tt.insufficient)
+		}
+		if tracked.NetLimit != 1500 || tracked.NetUsed != 100 || tracked.EnergyLimit != testStakedEnergy {
+			t.Errorf("case %d: wrong tracked resource %+v", i, tracked)
+		}
+
+		// the live resource carries the tracked estimation
+		resource, err := b.GetAccountResource(testFeeLimitFrom)
+		if err != nil {
+			t.Fatalf("case %d: get account resource failed: %v", i, err)
+		}
+		if resource.EstimatedEnergy != tt.energy || resource.Insufficient != tt.insufficient {
+			t.Errorf("case %d: account resource estimated energy %v insufficient %v, want %v %v",
+				i, resource.EstimatedEnergy, resource.Insufficient, tt.energy, tt.insufficient)
+		}
+	}
+}
+
+func TestCheckFeeLimit(t *testing.T) {
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+	setFeeLimitTestConfig(t, 0)
+
+	b := NewCrossChainBridge()
+	b.SetChainConfig(&tokens.ChainConfig{ChainID: testFeeLimitChainID})
+
+	tests := []struct {
+		feeLimit int64
+		tokenID  string
+		err      error
+	}{
+		{10000000, "USDC", nil},
+		{100000000, "USDC", nil},
+		{9999999, "USDC", errFeeLimitOutBounds},
+		{100000001, "USDC", errFeeLimitOutBounds},
+		{50000000, "usdt", nil},
+		{50000001, "USDT", errFeeLimitOutBounds},
+	}
+	for i, tt := range tests {
+		if err := b.checkFeeLimit(tt.feeLimit, tt.tokenID); !errors.Is(err, tt.err) {
+			t.Errorf("case %d: checkFeeLimit error = %v, want %v", i, err, tt.err)
+		}
+	}
+
+	// defaults to [0, SwapinFeeLimit] without config
+	if err := params.SetExtraConfig(&params.ExtraConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.checkFeeLimit(SwapinFeeLimit, "USDC"); err != nil {
+		t.Errorf("checkFeeLimit of default max fee limit error = %v", err)
+	}
+	if err := b.checkFeeLimit(SwapinFeeLimit+1, "USDC"); !errors.Is(err, errFeeLimitOutBounds) {
+		t.Errorf("checkFeeLimit above default max fee limit error = %v, want %v", err, errFeeLimitOutBounds)
+	}
+}
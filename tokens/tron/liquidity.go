@@ -0,0 +1,33 @@
+package tron
+
+import (
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+)
+
+// GetTokenLiquidity impl tokens.ILiquidityChecker
+// returns the underlying balance held by the multichain token
+func (b *Bridge) GetTokenLiquidity(tokenAddr string) (*big.Int, error) {
+	underlying, err := b.GetUnderlyingAddress(tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+	if common.HexToAddress(convertToEthAddress(underlying)) == (common.Address{}) {
+		return nil, nil // without underlying
+	}
+	return b.GetErc20Balance(underlying, convertToEthAddress(tokenAddr))
+}
+
+// IsLiquidityRequired impl tokens.ILiquidityChecker
+// only anySwapInUnderlying(AndExec) reverts when liquidity is insufficient
+func (b *Bridge) IsLiquidityRequired(tokenAddr string, withCall bool) bool {
+	tokenCfg := b.GetTokenConfig(tokenAddr)
+	if tokenCfg == nil {
+		return false
+	}
+	if withCall {
+		return GetSwapInAndExecFuncHash(tokenCfg) == AnySwapInUnderlyingAndExecFuncHash
+	}
+	return GetSwapInFuncHash(tokenCfg) == AnySwapInUnderlyingFuncHash
+}
@@ -173,7 +173,7 @@ func (b *Bridge) verifyNFTSwapTxLog(swapInfo *tokens.SwapTxInfo, rlog *types.RPC
 		return tokens.ErrTxWithRemovedLog
 	}
 
-	routerContract := b.GetRouterContract(swapInfo.NFTSwapInfo.Token)
+	routerContract := b.GetSwapTypeRouterContract(tokens.NFTSwapType, swapInfo.NFTSwapInfo.Token)
 	if routerContract == "" {
 		return tokens.ErrMissRouterInfo
 	}
@@ -439,7 +439,7 @@ func (b *Bridge) buildNFTSwapTxInput(args *tokens.BuildTxArgs) (err error) {
 	}
 
 	args.Input = (*hexutil.Bytes)(&input) // input
-	routerContract := b.GetRouterContract(multichainToken)
+	routerContract := b.GetSwapTypeRouterContract(tokens.NFTSwapType, multichainToken)
 	args.To = routerContract // to
 
 	return nil
@@ -0,0 +1,98 @@
+package tron
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// ensure Bridge impl tokens.IAccountResourceGetter
+var _ tokens.IAccountResourceGetter = &Bridge{}
+
+type rpcAccountResource struct {
+	FreeNetLimit int64 `json:"freeNetLimit"`
+	FreeNetUsed  int64 `json:"freeNetUsed"`
+	NetLimit     int64 `json:"NetLimit"`
+	NetUsed      int64 `json:"NetUsed"`
+	EnergyLimit  int64 `json:"EnergyLimit"`
+	EnergyUsed   int64 `json:"EnergyUsed"`
+}
+
+// GetAccountResource get staked energy and bandwidth of account
+func (b *Bridge) GetAccountResource(account string) (*tokens.AccountResource, error) {
+	rpcError := &RPCError{[]error{}, "GetAccountResource"}
+	address := tronToEthWithPrefix(account)
+	if address == "" {
+		return nil, fmt.Errorf("wrong tron address: %v", account)
+	}
+	for _, endpoint := range b.GatewayConfig.AllGatewayURLs {
+		apiurl := strings.TrimSuffix(endpoint, "/") + `/wallet/getaccountresource`
+		res, err := post(apiurl, `{"address":"`+address+`","visible":false}`)
+		if err != nil {
+			rpcError.log(fmt.Errorf("post error: %w", err))
+			continue
+		}
+		var result rpcAccountResource
+		err = json.Unmarshal(res, &result)
+		if err != nil {
+			rpcError.log(errors.New("parse error: json"))
+			continue
+		}
+		resource := &tokens.AccountResource{
+			Account:     account,
+			EnergyLimit: result.EnergyLimit,
+			EnergyUsed:  result.EnergyUsed,
+			NetLimit:    result.NetLimit + result.FreeNetLimit,
+			NetUsed:     result.NetUsed + result.FreeNetUsed,
+			Timestamp:   time.Now().Unix(),
+		}
+		if tracked := b.GetTrackedAccountResource(account); tracked != nil {
+			resource.EstimatedEnergy = tracked.EstimatedEnergy
+			resource.Insufficient = isResourceInsufficient(resource, tracked.EstimatedEnergy)
+			resource.TrackTimestamp = tracked.TrackTimestamp
+		}
+		return resource, nil
+	}
+	return nil, rpcError.Error()
+}
+
+// GetTrackedAccountResource get the resource of account recorded when building txs (nil if not tracked)
+func (b *Bridge) GetTrackedAccountResource(account string) *tokens.AccountResource {
+	if v, exist := b.trackedResources.Load(account); exist {
+		resource := *v.(*tokens.AccountResource)
+		return &resource
+	}
+	return nil
+}
+
+func isResourceInsufficient(resource *tokens.AccountResource, energy int64) bool {
+	return resource.EnergyLimit-resource.EnergyUsed < energy || resource.NetLimit <= resource.NetUsed
+}
+
+// trackAccountResource refresh and record the resource of the sender (mpc) with the estimated energy,
+// and warn if the staked energy or bandwidth can not cover it.
+func (b *Bridge) trackAccountResource(account string, energy int64) {
+	resource, err := b.GetAccountResource(account)
+	if err != nil {
+		log.Warn("get tron account resource failed", "account", account, "err", err)
+		return
+	}
+	resource.EstimatedEnergy = energy
+	resource.Insufficient = isResourceInsufficient(resource, energy)
+	resource.TrackTimestamp = resource.Timestamp
+	b.trackedResources.Store(account, resource)
+
+	if available := resource.EnergyLimit - resource.EnergyUsed; available < energy {
+		log.Warn("tron account staked energy is insufficient, will burn trx", "account", account,
+			"energyLimit", resource.EnergyLimit, "energyUsed", resource.EnergyUsed, "estimated", energy)
+	}
+	if resource.NetLimit <= resource.NetUsed {
+		log.Warn("tron account bandwidth is used up, will burn trx", "account", account,
+			"netLimit", resource.NetLimit, "netUsed", resource.NetUsed)
+	}
+}
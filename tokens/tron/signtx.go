@@ -36,7 +36,7 @@ func getTriggerSmartContract(tx *core.Transaction) (*core.TriggerSmartContract,
 	return &contract, nil
 }
 
-func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*core.Transaction, error) {
+func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, args *tokens.BuildTxArgs) (*core.Transaction, error) {
 	tx, ok := rawTx.(*core.Transaction)
 	if !ok {
 		return nil, errors.New("wrong raw tx param")
@@ -49,7 +49,7 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 
 	txRecipient := tronaddress.Address(contract.ContractAddress).String()
 
-	checkReceiver, err := router.GetTokenRouterContract(tokenID, b.ChainConfig.ChainID)
+	checkReceiver, err := router.GetTokenRouterContract(args.SwapType, args.GetTokenID(), b.ChainConfig.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,13 +62,13 @@ func (b *Bridge) verifyTransactionReceiver(rawTx interface{}, tokenID string) (*
 
 // MPCSignTransaction mpc sign raw tx
 func (b *Bridge) MPCSignTransaction(rawTx interface{}, args *tokens.BuildTxArgs) (signTx interface{}, txHash string, err error) {
-	tx, err := b.verifyTransactionReceiver(rawTx, args.GetTokenID())
+	tx, err := b.verifyTransactionReceiver(rawTx, args)
 	if err != nil {
 		return nil, "", err
 	}
 
 	mpcParams := params.GetMPCConfig(b.UseFastMPC)
-	if mpcParams.SignWithPrivateKey {
+	if mpcParams.IsSignWithPrivateKey() {
 		priKey := mpcParams.GetSignerPrivateKey(b.ChainConfig.ChainID)
 		return b.SignTransactionWithPrivateKey(rawTx, priKey)
 	}
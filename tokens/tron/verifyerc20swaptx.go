@@ -62,6 +62,15 @@ func (b *Bridge) verifyERC20SwapTx(txHash string, logIndex int, allowUnstable bo
 	}
 
 	if !allowUnstable {
+		fromTokenCfg := b.GetTokenConfig(swapInfo.ERC20SwapInfo.Token)
+		if fromTokenCfg == nil {
+			return swapInfo, tokens.ErrMissTokenConfig
+		}
+		err = tokens.CheckTierConfirmations(swapInfo, tokens.GetConfirmationTier(swapInfo, fromTokenCfg.Decimals))
+		if err != nil {
+			return swapInfo, err
+		}
+
 		ctx := []interface{}{
 			"identifier", params.GetIdentifier(),
 			"from", swapInfo.From, "to", swapInfo.To,
@@ -149,9 +158,11 @@ func (b *Bridge) checkTxSuccess(swapInfo *tokens.SwapTxInfo, allowUnstable bool)
 
 	swapInfo.Height = txStatus.BlockHeight  // Height
 	swapInfo.Timestamp = txStatus.BlockTime // Timestamp
+	swapInfo.Confirmations = txStatus.Confirmations
 
 	if !allowUnstable && txStatus.Confirmations < b.ChainConfig.Confirmations {
-		return tokens.ErrTxNotStable
+		return fmt.Errorf("%w: wait for %v confirmations",
+			tokens.ErrTxNotStable, b.ChainConfig.Confirmations)
 	}
 
 	tx, err := b.GetTronTransaction(swapInfo.Hash)
@@ -129,21 +129,46 @@ func (s *SwapInfo) GetToken() string {
 	return ""
 }
 
+// AccountResource account resource (eg. tron energy and bandwidth)
+type AccountResource struct {
+	Account     string `json:"account"`
+	EnergyLimit int64  `json:"energyLimit"`
+	EnergyUsed  int64  `json:"energyUsed"`
+	NetLimit    int64  `json:"netLimit"` // staked and free bandwidth
+	NetUsed     int64  `json:"netUsed"`
+	Timestamp   int64  `json:"timestamp"`
+
+	// tracked when building txs from the account
+	EstimatedEnergy int64 `json:"estimatedEnergy,omitempty"` // energy of the last built tx
+	Insufficient    bool  `json:"insufficient,omitempty"`    // staked resource can not cover it, burn trx
+	TrackTimestamp  int64 `json:"trackTimestamp,omitempty"`
+}
+
+// TrustlineInfo the trustline (eg. stellar and xrpl) required to receive issued asset
+type TrustlineInfo struct {
+	Receiver string `json:"receiver"`
+	Token    string `json:"token"`
+	Currency string `json:"currency"` // asset code
+	Issuer   string `json:"issuer"`
+	Exist    bool   `json:"exist"`
+}
+
 // SwapTxInfo struct
 type SwapTxInfo struct {
-	SwapInfo    `json:"swapinfo"`
-	SwapType    SwapType `json:"swaptype"`
-	Hash        string   `json:"hash"`
-	Height      uint64   `json:"height"`
-	Timestamp   uint64   `json:"timestamp"`
-	From        string   `json:"from"`
-	TxTo        string   `json:"txto"`
-	To          string   `json:"to"`
-	Bind        string   `json:"bind"`
-	Value       *big.Int `json:"value"`
-	LogIndex    int      `json:"logIndex"`
-	FromChainID *big.Int `json:"fromChainID"`
-	ToChainID   *big.Int `json:"toChainID"`
+	SwapInfo      `json:"swapinfo"`
+	SwapType      SwapType `json:"swaptype"`
+	Hash          string   `json:"hash"`
+	Height        uint64   `json:"height"`
+	Timestamp     uint64   `json:"timestamp"`
+	Confirmations uint64   `json:"confirmations,omitempty"` // confirmations when verifying
+	From          string   `json:"from"`
+	TxTo          string   `json:"txto"`
+	To            string   `json:"to"`
+	Bind          string   `json:"bind"`
+	Value         *big.Int `json:"value"`
+	LogIndex      int      `json:"logIndex"`
+	FromChainID   *big.Int `json:"fromChainID"`
+	ToChainID     *big.Int `json:"toChainID"`
 }
 
 // TxStatus struct
@@ -202,6 +227,32 @@ type SwapArgs struct {
 	FromChainID *big.Int `json:"fromChainID"`
 	ToChainID   *big.Int `json:"toChainID"`
 	Reswapping  bool     `json:"reswapping,omitempty"`
+
+	Refund  *RefundInfo  `json:"refund,omitempty"`
+	GasDrop *GasDropInfo `json:"gasDrop,omitempty"`
+}
+
+// RefundInfo refund info (pay back to sender on the source chain)
+type RefundInfo struct {
+	Reason string   `json:"reason"` // swap status name of the undeliverable swap
+	Fee    *big.Int `json:"fee"`
+	// signed admin approval, required if the swap is made fail manually
+	Approval string `json:"approval,omitempty"`
+}
+
+// IsRefund is refund
+func (args *SwapArgs) IsRefund() bool {
+	return args.Refund != nil
+}
+
+// GasDropInfo gas drop info (native coin top-up of the receiver on the dest chain)
+type GasDropInfo struct {
+	Amount *big.Int `json:"amount"`
+}
+
+// IsGasDrop is gas drop
+func (args *SwapArgs) IsGasDrop() bool {
+	return args.GasDrop != nil
 }
 
 // BuildTxArgs struct
@@ -228,6 +279,7 @@ type AllExtras struct {
 	GasFeeCap   *big.Int      `json:"gasFeeCap,omitempty"`
 	Sequence    *uint64       `json:"sequence,omitempty"`
 	ReplaceNum  uint64        `json:"replaceNum,omitempty"`
+	EscalateNum uint64        `json:"escalateNum,omitempty"`
 	Fee         *string       `json:"fee,omitempty"`
 	RawTx       hexutil.Bytes `json:"rawTx,omitempty"`
 	BlockHash   *string       `json:"blockHash,omitempty"`
@@ -235,6 +287,10 @@ type AllExtras struct {
 	BlockNumber *uint64       `json:"blockNumber,omitempty"`
 	TTL         *uint64       `json:"ttl,omitempty"`
 	BridgeFee   *big.Int      `json:"bridgeFee,omitempty"`
+	// storage deposit (eg. near nep141 storage_deposit) paid for the receiver
+	StorageDeposit *big.Int `json:"storageDeposit,omitempty"`
+	// gas drop charged from the swap value, delivered right behind the payout
+	GasDrop *big.Int `json:"gasDrop,omitempty"`
 }
 
 // GetReplaceNum get rplace swap count
@@ -245,6 +301,15 @@ func (args *BuildTxArgs) GetReplaceNum() uint64 {
 	return 0
 }
 
+// GetEscalateNum get count of previous sent txs of reswapping
+// (used to escalate priority fee, eg. solana compute unit price)
+func (args *BuildTxArgs) GetEscalateNum() uint64 {
+	if args.Extra != nil {
+		return args.Extra.EscalateNum
+	}
+	return 0
+}
+
 // GetExtraArgs get extra args
 func (args *BuildTxArgs) GetExtraArgs() *BuildTxArgs {
 	swapArgs := args.SwapArgs
@@ -286,5 +351,11 @@ func (args *BuildTxArgs) GetUniqueSwapIdentifier() string {
 	if common.IsHexHash(swapID) {
 		swapID = common.HexToHash(swapID).Hex()
 	}
+	if args.IsRefund() {
+		return fmt.Sprintf("refund:%v:%v:%v", fromChainID, swapID, logIndex)
+	}
+	if args.IsGasDrop() {
+		return fmt.Sprintf("gasdrop:%v:%v:%v", fromChainID, swapID, logIndex)
+	}
 	return fmt.Sprintf("%v:%v:%v", fromChainID, swapID, logIndex)
 }
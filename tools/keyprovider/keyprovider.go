@@ -0,0 +1,88 @@
+// Package keyprovider provides signer keys which are not stored in config,
+// such as encrypted keystore files, PKCS#11 (HSM) tokens and remote signers.
+package keyprovider
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+)
+
+// key provider types
+const (
+	TypeKeystore = "keystore"
+	TypePKCS11   = "pkcs11"
+	TypeRemote   = "remote"
+)
+
+var (
+	errKeyNotFound       = errors.New("key provider has no key of sign pubkey")
+	errWrongSignPubkey   = errors.New("wrong sign pubkey")
+	errWrongMsgHash      = errors.New("wrong msg hash")
+	errWrongSignature    = errors.New("wrong signature")
+	errPKCS11NotCompiled = errors.New("pkcs11 key provider is not compiled in (build with '-tags pkcs11')")
+)
+
+// KeyProvider sign msg hash with the key of the specified public key.
+// EC signature is in the [R || S || V] format where V is 0 or 1,
+// ED signature is the 64 bytes ed25519 signature.
+type KeyProvider interface {
+	Sign(signType, signPubkey string, msgHash []byte) (signature []byte, err error)
+}
+
+// NewKeyProvider new key provider of config
+func NewKeyProvider(cfg *params.KeyProviderConfig) (KeyProvider, error) {
+	switch cfg.Type {
+	case TypeKeystore:
+		return NewKeystoreProvider(cfg.Keystores)
+	case TypePKCS11:
+		return NewPKCS11Provider(cfg.PKCS11)
+	case TypeRemote:
+		return NewRemoteSigner(cfg.RemoteSigner)
+	default:
+		return nil, fmt.Errorf("unknown key provider type '%v'", cfg.Type)
+	}
+}
+
+// IsEC is EC sign type
+func IsEC(signType string) bool {
+	return strings.HasPrefix(signType, "EC")
+}
+
+// NormalizePubkey normalize sign pubkey to the hex string of
+// 65 bytes uncompressed EC pubkey or 32 bytes ED pubkey.
+func NormalizePubkey(signType, signPubkey string) (string, error) {
+	pubkey := common.FromHex(signPubkey)
+	if IsEC(signType) {
+		if len(pubkey) == 33 {
+			pub, err := crypto.DecompressPubkey(pubkey)
+			if err != nil {
+				return "", err
+			}
+			pubkey = crypto.FromECDSAPub(pub)
+		}
+		if len(pubkey) != 65 || pubkey[0] != 4 {
+			return "", errWrongSignPubkey
+		}
+	} else {
+		if len(pubkey) == 33 && pubkey[0] == 0xED {
+			pubkey = pubkey[1:]
+		}
+		if len(pubkey) != 32 {
+			return "", errWrongSignPubkey
+		}
+	}
+	return hex.EncodeToString(pubkey), nil
+}
+
+func checkMsgHash(signType string, msgHash []byte) error {
+	if len(msgHash) == 0 || (IsEC(signType) && len(msgHash) != 32) {
+		return errWrongMsgHash
+	}
+	return nil
+}
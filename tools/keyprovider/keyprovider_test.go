@@ -0,0 +1,124 @@
+package keyprovider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+	"github.com/deltaswapio/swaprouter/v3/tools/keystore"
+	"github.com/pborman/uuid"
+)
+
+const (
+	testPassword  = "password"
+	testAuthToken = "token"
+)
+
+var testMsgHash = common.FromHex("0xce0677bb30baa8cf067c88db9811f4333d131bf8bcf12fe7065d211dce971008")
+
+func writeReadOnlyFile(t *testing.T, file string, data []byte) {
+	if err := os.WriteFile(file, data, 0o400); err != nil {
+		t.Fatalf("write file error: %v", err)
+	}
+}
+
+// newTestKeystoreProvider returns provider and pubkeys of EC and ED keys
+func newTestKeystoreProvider(t *testing.T) (provider *KeystoreProvider, ecPubkey, edPubkey string) {
+	dir := t.TempDir()
+
+	ecKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate EC key error: %v", err)
+	}
+	ecKeyJSON, err := keystore.EncryptKey(&keystore.Key{
+		ID:         uuid.NewRandom(),
+		Address:    crypto.PubkeyToAddress(ecKey.PublicKey),
+		PrivateKey: ecKey,
+	}, testPassword, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("encrypt EC key error: %v", err)
+	}
+
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ED key error: %v", err)
+	}
+	edKeyJSON, err := EncryptED25519Key(edKey, testPassword, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("encrypt ED key error: %v", err)
+	}
+
+	passFile := filepath.Join(dir, "password")
+	writeReadOnlyFile(t, passFile, []byte(testPassword))
+	writeReadOnlyFile(t, filepath.Join(dir, "ec.json"), ecKeyJSON)
+	writeReadOnlyFile(t, filepath.Join(dir, "ed.json"), edKeyJSON)
+
+	provider, err = NewKeystoreProvider(map[string]*params.KeystoreConfig{
+		"1":         {KeystoreFile: filepath.Join(dir, "ec.json"), PasswordFile: passFile},
+		"245022934": {KeystoreFile: filepath.Join(dir, "ed.json"), PasswordFile: passFile},
+	})
+	if err != nil {
+		t.Fatalf("new keystore provider error: %v", err)
+	}
+	ecPubkey = common.ToHex(crypto.FromECDSAPub(&ecKey.PublicKey))
+	edPubkey = hex.EncodeToString(edPub)
+	return provider, ecPubkey, edPubkey
+}
+
+func testSign(t *testing.T, provider KeyProvider, ecPubkey, edPubkey string) {
+	sig, err := provider.Sign("EC256K1", ecPubkey, testMsgHash)
+	if err != nil {
+		t.Fatalf("EC sign error: %v", err)
+	}
+	recovered, err := crypto.Ecrecover(testMsgHash, sig)
+	if err != nil || common.ToHex(recovered) != ecPubkey {
+		t.Errorf("EC signature mismatch, err: %v", err)
+	}
+
+	sig, err = provider.Sign("ED25519", "0xED"+edPubkey, testMsgHash)
+	if err != nil {
+		t.Fatalf("ED sign error: %v", err)
+	}
+	if !ed25519.Verify(common.FromHex(edPubkey), testMsgHash, sig) {
+		t.Errorf("ED signature mismatch")
+	}
+
+	otherKey, _ := crypto.GenerateKey()
+	otherPubkey := common.ToHex(crypto.FromECDSAPub(&otherKey.PublicKey))
+	if _, err = provider.Sign("EC256K1", otherPubkey, testMsgHash); err == nil {
+		t.Errorf("sign with unknown key should fail")
+	}
+}
+
+func TestKeystoreProvider(t *testing.T) {
+	provider, ecPubkey, edPubkey := newTestKeystoreProvider(t)
+	testSign(t, provider, ecPubkey, edPubkey)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	provider, ecPubkey, edPubkey := newTestKeystoreProvider(t)
+
+	server := httptest.NewServer(NewRemoteSignerHandler(provider, testAuthToken))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeReadOnlyFile(t, tokenFile, []byte(testAuthToken))
+
+	signer, err := NewRemoteSigner(&params.RemoteSignerConfig{URL: server.URL, AuthTokenFile: tokenFile})
+	if err != nil {
+		t.Fatalf("new remote signer error: %v", err)
+	}
+	testSign(t, signer, ecPubkey, edPubkey)
+
+	unauthorized, _ := NewRemoteSigner(&params.RemoteSignerConfig{URL: server.URL})
+	if _, err = unauthorized.Sign("EC256K1", ecPubkey, testMsgHash); err == nil {
+		t.Errorf("sign without auth token should fail")
+	}
+}
@@ -0,0 +1,129 @@
+package keyprovider
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+	"github.com/deltaswapio/swaprouter/v3/tools/keystore"
+)
+
+const keyTypeED25519 = "ed25519"
+
+// encryptedED25519KeyJSON ed25519 keystore file (the seed is encrypted)
+type encryptedED25519KeyJSON struct {
+	Type      string              `json:"type"`
+	PublicKey string              `json:"publickey"`
+	Crypto    keystore.CryptoJSON `json:"crypto"`
+}
+
+// KeystoreProvider sign with keys in encrypted keystore files
+type KeystoreProvider struct {
+	ecKeys map[string]*ecdsa.PrivateKey // key is normalized pubkey
+	edKeys map[string]ed25519.PrivateKey
+}
+
+// NewKeystoreProvider load encrypted keystore files (key is chain ID)
+func NewKeystoreProvider(keystores map[string]*params.KeystoreConfig) (*KeystoreProvider, error) {
+	p := &KeystoreProvider{
+		ecKeys: make(map[string]*ecdsa.PrivateKey),
+		edKeys: make(map[string]ed25519.PrivateKey),
+	}
+	for chainID, ks := range keystores {
+		pubkey, err := p.loadKeystore(ks.KeystoreFile, ks.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("load keystore of chain %v failed: %w", chainID, err)
+		}
+		log.Info("key provider load keystore success", "chainID", chainID, "pubkey", pubkey)
+	}
+	return p, nil
+}
+
+func (p *KeystoreProvider) loadKeystore(keyfile, passfile string) (pubkey string, err error) {
+	keyjson, err := tools.SafeReadFile(keyfile)
+	if err != nil {
+		return "", fmt.Errorf("read keystore fail %w", err)
+	}
+	passdata, err := tools.SafeReadFile(passfile)
+	if err != nil {
+		return "", fmt.Errorf("read password fail %w", err)
+	}
+	passwd := strings.TrimSpace(string(passdata))
+
+	var edKeyJSON encryptedED25519KeyJSON
+	if err = json.Unmarshal(keyjson, &edKeyJSON); err == nil && edKeyJSON.Type == keyTypeED25519 {
+		key, errd := decryptED25519Key(&edKeyJSON, passwd)
+		if errd != nil {
+			return "", fmt.Errorf("decrypt key fail %w", errd)
+		}
+		pubkey = hex.EncodeToString(key.Public().(ed25519.PublicKey))
+		p.edKeys[pubkey] = key
+		return pubkey, nil
+	}
+
+	key, err := keystore.DecryptKey(keyjson, passwd)
+	if err != nil {
+		return "", fmt.Errorf("decrypt key fail %w", err)
+	}
+	pubkey = hex.EncodeToString(crypto.FromECDSAPub(&key.PrivateKey.PublicKey))
+	p.ecKeys[pubkey] = key.PrivateKey
+	return pubkey, nil
+}
+
+// Sign impl KeyProvider
+func (p *KeystoreProvider) Sign(signType, signPubkey string, msgHash []byte) ([]byte, error) {
+	if err := checkMsgHash(signType, msgHash); err != nil {
+		return nil, err
+	}
+	pubkey, err := NormalizePubkey(signType, signPubkey)
+	if err != nil {
+		return nil, err
+	}
+	if IsEC(signType) {
+		key, exist := p.ecKeys[pubkey]
+		if !exist {
+			return nil, errKeyNotFound
+		}
+		return crypto.Sign(msgHash, key)
+	}
+	key, exist := p.edKeys[pubkey]
+	if !exist {
+		return nil, errKeyNotFound
+	}
+	return ed25519.Sign(key, msgHash), nil
+}
+
+// EncryptED25519Key encrypt ed25519 key into a json blob
+func EncryptED25519Key(key ed25519.PrivateKey, auth string, scryptN, scryptP int) ([]byte, error) {
+	cryptoStruct, err := keystore.EncryptDataV3(key.Seed(), []byte(auth), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&encryptedED25519KeyJSON{
+		Type:      keyTypeED25519,
+		PublicKey: hex.EncodeToString(key.Public().(ed25519.PublicKey)),
+		Crypto:    cryptoStruct,
+	})
+}
+
+func decryptED25519Key(keyJSON *encryptedED25519KeyJSON, auth string) (ed25519.PrivateKey, error) {
+	seed, err := keystore.DecryptDataV3(&keyJSON.Crypto, auth)
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, keystore.ErrDecrypt
+	}
+	key := ed25519.NewKeyFromSeed(seed)
+	if !strings.EqualFold(hex.EncodeToString(key.Public().(ed25519.PublicKey)), keyJSON.PublicKey) {
+		return nil, keystore.ErrDecrypt
+	}
+	return key, nil
+}
@@ -0,0 +1,218 @@
+//go:build pkcs11
+
+package keyprovider
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+	"github.com/miekg/pkcs11"
+)
+
+// not defined in older pkcs11 headers
+const (
+	ckmEDDSA = 0x00001057
+)
+
+var secp256k1N = crypto.S256().Params().N
+
+type pkcs11Key struct {
+	isEC   bool
+	handle pkcs11.ObjectHandle
+}
+
+// PKCS11Provider sign with keys in PKCS#11 token (HSM)
+type PKCS11Provider struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	keys    map[string]*pkcs11Key // key is normalized pubkey
+
+	// pkcs11 session is not safe for concurrent use
+	lock sync.Mutex
+}
+
+// NewPKCS11Provider new pkcs11 key provider
+func NewPKCS11Provider(cfg *params.PKCS11Config) (KeyProvider, error) {
+	pin := ""
+	if cfg.PinFile != "" {
+		data, err := tools.SafeReadFile(cfg.PinFile)
+		if err != nil {
+			return nil, fmt.Errorf("read pin fail %w", err)
+		}
+		pin = strings.TrimSpace(string(data))
+	}
+
+	ctx := pkcs11.New(cfg.ModulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("load pkcs11 module %v failed", cfg.ModulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, err
+	}
+	slot, err := findSlotByTokenLabel(ctx, cfg.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, err
+	}
+	if pin != "" {
+		if err = ctx.Login(session, pkcs11.CKU_USER, pin); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &PKCS11Provider{
+		ctx:     ctx,
+		session: session,
+		keys:    make(map[string]*pkcs11Key),
+	}
+	for _, label := range cfg.KeyLabels {
+		pubkey, errl := p.loadKey(label)
+		if errl != nil {
+			return nil, fmt.Errorf("load pkcs11 key %v failed: %w", label, errl)
+		}
+		log.Info("key provider load pkcs11 key success", "label", label, "pubkey", pubkey)
+	}
+	return p, nil
+}
+
+func findSlotByTokenLabel(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(info.Label) == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("pkcs11 token %v not found", tokenLabel)
+}
+
+func (p *PKCS11Provider) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := p.ctx.FindObjectsInit(p.session, template); err != nil {
+		return 0, err
+	}
+	objs, _, err := p.ctx.FindObjects(p.session, 1)
+	_ = p.ctx.FindObjectsFinal(p.session)
+	if err != nil {
+		return 0, err
+	}
+	if len(objs) == 0 {
+		return 0, fmt.Errorf("object not found")
+	}
+	return objs[0], nil
+}
+
+func (p *PKCS11Provider) loadKey(label string) (pubkey string, err error) {
+	pubObj, err := p.findObject(pkcs11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return "", err
+	}
+	privObj, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return "", err
+	}
+	attrs, err := p.ctx.GetAttributeValue(p.session, pubObj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(attrs) == 0 {
+		return "", fmt.Errorf("no ec point attribute")
+	}
+	point := unwrapECPoint(attrs[0].Value)
+	key := &pkcs11Key{handle: privObj}
+	switch len(point) {
+	case 65:
+		key.isEC = true
+	case 32:
+	default:
+		return "", errWrongSignPubkey
+	}
+	pubkey = hex.EncodeToString(point)
+	p.keys[pubkey] = key
+	return pubkey, nil
+}
+
+// unwrapECPoint unwrap the DER encoded octet string of CKA_EC_POINT
+func unwrapECPoint(value []byte) []byte {
+	if len(value) > 2 && value[0] == 0x04 && int(value[1]) == len(value)-2 {
+		return value[2:]
+	}
+	return value
+}
+
+// Sign impl KeyProvider
+func (p *PKCS11Provider) Sign(signType, signPubkey string, msgHash []byte) ([]byte, error) {
+	if err := checkMsgHash(signType, msgHash); err != nil {
+		return nil, err
+	}
+	pubkey, err := NormalizePubkey(signType, signPubkey)
+	if err != nil {
+		return nil, err
+	}
+	key, exist := p.keys[pubkey]
+	if !exist || key.isEC != IsEC(signType) {
+		return nil, errKeyNotFound
+	}
+
+	mechanism := pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	if !key.isEC {
+		mechanism = pkcs11.NewMechanism(ckmEDDSA, nil)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if err = p.ctx.SignInit(p.session, []*pkcs11.Mechanism{mechanism}, key.handle); err != nil {
+		return nil, err
+	}
+	signature, err := p.ctx.Sign(p.session, msgHash)
+	if err != nil {
+		return nil, err
+	}
+	if !key.isEC {
+		return signature, nil
+	}
+	return toRecoverableSignature(pubkey, msgHash, signature)
+}
+
+// toRecoverableSignature convert [R || S] to [R || S || V] with low S
+func toRecoverableSignature(pubkey string, msgHash, signature []byte) ([]byte, error) {
+	if len(signature) != 64 {
+		return nil, errWrongSignature
+	}
+	s := new(big.Int).SetBytes(signature[32:])
+	if s.Cmp(new(big.Int).Rsh(secp256k1N, 1)) > 0 {
+		s.Sub(secp256k1N, s)
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], signature[:32])
+	s.FillBytes(sig[32:64])
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		if verifySignature("EC256K1", pubkey, msgHash, sig) == nil {
+			return sig, nil
+		}
+	}
+	return nil, errWrongSignature
+}
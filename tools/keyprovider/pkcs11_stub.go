@@ -0,0 +1,13 @@
+//go:build !pkcs11
+
+package keyprovider
+
+import (
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+// NewPKCS11Provider pkcs11 key provider needs cgo and the pkcs11 library,
+// build with '-tags pkcs11' to enable it.
+func NewPKCS11Provider(cfg *params.PKCS11Config) (KeyProvider, error) {
+	return nil, errPKCS11NotCompiled
+}
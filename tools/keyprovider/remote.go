@@ -0,0 +1,153 @@
+package keyprovider
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tools"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+)
+
+const defaultRemoteSignTimeout = 10 // seconds
+
+// SignRequest remote sign request
+type SignRequest struct {
+	SignType   string        `json:"signType"`
+	SignPubkey string        `json:"signPubkey"`
+	MsgHash    hexutil.Bytes `json:"msgHash"`
+}
+
+// SignResponse remote sign response
+type SignResponse struct {
+	Signature hexutil.Bytes `json:"signature,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// RemoteSigner sign by a remote signer over HTTP
+type RemoteSigner struct {
+	url       string
+	authToken string
+	timeout   int
+}
+
+// NewRemoteSigner new remote signer
+func NewRemoteSigner(cfg *params.RemoteSignerConfig) (*RemoteSigner, error) {
+	s := &RemoteSigner{
+		url:     cfg.URL,
+		timeout: cfg.Timeout,
+	}
+	if s.timeout <= 0 {
+		s.timeout = defaultRemoteSignTimeout
+	}
+	if cfg.AuthTokenFile != "" {
+		data, err := tools.SafeReadFile(cfg.AuthTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("read auth token fail %w", err)
+		}
+		s.authToken = strings.TrimSpace(string(data))
+	}
+	log.Info("init remote signer success", "url", s.url, "timeout", s.timeout)
+	return s, nil
+}
+
+// Sign impl KeyProvider
+func (s *RemoteSigner) Sign(signType, signPubkey string, msgHash []byte) ([]byte, error) {
+	if err := checkMsgHash(signType, msgHash); err != nil {
+		return nil, err
+	}
+	pubkey, err := NormalizePubkey(signType, signPubkey)
+	if err != nil {
+		return nil, err
+	}
+	req := &SignRequest{
+		SignType:   signType,
+		SignPubkey: pubkey,
+		MsgHash:    msgHash,
+	}
+	var headers map[string]string
+	if s.authToken != "" {
+		headers = map[string]string{"Authorization": "Bearer " + s.authToken}
+	}
+	resp, err := client.HTTPPost(s.url, req, nil, headers, s.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var result SignResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("remote signer response error (status %v): %w", resp.StatusCode, err)
+	}
+	if result.Error != "" || resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer error (status %v): %v", resp.StatusCode, result.Error)
+	}
+	if err = verifySignature(signType, pubkey, msgHash, result.Signature); err != nil {
+		return nil, err
+	}
+	return result.Signature, nil
+}
+
+// verifySignature do not trust the remote signer
+func verifySignature(signType, pubkey string, msgHash, signature []byte) error {
+	pubkeyBytes, _ := hex.DecodeString(pubkey)
+	if IsEC(signType) {
+		if len(signature) != crypto.SignatureLength {
+			return errWrongSignature
+		}
+		recovered, err := crypto.Ecrecover(msgHash, signature)
+		if err != nil || !bytes.Equal(recovered, pubkeyBytes) {
+			return errWrongSignature
+		}
+		return nil
+	}
+	if len(signature) != ed25519.SignatureSize ||
+		!ed25519.Verify(ed25519.PublicKey(pubkeyBytes), msgHash, signature) {
+		return errWrongSignature
+	}
+	return nil
+}
+
+// NewRemoteSignerHandler new http handler which serves remote sign requests
+// with the keys of the key provider (used as a local stand-in remote signer)
+func NewRemoteSignerHandler(provider KeyProvider, authToken string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResponse := func(status int, resp *SignResponse) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(resp)
+		}
+		if r.Method != http.MethodPost {
+			writeResponse(http.StatusMethodNotAllowed, &SignResponse{Error: "method not allowed"})
+			return
+		}
+		if authToken != "" && r.Header.Get("Authorization") != "Bearer "+authToken {
+			writeResponse(http.StatusUnauthorized, &SignResponse{Error: "unauthorized"})
+			return
+		}
+		var req SignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeResponse(http.StatusBadRequest, &SignResponse{Error: err.Error()})
+			return
+		}
+		signature, err := provider.Sign(req.SignType, req.SignPubkey, req.MsgHash)
+		if err != nil {
+			writeResponse(http.StatusBadRequest, &SignResponse{Error: err.Error()})
+			return
+		}
+		writeResponse(http.StatusOK, &SignResponse{Signature: signature})
+	})
+}
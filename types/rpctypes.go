@@ -33,6 +33,7 @@ type RPCBlock struct {
 	GasUsed      *hexutil.Uint64 `json:"gasUsed"`
 	Time         *hexutil.Big    `json:"timestamp"`
 	BaseFee      *hexutil.Big    `json:"baseFeePerGas"`
+	ReceiptsRoot *common.Hash    `json:"receiptsRoot"`
 	Transactions []*common.Hash  `json:"transactions"`
 }
 
@@ -93,6 +94,8 @@ type RPCLog struct {
 	Topics  []common.Hash   `json:"topics"`
 	Data    *hexutil.Bytes  `json:"data"`
 	Removed *bool           `json:"removed"`
+
+	TxHash *common.Hash `json:"transactionHash,omitempty"`
 }
 
 // RPCTxReceipt struct
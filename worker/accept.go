@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
 	"github.com/deltaswapio/swaprouter/v3/mpc"
 	"github.com/deltaswapio/swaprouter/v3/params"
 	"github.com/deltaswapio/swaprouter/v3/router"
@@ -38,6 +40,12 @@ var (
 	errIdentifierMismatch = errors.New("cross chain bridge identifier mismatch")
 	errInitiatorMismatch  = errors.New("initiator mismatch")
 	errWrongMsgContext    = errors.New("wrong msg context")
+
+	errGasDropWithoutAcceptRecord = errors.New("gas drop requires accept records")
+	errAcceptRecordNotSupported   = errors.New("accept record is not supported")
+
+	// serialize the check and save of gas drop accept records
+	gasDropAcceptLock sync.Mutex
 )
 
 // StartAcceptSignJob accept job
@@ -274,6 +282,11 @@ func processAcceptInfo(mpcConfig *mpc.Config, info *mpc.SignInfoData) error {
 	} else {
 		logWorker("accept", "accept sign finish", ctx...)
 		isProcessed = true
+		if agreeResult == acceptAgree {
+			atomic.AddUint64(&acceptAgreeCount, 1)
+		} else {
+			atomic.AddUint64(&acceptDisagreeCount, 1)
+		}
 	}
 	return err
 }
@@ -309,6 +322,15 @@ func verifySignInfo(mpcConfig *mpc.Config, signInfo *mpc.SignInfoData) (*tokens.
 		}
 		return args, nil
 	}
+	if args.IsGasDrop() {
+		// a gas drop is a plain transfer without swap id on chain,
+		// so the accept record is the only guard against double sending
+		if lvldbHandle == nil {
+			return args, errGasDropWithoutAcceptRecord
+		}
+		gasDropAcceptLock.Lock()
+		defer gasDropAcceptLock.Unlock()
+	}
 	if lvldbHandle != nil && args.GetTxNonce() > 0 { // only for eth like chain
 		err = CheckAcceptRecord(args)
 		if err != nil {
@@ -350,6 +372,13 @@ func rebuildAndVerifyMsgHash(keyID string, msgHash []string, args *tokens.BuildT
 		"tokenID", args.GetTokenID(),
 	}
 
+	if args.IsRefund() {
+		return rebuildAndVerifyRefundMsgHash(keyID, msgHash, args, srcBridge, ctx)
+	}
+	if args.IsGasDrop() {
+		return rebuildAndVerifyGasDropMsgHash(keyID, msgHash, args, srcBridge, dstBridge, ctx)
+	}
+
 	txid := args.SwapID
 	logIndex := args.LogIndex
 	verifyArgs := &tokens.VerifyArgs{
@@ -414,25 +443,185 @@ func rebuildAndVerifyMsgHash(keyID string, msgHash []string, args *tokens.BuildT
 	return nil
 }
 
-func saveAcceptRecord(bridge tokens.IBridge, keyID string, args *tokens.BuildTxArgs, rawTx interface{}, ctx []interface{}) {
+// rebuildAndVerifyRefundMsgHash the swap must be undeliverable for the same reason,
+// and the refund must pay back to the sender on the source chain.
+func rebuildAndVerifyRefundMsgHash(keyID string, msgHash []string, args *tokens.BuildTxArgs, srcBridge tokens.IBridge, ctx []interface{}) (err error) {
+	refundCfg := params.GetRefundConfig()
+	if refundCfg == nil {
+		return tokens.ErrRefundNotAllowed
+	}
+	builder, ok := srcBridge.(tokens.IRefundBuilder)
+	if !ok {
+		return tokens.ErrRefundNotSupported
+	}
+	if args.FromChainID.Cmp(args.ToChainID) != 0 {
+		return fmt.Errorf("refund chainID mismatch: '%v' != '%v'", args.FromChainID, args.ToChainID)
+	}
+
+	start := time.Now()
+	verifyArgs := &tokens.VerifyArgs{
+		SwapType:      args.SwapType,
+		LogIndex:      args.LogIndex,
+		AllowUnstable: false,
+	}
+	swapInfo, err := srcBridge.VerifyTransaction(args.SwapID, verifyArgs)
+	switch {
+	case err == nil:
+		// swaps made fail by admin are payable and oracles can not
+		// check whether they are paid by pending or old swap txs,
+		// so that they are refunded only with the approval of admin.
+		if !isManualMakeFailRefund(args.Refund.Reason) {
+			return fmt.Errorf("%w: swap is verified ok", tokens.ErrRefundNotAllowed)
+		}
+		err = VerifyManualRefundApproval(args.Refund.Approval, args.FromChainID.String(), args.SwapID, args.LogIndex)
+		if err != nil {
+			logWorkerError("accept", "verify manual refund approval failed", err, ctx...)
+			return err
+		}
+	case tokens.IsRefundableError(err):
+		if reason := mongodb.GetRouterSwapStatusByVerifyError(err).String(); reason != args.Refund.Reason {
+			return fmt.Errorf("refund reason mismatch: '%v' != '%v'", args.Refund.Reason, reason)
+		}
+	default:
+		logWorkerError("accept", "verify refund failed", err, ctx...)
+		return err
+	}
+	if swapInfo == nil || swapInfo.ERC20SwapInfo == nil {
+		return tokens.ErrRefundNotSupported
+	}
+	logWorker("accept", fmt.Sprintf("verify refund success (timespent %v)", time.Since(start).String()), ctx...)
+	if !strings.EqualFold(args.Bind, swapInfo.From) {
+		return fmt.Errorf("refund receiver mismatch: '%v' != '%v'", args.Bind, swapInfo.From)
+	}
+	fee := tokens.CalcRefundFee(swapInfo.Value)
+	if args.Refund.Fee == nil || args.Refund.Fee.Cmp(fee) != 0 {
+		return fmt.Errorf("refund fee mismatch: '%v' != '%v'", args.Refund.Fee, fee)
+	}
+
+	start = time.Now()
+	buildTxArgs := &tokens.BuildTxArgs{
+		SwapArgs: tokens.SwapArgs{
+			SwapInfo:    swapInfo.SwapInfo,
+			Identifier:  params.GetIdentifier(),
+			SwapID:      swapInfo.Hash,
+			SwapType:    swapInfo.SwapType,
+			Bind:        swapInfo.From,
+			LogIndex:    swapInfo.LogIndex,
+			FromChainID: swapInfo.FromChainID,
+			ToChainID:   swapInfo.FromChainID,
+			Refund: &tokens.RefundInfo{
+				Reason:   args.Refund.Reason,
+				Fee:      fee,
+				Approval: args.Refund.Approval,
+			},
+		},
+		From:        args.From,
+		OriginFrom:  swapInfo.From,
+		OriginTxTo:  swapInfo.TxTo,
+		OriginValue: swapInfo.Value,
+		Extra:       args.Extra,
+	}
+	rawTx, err := builder.BuildRefundTransaction(buildTxArgs)
+	if err != nil {
+		logWorkerError("accept", fmt.Sprintf("build refund tx failed (timespent %v)", time.Since(start).String()), err, ctx...)
+		return err
+	}
+	err = srcBridge.VerifyMsgHash(rawTx, msgHash)
+	if err != nil {
+		logWorkerError("accept", fmt.Sprintf("verify refund message hash failed (timespent %v)", time.Since(start).String()), err, ctx...)
+		return err
+	}
+	logWorker("accept", fmt.Sprintf("build refund tx and verify message hash success (timespent %v)", time.Since(start).String()), ctx...)
+	if lvldbHandle != nil && args.GetTxNonce() > 0 { // only for eth like chain
+		go saveAcceptRecord(srcBridge, keyID, buildTxArgs, rawTx, ctx)
+	}
+	return nil
+}
+
+// rebuildAndVerifyGasDropMsgHash the gas drop must be requested by the swapout,
+// and the amount is recalculated by the dest bridge with the caps of dest chain.
+func rebuildAndVerifyGasDropMsgHash(keyID string, msgHash []string, args *tokens.BuildTxArgs, srcBridge, dstBridge tokens.IBridge, ctx []interface{}) (err error) {
+	builder, ok := dstBridge.(tokens.IGasDropBuilder)
+	if !ok {
+		return tokens.ErrGasDropNotSupported
+	}
+
+	start := time.Now()
+	verifyArgs := &tokens.VerifyArgs{
+		SwapType:      args.SwapType,
+		LogIndex:      args.LogIndex,
+		AllowUnstable: false,
+	}
+	swapInfo, err := srcBridge.VerifyTransaction(args.SwapID, verifyArgs)
+	if err != nil {
+		logWorkerError("accept", "verify gas drop failed", err, ctx...)
+		return err
+	}
+	if swapInfo.ERC20SwapInfo == nil || tokens.GetGasDropRequest(swapInfo.ERC20SwapInfo) == nil {
+		return tokens.ErrGasDropNotSupported
+	}
+	logWorker("accept", fmt.Sprintf("verify gas drop success (timespent %v)", time.Since(start).String()), ctx...)
+	if !strings.EqualFold(args.Bind, swapInfo.Bind) {
+		return fmt.Errorf("bind mismatch: '%v' != '%v'", args.Bind, swapInfo.Bind)
+	}
+	if args.ToChainID.Cmp(swapInfo.ToChainID) != 0 {
+		return fmt.Errorf("toChainID mismatch: '%v' != '%v'", args.ToChainID, swapInfo.ToChainID)
+	}
+
+	start = time.Now()
+	buildTxArgs := &tokens.BuildTxArgs{
+		SwapArgs: tokens.SwapArgs{
+			SwapInfo:    swapInfo.SwapInfo,
+			Identifier:  params.GetIdentifier(),
+			SwapID:      swapInfo.Hash,
+			SwapType:    swapInfo.SwapType,
+			Bind:        swapInfo.Bind,
+			LogIndex:    swapInfo.LogIndex,
+			FromChainID: swapInfo.FromChainID,
+			ToChainID:   swapInfo.ToChainID,
+			GasDrop:     args.GasDrop,
+		},
+		From:        args.From,
+		OriginFrom:  swapInfo.From,
+		OriginTxTo:  swapInfo.TxTo,
+		OriginValue: swapInfo.Value,
+		Extra:       args.Extra,
+	}
+	rawTx, err := builder.BuildGasDropTransaction(buildTxArgs)
+	if err != nil {
+		logWorkerError("accept", fmt.Sprintf("build gas drop tx failed (timespent %v)", time.Since(start).String()), err, ctx...)
+		return err
+	}
+	err = dstBridge.VerifyMsgHash(rawTx, msgHash)
+	if err != nil {
+		logWorkerError("accept", fmt.Sprintf("verify gas drop message hash failed (timespent %v)", time.Since(start).String()), err, ctx...)
+		return err
+	}
+	logWorker("accept", fmt.Sprintf("build gas drop tx and verify message hash success (timespent %v)", time.Since(start).String()), ctx...)
+	// save synchronously before agreeing, the record must exist for the next check
+	return saveAcceptRecord(dstBridge, keyID, buildTxArgs, rawTx, ctx)
+}
+
+func saveAcceptRecord(bridge tokens.IBridge, keyID string, args *tokens.BuildTxArgs, rawTx interface{}, ctx []interface{}) error {
 	impl, ok := bridge.(interface {
 		GetSignedTxHashOfKeyID(sender, keyID string, rawTx interface{}) (txHash string, err error)
 	})
 	if !ok {
-		return
+		return errAcceptRecordNotSupported
 	}
 
 	swapTx, err := impl.GetSignedTxHashOfKeyID(args.From, keyID, rawTx)
 	if err != nil {
 		logWorkerError("accept", "get signed tx hash failed", err, ctx...)
-		return
+		return err
 	}
 	ctx = append(ctx, "swaptx", swapTx)
 
 	err = AddAcceptRecord(args, swapTx)
 	if err != nil {
 		logWorkerError("accept", "save accept record to db failed", err, ctx...)
-		return
+		return err
 	}
 	logWorker("accept", "save accept record to db success", ctx...)
+	return nil
 }
@@ -24,6 +24,14 @@ var (
 )
 
 func getSwapKeyPrefix(args *tokens.BuildTxArgs) string {
+	if args.IsRefund() {
+		return strings.ToLower(fmt.Sprintf("refund:%s:%d:%s:%d:",
+			args.SwapID, args.LogIndex, args.FromChainID.String(), args.SwapType))
+	}
+	if args.IsGasDrop() {
+		return strings.ToLower(fmt.Sprintf("gasdrop:%s:%d:%s:%d:",
+			args.SwapID, args.LogIndex, args.FromChainID.String(), args.SwapType))
+	}
 	return strings.ToLower(fmt.Sprintf("%s:%d:%s:%d:",
 		args.SwapID, args.LogIndex, args.FromChainID.String(), args.SwapType))
 }
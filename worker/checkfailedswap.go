@@ -59,9 +59,15 @@ func checkFailedRouterSwap(swap *mongodb.MgoSwapResult) error {
 	}
 	nonceSetter, ok := resBridge.(tokens.NonceSetter)
 	if !ok {
+		if isFailureClassifyEnabled() {
+			txStatus := getSwapTxStatus(resBridge, swap)
+			if txStatus != nil && txStatus.IsSwapTxOnChainAndFailed() {
+				return classifyFailedSwap(resBridge, swap, nil)
+			}
+		}
 		return nil
 	}
-	routerMPC, err := router.GetRouterMPC(swap.GetTokenID(), swap.ToChainID)
+	routerMPC, err := router.GetRouterMPC(tokens.SwapType(swap.SwapType), swap.GetTokenID(), swap.ToChainID)
 	if err != nil {
 		return nil
 	}
@@ -71,7 +77,7 @@ func checkFailedRouterSwap(swap *mongodb.MgoSwapResult) error {
 
 	txStatus := getSwapTxStatus(resBridge, swap)
 	if txStatus != nil && txStatus.IsSwapTxOnChainAndFailed() {
-		return nil
+		return classifyFailedSwap(resBridge, swap, nil)
 	}
 
 	if txStatus != nil && txStatus.BlockHeight > 0 {
@@ -99,5 +105,9 @@ func checkFailedRouterSwap(swap *mongodb.MgoSwapResult) error {
 		return markSwapResultUnstable(swap.FromChainID, swap.TxID, swap.LogIndex)
 	}
 
-	return nil
+	// the swap nonce is used by other tx
+	return classifyFailedSwap(resBridge, swap, &tokens.TxFailure{
+		Category: tokens.FailureNonceConflict,
+		Reason:   fmt.Sprintf("swap nonce %v is passed, latest nonce %v", swap.SwapNonce, nonce),
+	})
 }
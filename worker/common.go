@@ -53,6 +53,7 @@ func AddInitialSwapResult(swapInfo *tokens.SwapTxInfo, status mongodb.SwapStatus
 		logWorkerError("add", "addInitialSwapResult failed", err, "chainid", swapInfo.FromChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex)
 	} else {
 		logWorker("add", "addInitialSwapResult success", "chainid", swapInfo.FromChainID, "txid", swapInfo.Hash, "logIndex", swapInfo.LogIndex)
+		notifySwapStatus(swapResult.FromChainID, swapResult.TxID, swapResult.LogIndex)
 	}
 	return err
 }
@@ -96,6 +97,7 @@ func updateRouterSwapResult(fromChainID, txid string, logIndex int, mtx *MatchTx
 			"swaptx", mtx.SwapTx, "swapheight", mtx.SwapHeight,
 			"swaptime", mtx.SwapTime, "swapvalue", mtx.SwapValue,
 			"swapnonce", mtx.SwapNonce)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -111,6 +113,7 @@ func updateSwapMemo(fromChainID, txid string, logIndex int, memo string) (err er
 		logWorkerError("update", "updateSwapMemo failed", err, "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "memo", memo)
 	} else {
 		logWorker("update", "updateSwapMemo success", "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "memo", memo)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -140,6 +143,7 @@ func updateSwapTx(fromChainID, txid string, logIndex int, swapTx string) (err er
 		logWorkerError("update", "updateSwapTx failed", err, "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "swaptx", swapTx)
 	} else {
 		logWorker("update", "updateSwapTx success", "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "swaptx", swapTx)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -153,6 +157,7 @@ func markSwapResultUnstable(fromChainID, txid string, logIndex int) (err error)
 		logWorkerError("checkfailedswap", "markSwapResultUnstable failed", err, "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
 	} else {
 		logWorker("checkfailedswap", "markSwapResultUnstable success", "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -166,6 +171,7 @@ func markSwapResultStable(fromChainID, txid string, logIndex int) (err error) {
 		logWorkerError("stable", "markSwapResultStable failed", err, "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
 	} else {
 		logWorker("stable", "markSwapResultStable success", "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -179,6 +185,7 @@ func markSwapResultFailed(fromChainID, txid string, logIndex int) (err error) {
 		logWorkerError("stable", "markSwapResultFailed failed", err, "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
 	} else {
 		logWorker("stable", "markSwapResultFailed success", "chainid", fromChainID, "txid", txid, "logIndex", logIndex)
+		notifySwapStatus(fromChainID, txid, logIndex)
 	}
 	return err
 }
@@ -237,7 +244,7 @@ SENDTX_LOOP:
 		}
 	}
 
-	if params.GetRouterServerConfig().SendTxLoopCount[args.ToChainID.String()] >= 0 {
+	if !args.IsRefund() && !args.IsGasDrop() && params.GetRouterServerConfig().SendTxLoopCount[args.ToChainID.String()] >= 0 {
 		go sendTxLoopUntilSuccess(bridge, txHash, signedTx, args)
 	}
 
@@ -13,5 +13,15 @@
 //		replace swap with the same tx nonce value when the sent swaptx is not packed into block because of lack fee or other reasons.
 //	passbigvalue
 //		pass big value swap if the swap value is too large.
+//	liquidity
+//		resume swaps parked for insufficient dest liquidity when liquidity returns.
+//	trustline
+//		resume swaps waiting for the receiver to create trustline (eg. stellar and xrpl).
+//	reorg
+//		watch source txs of paid swaps and alert if they are reorged.
+//	refund
+//		refund swaps which can never be delivered back to the sender on the source chain.
+//	gasdrop
+//		top up native coin to the receiver of stable swaps which request gas drop.
 // Most the above jobs is assigned to the `server` node, the `oracle` node mainly do the `accept` job.
 package worker
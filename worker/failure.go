@@ -0,0 +1,156 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+var (
+	failureCounters     = make(map[tokens.FailureCategory]uint64)
+	failureCountersLock sync.Mutex
+
+	// key is swap key, value is the bumped gas limit used by the next reswap
+	reswapGasLimits = new(sync.Map)
+)
+
+const failureMemoPrefix = "[failure:"
+
+// GetFailureStats get counts of classified failed swaps per category
+func GetFailureStats() map[tokens.FailureCategory]uint64 {
+	failureCountersLock.Lock()
+	defer failureCountersLock.Unlock()
+	stats := make(map[tokens.FailureCategory]uint64, len(tokens.AllFailureCategories))
+	for _, category := range tokens.AllFailureCategories {
+		stats[category] = failureCounters[category]
+	}
+	return stats
+}
+
+func increaseFailureCounter(category tokens.FailureCategory) {
+	failureCountersLock.Lock()
+	defer failureCountersLock.Unlock()
+	failureCounters[category]++
+}
+
+func isFailureClassifyEnabled() bool {
+	serverCfg := params.GetRouterServerConfig()
+	return serverCfg != nil && serverCfg.EnableClassifyFailure
+}
+
+// the memo contains the swap tx, so a failed reswap will be classified again
+func getFailureMemo(swap *mongodb.MgoSwapResult, failure *tokens.TxFailure) string {
+	return fmt.Sprintf("%v%v:%v] %v", failureMemoPrefix, failure.Category, swap.SwapTx, failure.Reason)
+}
+
+func isFailureClassified(swap *mongodb.MgoSwapResult) bool {
+	return strings.HasPrefix(swap.Memo, failureMemoPrefix) && strings.Contains(swap.Memo, ":"+swap.SwapTx+"]")
+}
+
+// classifyFailedSwap classify the failed swap (by the dest bridge if failure is nil),
+// and handle it with the policy configed for the failure category.
+func classifyFailedSwap(resBridge tokens.IBridge, swap *mongodb.MgoSwapResult, failure *tokens.TxFailure) error {
+	if !isFailureClassifyEnabled() || swap.SwapTx == "" || isFailureClassified(swap) {
+		return nil
+	}
+	if failure == nil {
+		classifier, ok := resBridge.(tokens.IFailureClassifier)
+		if ok {
+			var err error
+			failure, err = classifier.ClassifyFailedTx(swap.SwapTx)
+			if err != nil {
+				return err
+			}
+		} else {
+			failure = &tokens.TxFailure{Category: tokens.FailureUnknown, Reason: "no failure classifier"}
+		}
+	}
+	increaseFailureCounter(failure.Category)
+
+	memo := getFailureMemo(swap, failure)
+	policy := params.GetFailurePolicy(string(failure.Category))
+	logWorker("checkfailedswap", "classify failed swap", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID,
+		"txid", swap.TxID, "logIndex", swap.LogIndex, "swaptx", swap.SwapTx, "category", failure.Category, "reason", failure.Reason, "policy", policy)
+
+	var err error
+	switch policy {
+	case params.FailurePolicyReswap:
+		err = autoReswapFailedSwap(swap, failure, memo)
+	case params.FailurePolicyPark:
+		err = parkFailedSwap(resBridge, swap, failure, memo)
+	default:
+		err = fmt.Errorf("failure policy is %v", policy)
+	}
+	if err != nil {
+		logWorkerError("checkfailedswap", "failed swap is escalated to admin", err, "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID,
+			"txid", swap.TxID, "logIndex", swap.LogIndex, "swaptx", swap.SwapTx, "category", failure.Category, "reason", failure.Reason)
+	}
+	// keep the classification and the swap tx in memo
+	return updateSwapMemo(swap.FromChainID, swap.TxID, swap.LogIndex, memo)
+}
+
+// parkFailedSwap park the failed swap with the status of the failure category,
+// which is resumed by the corresponding job (liquidity or trustline).
+func parkFailedSwap(resBridge tokens.IBridge, swap *mongodb.MgoSwapResult, failure *tokens.TxFailure, memo string) error {
+	var status mongodb.SwapStatus
+	switch failure.Category {
+	case tokens.FailureInsufficientLiquidity:
+		status = mongodb.InsufficientLiquidity
+	case tokens.FailureReceiverRejected:
+		if _, ok := resBridge.(tokens.ITrustlineChecker); !ok {
+			return fmt.Errorf("can not park failure category %v on chain %v", failure.Category, swap.ToChainID)
+		}
+		status = mongodb.MissTrustline
+	default:
+		return fmt.Errorf("can not park failure category %v", failure.Category)
+	}
+	return mongodb.RouterResetFailedSwap(swap.FromChainID, swap.TxID, swap.LogIndex, status, memo)
+}
+
+func autoReswapFailedSwap(swap *mongodb.MgoSwapResult, failure *tokens.TxFailure, memo string) error {
+	// the nonce is used by another tx which may be a swap tx signed by
+	// other routers, reswap it only after checked manually.
+	if failure.Category == tokens.FailureNonceConflict {
+		return fmt.Errorf("can not auto reswap failure category %v", failure.Category)
+	}
+	serverCfg := params.GetRouterServerConfig()
+	if swap.AutoReswapCount >= serverCfg.MaxAutoReswapCount {
+		return fmt.Errorf("auto reswap count reached %v", swap.AutoReswapCount)
+	}
+	if failure.Category == tokens.FailureOutOfGas && failure.GasLimit > 0 {
+		gasLimit := failure.GasLimit + failure.GasLimit*serverCfg.FailureGasBumpPercent/100
+		if maxGasLimit := params.GetMaxGasLimit(swap.ToChainID); maxGasLimit > 0 && gasLimit > maxGasLimit {
+			gasLimit = maxGasLimit
+		}
+		if gasLimit <= failure.GasLimit {
+			return fmt.Errorf("can not bump gas limit %v", failure.GasLimit)
+		}
+		reswapGasLimits.Store(swap.Key, gasLimit)
+	}
+	err := mongodb.IncreaseRouterSwapResultAutoReswapCount(swap.FromChainID, swap.TxID, swap.LogIndex)
+	if err != nil {
+		reswapGasLimits.Delete(swap.Key)
+		return err
+	}
+	err = mongodb.RouterResetFailedSwap(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.TxNotSwapped, memo)
+	if err != nil {
+		reswapGasLimits.Delete(swap.Key)
+		return err
+	}
+	DeleteCachedSwap(swap.FromChainID, swap.TxID, swap.LogIndex)
+	return nil
+}
+
+// getReswapGasLimit get and delete the bumped gas limit of auto reswap
+func getReswapGasLimit(key string) *uint64 {
+	value, exist := reswapGasLimits.LoadAndDelete(key)
+	if !exist {
+		return nil
+	}
+	gasLimit := value.(uint64)
+	return &gasLimit
+}
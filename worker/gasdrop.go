@@ -0,0 +1,309 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+var errAlreadyGasDropped = errors.New("already gas dropped")
+
+// StartGasDropJob top up native coin to the receiver of stable swaps which request gas drop
+func StartGasDropJob() {
+	logWorker("gasdrop", "start gas drop job")
+	if !tokens.IsERC20Router() {
+		logWorker("gasdrop", "stop gas drop job as non erc20 swap")
+		return
+	}
+	if params.IsParallelSwapEnabled() {
+		// parallel nonce allocation is bound to the swap result
+		logWorker("gasdrop", "stop gas drop job as parallel swap is enabled")
+		return
+	}
+
+	mongodb.MgoWaitGroup.Add(1)
+	go doGasDropJob()
+}
+
+func doGasDropJob() {
+	defer mongodb.MgoWaitGroup.Done()
+	jobs := []func(){
+		processPendingGasDrops,
+		checkGasDropsStable,
+	}
+	for {
+		for _, job := range jobs {
+			if utils.IsCleanuping() {
+				logWorker("gasdrop", "stop gas drop job")
+				return
+			}
+			job()
+		}
+		if utils.IsCleanuping() {
+			logWorker("gasdrop", "stop gas drop job")
+			return
+		}
+		restInJob(restIntervalInGasDropJob)
+	}
+}
+
+func getGasDropTaskKey(fromChainID, txid string, logIndex int) string {
+	return "gasdrop:" + mongodb.GetRouterSwapKey(fromChainID, txid, logIndex)
+}
+
+// addGasDropForSwap record the gas drop charged by the payout of the swap,
+// it is delivered once the payout is stable (the first charged amount wins on reswap)
+func addGasDropForSwap(args *tokens.BuildTxArgs) error {
+	dstBridge := router.GetBridgeByChainID(args.ToChainID.String())
+	if dstBridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	if _, ok := dstBridge.(tokens.IGasDropBuilder); !ok {
+		return nil
+	}
+	mg := &mongodb.MgoGasDrop{
+		SwapType:    uint32(args.SwapType),
+		TxID:        args.SwapID,
+		LogIndex:    args.LogIndex,
+		FromChainID: args.FromChainID.String(),
+		ToChainID:   args.ToChainID.String(),
+		TokenID:     args.GetTokenID(),
+		Receiver:    args.Bind,
+		Amount:      args.Extra.GasDrop.String(),
+		Status:      mongodb.GasDropPending,
+	}
+	err := mongodb.AddRouterGasDrop(mg)
+	if errors.Is(err, mongodb.ErrItemIsDup) {
+		return nil
+	}
+	return err
+}
+
+// processPendingGasDrops dispatch pending gas drops to the swap queue of the dest chain
+func processPendingGasDrops() {
+	res, err := mongodb.FindRouterGasDropsWithStatus(mongodb.GasDropPending, getSepTimeInFind(maxGasDropLifetime))
+	if err != nil {
+		logWorkerError("gasdrop", "find pending gas drops error", err)
+		return
+	}
+	for _, mg := range res {
+		if utils.IsCleanuping() {
+			return
+		}
+		if swapTasksInQueue.Contains(getGasDropTaskKey(mg.FromChainID, mg.TxID, mg.LogIndex)) {
+			continue
+		}
+		err = processPendingGasDrop(mg)
+		if err != nil {
+			logWorkerError("gasdrop", "process pending gas drop error", err, "chainID", mg.FromChainID, "txid", mg.TxID, "logIndex", mg.LogIndex)
+		}
+	}
+}
+
+func processPendingGasDrop(mg *mongodb.MgoGasDrop) error {
+	if router.IsChainIDPaused(mg.ToChainID) {
+		return errChainIsPaused
+	}
+	res, err := mongodb.FindRouterSwapResult(mg.FromChainID, mg.TxID, mg.LogIndex)
+	if err != nil {
+		return err
+	}
+	if res.Status != mongodb.MatchTxStable {
+		return fmt.Errorf("swap result status %v is not stable", res.Status.String())
+	}
+	fromChainID, err := common.GetBigIntFromStr(mg.FromChainID)
+	if err != nil {
+		return fmt.Errorf("wrong fromChainID %v", mg.FromChainID)
+	}
+	toChainID, err := common.GetBigIntFromStr(mg.ToChainID)
+	if err != nil {
+		return fmt.Errorf("wrong toChainID %v", mg.ToChainID)
+	}
+	value, err := common.GetBigIntFromStr(res.Value)
+	if err != nil {
+		return fmt.Errorf("wrong value %v", res.Value)
+	}
+	amount, err := common.GetBigIntFromStr(mg.Amount)
+	if err != nil {
+		return fmt.Errorf("wrong amount %v", mg.Amount)
+	}
+	routerMPC, err := router.GetRouterMPC(tokens.ERC20SwapType, mg.TokenID, mg.ToChainID)
+	if err != nil {
+		return err
+	}
+
+	args := &tokens.BuildTxArgs{
+		SwapArgs: tokens.SwapArgs{
+			Identifier:  params.GetIdentifier(),
+			SwapID:      mg.TxID,
+			SwapType:    tokens.SwapType(mg.SwapType),
+			Bind:        mg.Receiver,
+			LogIndex:    mg.LogIndex,
+			FromChainID: fromChainID,
+			ToChainID:   toChainID,
+			GasDrop: &tokens.GasDropInfo{
+				Amount: amount,
+			},
+		},
+		From:        routerMPC,
+		OriginFrom:  res.From,
+		OriginTxTo:  res.TxTo,
+		OriginValue: value,
+		Extra:       &tokens.AllExtras{},
+	}
+	args.SwapInfo, err = mongodb.ConvertFromSwapInfo(&res.SwapInfo)
+	if err != nil {
+		return err
+	}
+
+	// share the swap queue of the dest chain to prevent nonce conflicts
+	return dispatchSwapTask(args)
+}
+
+func doGasDrop(args *tokens.BuildTxArgs) (err error) {
+	fromChainID := args.FromChainID.String()
+	txid := args.SwapID
+	logIndex := args.LogIndex
+
+	mg, err := mongodb.FindRouterGasDrop(fromChainID, txid, logIndex)
+	if err != nil {
+		return err
+	}
+	if mg.Status != mongodb.GasDropPending || mg.GasDropTx != "" {
+		return errAlreadyGasDropped
+	}
+
+	bridge := router.GetBridgeByChainID(args.ToChainID.String())
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	builder, ok := bridge.(tokens.IGasDropBuilder)
+	if !ok {
+		return tokens.ErrGasDropNotSupported
+	}
+
+	toChainID := args.ToChainID.String()
+	taskKey := getGasDropTaskKey(fromChainID, txid, logIndex)
+	err = restoreOfflineSignExtras(toChainID, taskKey, args)
+	if err != nil {
+		return err
+	}
+	defer func() { updateOfflineSignExtras(toChainID, taskKey, args, err) }()
+
+	start := time.Now()
+	rawTx, err := builder.BuildGasDropTransaction(args)
+	if err != nil {
+		logWorkerError("gasdrop", "build gas drop tx failed", err, "chainID", fromChainID, "txid", txid, "logIndex", logIndex)
+		return err
+	}
+	gasDropNonce := args.GetTxNonce() // assign after build tx
+	logWorker("gasdrop", "build gas drop tx success", "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "gasDropNonce", gasDropNonce, "timespent", time.Since(start).String())
+
+	start = time.Now()
+	signedTx, txHash, err := bridge.MPCSignTransaction(rawTx, args)
+	if err != nil {
+		logWorkerError("gasdrop", "sign gas drop tx failed", err, "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "timespent", time.Since(start).String())
+		return err
+	}
+	logWorker("gasdrop", "sign gas drop tx success", "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "txHash", txHash, "gasDropNonce", gasDropNonce, "timespent", time.Since(start).String())
+
+	// update database before sending transaction
+	err = mongodb.UpdateRouterGasDrop(fromChainID, txid, logIndex, &mongodb.GasDropUpdateItems{
+		MPC:          args.From,
+		GasDropTx:    txHash,
+		GasDropNonce: gasDropNonce,
+		Status:       mongodb.GasDropTxNotStable,
+		Timestamp:    now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = sendSignedTransaction(bridge, signedTx, args)
+	return err
+}
+
+// checkGasDropsStable check gas drop txs are stable or failed
+func checkGasDropsStable() {
+	res, err := mongodb.FindRouterGasDropsWithStatus(mongodb.GasDropTxNotStable, getSepTimeInFind(maxGasDropLifetime))
+	if err != nil {
+		logWorkerError("gasdrop", "find not stable gas drops error", err)
+		return
+	}
+	for _, mg := range res {
+		if utils.IsCleanuping() {
+			return
+		}
+		err = processGasDropStable(mg)
+		if err != nil {
+			logWorkerError("gasdrop", "process gas drop stable error", err, "chainID", mg.FromChainID, "txid", mg.TxID, "logIndex", mg.LogIndex, "gasDropTx", mg.GasDropTx)
+		}
+	}
+}
+
+func processGasDropStable(mg *mongodb.MgoGasDrop) error {
+	bridge := router.GetBridgeByChainID(mg.ToChainID)
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	txStatus, err := bridge.GetTransactionStatus(mg.GasDropTx)
+	if err != nil || !txStatus.IsSwapTxOnChain() {
+		return checkIfGasDropNonceHasPassed(bridge, mg)
+	}
+	if txStatus.Confirmations < bridge.GetChainConfig().Confirmations {
+		if mg.GasDropHeight == 0 {
+			return mongodb.UpdateRouterGasDrop(mg.FromChainID, mg.TxID, mg.LogIndex, &mongodb.GasDropUpdateItems{
+				GasDropHeight: txStatus.BlockHeight,
+				Status:        mongodb.GasDropTxNotStable,
+				Timestamp:     now(),
+			})
+		}
+		return nil
+	}
+	status := mongodb.GasDropTxStable
+	if txStatus.IsSwapTxOnChainAndFailed() {
+		status = mongodb.GasDropTxFailed
+	}
+	logWorker("gasdrop", "mark gas drop "+status.String(), "chainID", mg.FromChainID, "txid", mg.TxID, "logIndex", mg.LogIndex, "gasDropTx", mg.GasDropTx)
+	return mongodb.UpdateRouterGasDrop(mg.FromChainID, mg.TxID, mg.LogIndex, &mongodb.GasDropUpdateItems{
+		GasDropHeight: txStatus.BlockHeight,
+		Status:        status,
+		Timestamp:     now(),
+	})
+}
+
+// checkIfGasDropNonceHasPassed mark gas drop failed only if the gas drop tx can never be mined
+func checkIfGasDropNonceHasPassed(bridge tokens.IBridge, mg *mongodb.MgoGasDrop) error {
+	if mg.Timestamp+treatAsNoncePassedInterval > now() {
+		return nil
+	}
+	nonceSetter, ok := bridge.(tokens.NonceSetter)
+	if !ok || mg.GasDropNonce == 0 {
+		return nil
+	}
+	nonce, err := nonceSetter.GetPoolNonce(mg.MPC, "latest")
+	if err != nil {
+		return fmt.Errorf("get router mpc nonce failed, %w", err)
+	}
+	if nonce <= mg.GasDropNonce {
+		return nil
+	}
+	// recheck as the tx may be mined just now
+	txStatus, err := bridge.GetTransactionStatus(mg.GasDropTx)
+	if err == nil && txStatus.IsSwapTxOnChain() {
+		return nil
+	}
+	logWorkerWarn("gasdrop", "mark gas drop failed as nonce passed", "chainID", mg.FromChainID, "txid", mg.TxID, "logIndex", mg.LogIndex, "gasDropTx", mg.GasDropTx, "gasDropNonce", mg.GasDropNonce, "latestNonce", nonce)
+	return mongodb.UpdateRouterGasDrop(mg.FromChainID, mg.TxID, mg.LogIndex, &mongodb.GasDropUpdateItems{
+		Status:    mongodb.GasDropTxFailed,
+		Timestamp: now(),
+		Memo:      "gas drop tx nonce passed",
+	})
+}
@@ -0,0 +1,189 @@
+package worker
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// StartLiquidityJob resume swaps parked for insufficient liquidity
+func StartLiquidityJob() {
+	logWorker("liquidity", "start check liquidity job")
+	serverCfg = params.GetRouterServerConfig()
+	if serverCfg == nil {
+		logWorker("liquidity", "stop check liquidity job as no router server config exist")
+		return
+	}
+	if !serverCfg.EnableCheckLiquidity {
+		logWorker("liquidity", "stop check liquidity job as disabled")
+		return
+	}
+	if !tokens.IsERC20Router() {
+		logWorker("liquidity", "stop check liquidity job as non erc20 swap")
+		return
+	}
+
+	mongodb.MgoWaitGroup.Add(1)
+	go doLiquidityJob()
+}
+
+func doLiquidityJob() {
+	defer mongodb.MgoWaitGroup.Done()
+	for {
+		res, err := FindInsufficientLiquiditySwaps()
+		if err != nil {
+			logWorkerError("liquidity", "find insufficient liquidity swaps error", err)
+		}
+		if len(res) > 0 {
+			logWorker("liquidity", "find insufficient liquidity swaps", "count", len(res))
+		}
+		// key is toChainID + multichain token, value is the remaining liquidity
+		remainings := make(map[string]*big.Int)
+		for _, swap := range res {
+			if utils.IsCleanuping() {
+				logWorker("liquidity", "stop check liquidity job")
+				return
+			}
+			err = processInsufficientLiquiditySwap(swap, remainings)
+			if err != nil {
+				logWorkerError("liquidity", "process insufficient liquidity swap error", err, "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex)
+			}
+		}
+		if utils.IsCleanuping() {
+			logWorker("liquidity", "stop check liquidity job")
+			return
+		}
+		restInJob(restIntervalInLiquidityJob)
+	}
+}
+
+// FindInsufficientLiquiditySwaps find swaps parked for insufficient liquidity (oldest first)
+func FindInsufficientLiquiditySwaps() ([]*mongodb.MgoSwap, error) {
+	septime := getSepTimeInFind(maxInsufficientLiquidityLifetime)
+	return mongodb.FindRouterSwapsWithStatus(mongodb.InsufficientLiquidity, septime)
+}
+
+// processInsufficientLiquiditySwap resume swap if the remaining liquidity is enough.
+// swaps are processed in init time order, and the liquidity reserved by the
+// resumed swaps is deducted. swaps which do not fit are skipped, so that the
+// later smaller swaps can still be resumed with the remaining liquidity.
+func processInsufficientLiquiditySwap(swap *mongodb.MgoSwap, remainings map[string]*big.Int) error {
+	if swap.Status != mongodb.InsufficientLiquidity || swap.ERC20SwapInfo == nil {
+		return nil
+	}
+	multichainToken := router.GetCachedMultichainToken(swap.GetTokenID(), swap.ToChainID)
+	if multichainToken == "" {
+		return tokens.ErrMissTokenConfig
+	}
+	key := swap.ToChainID + ":" + multichainToken
+	remaining, exist := remainings[key]
+	if !exist {
+		dstBridge := router.GetBridgeByChainID(swap.ToChainID)
+		if dstBridge == nil {
+			return tokens.ErrNoBridgeForChainID
+		}
+		checker, ok := dstBridge.(tokens.ILiquidityChecker)
+		if !ok {
+			return resumeSwapWithLiquidity(swap)
+		}
+		liquidity, err := checker.GetTokenLiquidity(multichainToken)
+		if err != nil {
+			return err
+		}
+		if liquidity == nil {
+			return resumeSwapWithLiquidity(swap)
+		}
+		remaining = new(big.Int).Set(liquidity)
+		remainings[key] = remaining
+	}
+	amount, err := CalcSwapInAmount(swap)
+	if err != nil {
+		return err
+	}
+	if remaining.Cmp(amount) < 0 {
+		logWorkerTrace("liquidity", "liquidity is still insufficient", "txid", swap.TxID, "logIndex", swap.LogIndex, "toChainID", swap.ToChainID, "token", multichainToken, "liquidity", remaining, "amount", amount)
+		return nil
+	}
+	remaining.Sub(remaining, amount)
+	return resumeSwapWithLiquidity(swap)
+}
+
+func resumeSwapWithLiquidity(swap *mongodb.MgoSwap) error {
+	err := mongodb.UpdateRouterSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.TxNotSwapped, now(), "")
+	if err != nil {
+		return err
+	}
+	_ = updateSwapMemo(swap.FromChainID, swap.TxID, swap.LogIndex, "")
+	logWorker("liquidity", "resume swap as liquidity is enough", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID, "txid", swap.TxID, "logIndex", swap.LogIndex)
+	return nil
+}
+
+// CalcSwapInAmount calc the amount of underlying paid on the dest chain
+func CalcSwapInAmount(swap *mongodb.MgoSwap) (*big.Int, error) {
+	if swap.ERC20SwapInfo == nil {
+		return nil, tokens.ErrSwapTypeNotSupported
+	}
+	value, err := common.GetBigIntFromStr(swap.Value)
+	if err != nil {
+		return nil, fmt.Errorf("wrong value %v", swap.Value)
+	}
+	fromBridge := router.GetBridgeByChainID(swap.FromChainID)
+	dstBridge := router.GetBridgeByChainID(swap.ToChainID)
+	if fromBridge == nil || dstBridge == nil {
+		return nil, tokens.ErrNoBridgeForChainID
+	}
+	tokenID := swap.GetTokenID()
+	fromTokenCfg := fromBridge.GetTokenConfig(swap.GetToken())
+	toTokenCfg := dstBridge.GetTokenConfig(router.GetCachedMultichainToken(tokenID, swap.ToChainID))
+	if fromTokenCfg == nil || toTokenCfg == nil {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	return tokens.CalcSwapValue(tokenID, swap.FromChainID, swap.ToChainID, value,
+		fromTokenCfg.Decimals, toTokenCfg.Decimals, swap.From, swap.TxTo), nil
+}
+
+// checkSwapInLiquidity park the swap if the dest liquidity is insufficient
+// and the swapin tx would revert. rpc errors are not blocking.
+func checkSwapInLiquidity(swap *mongodb.MgoSwap, dstBridge tokens.IBridge) error {
+	if swap.ERC20SwapInfo == nil {
+		return nil
+	}
+	checker, ok := dstBridge.(tokens.ILiquidityChecker)
+	if !ok {
+		return nil
+	}
+	multichainToken := router.GetCachedMultichainToken(swap.GetTokenID(), swap.ToChainID)
+	if multichainToken == "" {
+		return nil
+	}
+	if !checker.IsLiquidityRequired(multichainToken, swap.ERC20SwapInfo.CallProxy != "") {
+		return nil
+	}
+	liquidity, err := checker.GetTokenLiquidity(multichainToken)
+	if err != nil {
+		logWorkerWarn("swap", "get token liquidity failed", "toChainID", swap.ToChainID, "token", multichainToken, "err", err)
+		return nil
+	}
+	if liquidity == nil {
+		return nil
+	}
+	amount, err := CalcSwapInAmount(swap)
+	if err != nil || liquidity.Cmp(amount) >= 0 {
+		return nil
+	}
+	memo := fmt.Sprintf("%v, liquidity %v, amount %v", tokens.ErrInsufficientLiquidity, liquidity, amount)
+	logWorkerWarn("swap", "park swap for insufficient liquidity", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "token", multichainToken, "liquidity", liquidity, "amount", amount)
+	err = mongodb.UpdateRouterSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.InsufficientLiquidity, now(), memo)
+	if err != nil {
+		return err
+	}
+	notifyRegisteredSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex)
+	_ = updateSwapMemo(swap.FromChainID, swap.TxID, swap.LogIndex, memo)
+	return tokens.ErrInsufficientLiquidity
+}
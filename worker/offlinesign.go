@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/deltaswapio/swaprouter/v3/mpc"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// offline signing is asynchronous, the sign bundle is exported and the task
+// is retried on later passes to pick up the signature. the build extras
+// (nonce, gas and fees) of the pending task are kept to rebuild the same tx,
+// so that the msg hash and then the bundle are the same.
+var (
+	offlineSignPendingExtras = make(map[string]*tokens.AllExtras) // key is task key
+	offlineSignPendingSlots  = make(map[string]string)            // key is chainID:sender, value is task key
+	offlineSignPendingLock   sync.Mutex
+
+	offlineSignRetryInterval = 10 // seconds
+
+	errWaitOtherOfflineSign = errors.New("wait offline signature of other task")
+)
+
+func getOfflineSignSlotKey(chainID string, args *tokens.BuildTxArgs) string {
+	return strings.ToLower(fmt.Sprintf("%v:%v", chainID, args.From))
+}
+
+// restoreOfflineSignExtras restore the build extras of pending offline sign task.
+// tasks of the same sender wait until the pending one is signed in non parallel mode,
+// as they would be assigned the same nonce.
+func restoreOfflineSignExtras(chainID, taskKey string, args *tokens.BuildTxArgs) error {
+	offlineSignPendingLock.Lock()
+	defer offlineSignPendingLock.Unlock()
+
+	if extra, exist := offlineSignPendingExtras[taskKey]; exist {
+		restored := *extra
+		args.Extra = &restored
+		return nil
+	}
+	if params.IsParallelSwapEnabled() {
+		return nil
+	}
+	if pendingKey, exist := offlineSignPendingSlots[getOfflineSignSlotKey(chainID, args)]; exist {
+		return fmt.Errorf("%w %v", errWaitOtherOfflineSign, pendingKey)
+	}
+	return nil
+}
+
+// updateOfflineSignExtras keep the build extras if offline sign is pending, otherwise clear them
+func updateOfflineSignExtras(chainID, taskKey string, args *tokens.BuildTxArgs, err error) {
+	offlineSignPendingLock.Lock()
+	defer offlineSignPendingLock.Unlock()
+
+	slotKey := getOfflineSignSlotKey(chainID, args)
+	if errors.Is(err, mpc.ErrOfflineSignPending) && args.Extra != nil {
+		extra := *args.Extra
+		offlineSignPendingExtras[taskKey] = &extra
+		offlineSignPendingSlots[slotKey] = taskKey
+		return
+	}
+	if _, exist := offlineSignPendingExtras[taskKey]; !exist {
+		return
+	}
+	delete(offlineSignPendingExtras, taskKey)
+	if offlineSignPendingSlots[slotKey] == taskKey {
+		delete(offlineSignPendingSlots, slotKey)
+	}
+}
+
+func isOfflineSignWaiting(err error) bool {
+	return errors.Is(err, mpc.ErrOfflineSignPending) || errors.Is(err, errWaitOtherOfflineSign)
+}
@@ -0,0 +1,462 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/admin"
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+var (
+	errAlreadyRefunded     = errors.New("already refunded")
+	errMissRefundApproval  = errors.New("miss refund approval")
+	errWrongRefundApproval = errors.New("wrong refund approval")
+
+	refundableStatuses = []mongodb.SwapStatus{
+		mongodb.TxWithWrongPath,
+		mongodb.SwapoutForbidden,
+		mongodb.MissTokenConfig,
+		mongodb.NoUnderlyingToken,
+		mongodb.ManualMakeFail,
+	}
+)
+
+// StartRefundJob refund swaps which can never be delivered
+func StartRefundJob() {
+	logWorker("refund", "start refund job")
+	if params.GetRefundConfig() == nil {
+		logWorker("refund", "stop refund job as disabled")
+		return
+	}
+	if !tokens.IsERC20Router() {
+		logWorker("refund", "stop refund job as non erc20 swap")
+		return
+	}
+
+	mongodb.MgoWaitGroup.Add(1)
+	go doRefundJob()
+}
+
+func doRefundJob() {
+	defer mongodb.MgoWaitGroup.Done()
+	jobs := []func(){
+		collectRefunds,
+		autoApproveRefunds,
+		processApprovedRefunds,
+		checkRefundsStable,
+	}
+	for {
+		for _, job := range jobs {
+			if utils.IsCleanuping() {
+				logWorker("refund", "stop refund job")
+				return
+			}
+			job()
+		}
+		if utils.IsCleanuping() {
+			logWorker("refund", "stop refund job")
+			return
+		}
+		restInJob(restIntervalInRefundJob)
+	}
+}
+
+func getRefundTaskKey(fromChainID, txid string, logIndex int) string {
+	return "refund:" + mongodb.GetRouterSwapKey(fromChainID, txid, logIndex)
+}
+
+// collectRefunds add pending refund records for undeliverable swaps
+func collectRefunds() {
+	refundCfg := params.GetRefundConfig()
+	if refundCfg == nil {
+		return
+	}
+	septime := getSepTimeInFind(maxRefundLifetime)
+	for _, status := range refundableStatuses {
+		for skip := int64(0); ; {
+			res, err := mongodb.FindRouterSwapsWithStatusAndSkip(status, septime, skip)
+			if err != nil {
+				logWorkerError("refund", "find refundable swaps error", err, "status", status.String())
+				break
+			}
+			if len(res) == 0 {
+				break
+			}
+			for _, swap := range res {
+				if utils.IsCleanuping() {
+					return
+				}
+				err = addRefundForSwap(swap)
+				if err != nil {
+					logWorkerError("refund", "add refund error", err, "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex)
+				}
+			}
+			skip += int64(len(res))
+		}
+	}
+}
+
+func addRefundForSwap(swap *mongodb.MgoSwap) error {
+	if !swap.Status.IsRefundableStatus() || swap.ERC20SwapInfo == nil {
+		return nil
+	}
+	_, err := mongodb.FindRouterRefund(swap.FromChainID, swap.TxID, swap.LogIndex)
+	if err == nil {
+		return nil // already exist
+	}
+	if !errors.Is(err, mongodb.ErrItemNotFound) {
+		return err
+	}
+	bridge := router.GetBridgeByChainID(swap.FromChainID)
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	if _, ok := bridge.(tokens.IRefundBuilder); !ok {
+		return nil
+	}
+	err = checkSwapNotPaid(swap.FromChainID, swap.TxID, swap.LogIndex)
+	if errors.Is(err, errAlreadySwapped) {
+		logWorkerTrace("refund", "ignore refund as swap has been paid", "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "status", swap.Status.String())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	value, err := common.GetBigIntFromStr(swap.Value)
+	if err != nil {
+		return fmt.Errorf("wrong value %v", swap.Value)
+	}
+	fee := tokens.CalcRefundFee(value)
+	if value.Cmp(fee) <= 0 {
+		logWorkerTrace("refund", "ignore refund as value is too small", "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "value", value, "fee", fee)
+		return nil
+	}
+	mr := &mongodb.MgoRefund{
+		SwapType:    swap.SwapType,
+		TxID:        swap.TxID,
+		LogIndex:    swap.LogIndex,
+		FromChainID: swap.FromChainID,
+		Token:       swap.GetToken(),
+		TokenID:     swap.GetTokenID(),
+		SwapoutID:   swap.ERC20SwapInfo.SwapoutID,
+		Receiver:    swap.From,
+		Value:       swap.Value,
+		Fee:         fee.String(),
+		Reason:      swap.Status.String(),
+		Status:      mongodb.RefundPending,
+	}
+	err = mongodb.AddRouterRefund(mr)
+	if errors.Is(err, mongodb.ErrItemIsDup) {
+		return nil
+	}
+	return err
+}
+
+// checkSwapNotPaid refund is forbidden if the swap has ever been paid
+func checkSwapNotPaid(fromChainID, txid string, logIndex int) error {
+	res, err := mongodb.FindRouterSwapResult(fromChainID, txid, logIndex)
+	if errors.Is(err, mongodb.ErrItemNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if res.SwapTx != "" || res.SwapNonce > 0 || res.SwapHeight != 0 || len(res.OldSwapTxs) > 0 {
+		return errAlreadySwapped
+	}
+	return nil
+}
+
+// isManualMakeFailRefund refund of swap made fail manually, which is approved by admin only
+func isManualMakeFailRefund(reason string) bool {
+	return reason == mongodb.ManualMakeFail.String()
+}
+
+// VerifyManualRefundApproval verify the signed admin approval of refunding swap made fail manually.
+// the swap is verified ok on the source chain, so that oracles rely on the approval.
+func VerifyManualRefundApproval(approval, fromChainID, txid string, logIndex int) error {
+	refundCfg := params.GetRefundConfig()
+	if refundCfg == nil {
+		return tokens.ErrRefundNotAllowed
+	}
+	if approval == "" {
+		return errMissRefundApproval
+	}
+	tx, err := admin.DecodeTransaction(approval)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errWrongRefundApproval, err)
+	}
+	approver, args, err := admin.RecoverTransaction(tx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errWrongRefundApproval, err)
+	}
+	if !refundCfg.IsManualRefundApprover(approver.String()) {
+		return fmt.Errorf("%w: %v is not manual refund approver", errWrongRefundApproval, approver.String())
+	}
+	if args.Method != admin.ApproveRefundMethod || len(args.Params) < 3 {
+		return fmt.Errorf("%w: wrong method %v", errWrongRefundApproval, args.Method)
+	}
+	approvedLogIndex, err := common.GetIntFromStr(args.Params[2])
+	if err != nil || args.Params[0] != fromChainID ||
+		!strings.EqualFold(args.Params[1], txid) || approvedLogIndex != logIndex {
+		return fmt.Errorf("%w: approved swap %v mismatch", errWrongRefundApproval, args.Params)
+	}
+	return nil
+}
+
+// autoApproveRefunds approve pending refunds after the auto refund delay
+func autoApproveRefunds() {
+	refundCfg := params.GetRefundConfig()
+	if refundCfg == nil || refundCfg.AutoRefundDelay <= 0 {
+		return
+	}
+	res, err := mongodb.FindRouterRefundsWithStatus(mongodb.RefundPending, getSepTimeInFind(maxRefundLifetime))
+	if err != nil {
+		logWorkerError("refund", "find pending refunds error", err)
+		return
+	}
+	for _, mr := range res {
+		if mr.InitTime/1000+refundCfg.AutoRefundDelay > now() || isManualMakeFailRefund(mr.Reason) {
+			continue
+		}
+		err = mongodb.ApproveRouterRefund(mr.FromChainID, mr.TxID, mr.LogIndex, "")
+		if err != nil {
+			logWorkerError("refund", "auto approve refund error", err, "chainID", mr.FromChainID, "txid", mr.TxID, "logIndex", mr.LogIndex)
+		}
+	}
+}
+
+// processApprovedRefunds dispatch approved refunds to the swap queue of the source chain
+func processApprovedRefunds() {
+	res, err := mongodb.FindRouterRefundsWithStatus(mongodb.RefundApproved, getSepTimeInFind(maxRefundLifetime))
+	if err != nil {
+		logWorkerError("refund", "find approved refunds error", err)
+		return
+	}
+	for _, mr := range res {
+		if utils.IsCleanuping() {
+			return
+		}
+		if swapTasksInQueue.Contains(getRefundTaskKey(mr.FromChainID, mr.TxID, mr.LogIndex)) {
+			continue
+		}
+		err = processApprovedRefund(mr)
+		if err != nil {
+			logWorkerError("refund", "process approved refund error", err, "chainID", mr.FromChainID, "txid", mr.TxID, "logIndex", mr.LogIndex)
+		}
+	}
+}
+
+func processApprovedRefund(mr *mongodb.MgoRefund) error {
+	if router.IsChainIDPaused(mr.FromChainID) {
+		return errChainIsPaused
+	}
+	swap, err := mongodb.FindRouterSwap(mr.FromChainID, mr.TxID, mr.LogIndex)
+	if err != nil {
+		return err
+	}
+	if !swap.Status.IsRefundableStatus() {
+		return fmt.Errorf("swap status %v is not refundable", swap.Status.String())
+	}
+	err = checkSwapNotPaid(mr.FromChainID, mr.TxID, mr.LogIndex)
+	if err != nil {
+		return err
+	}
+	if isManualMakeFailRefund(mr.Reason) {
+		err = VerifyManualRefundApproval(mr.Approval, mr.FromChainID, mr.TxID, mr.LogIndex)
+		if err != nil {
+			return err
+		}
+	}
+	chainID, err := common.GetBigIntFromStr(mr.FromChainID)
+	if err != nil {
+		return fmt.Errorf("wrong chainID %v", mr.FromChainID)
+	}
+	value, err := common.GetBigIntFromStr(mr.Value)
+	if err != nil {
+		return fmt.Errorf("wrong value %v", mr.Value)
+	}
+	routerMPC, err := router.GetRefundRouterMPC(mr.Token, mr.FromChainID)
+	if err != nil {
+		return err
+	}
+
+	args := &tokens.BuildTxArgs{
+		SwapArgs: tokens.SwapArgs{
+			Identifier:  params.GetIdentifier(),
+			SwapID:      mr.TxID,
+			SwapType:    tokens.SwapType(mr.SwapType),
+			Bind:        mr.Receiver,
+			LogIndex:    mr.LogIndex,
+			FromChainID: chainID,
+			ToChainID:   chainID,
+			Refund: &tokens.RefundInfo{
+				Reason:   mr.Reason,
+				Fee:      tokens.CalcRefundFee(value),
+				Approval: mr.Approval,
+			},
+		},
+		From:        routerMPC,
+		OriginFrom:  swap.From,
+		OriginTxTo:  swap.TxTo,
+		OriginValue: value,
+		Extra:       &tokens.AllExtras{},
+	}
+	args.SwapInfo, err = mongodb.ConvertFromSwapInfo(&swap.SwapInfo)
+	if err != nil {
+		return err
+	}
+
+	// share the swap queue of the source chain to prevent nonce conflicts
+	return dispatchSwapTask(args)
+}
+
+func doRefund(args *tokens.BuildTxArgs) (err error) {
+	fromChainID := args.FromChainID.String()
+	txid := args.SwapID
+	logIndex := args.LogIndex
+
+	mr, err := mongodb.FindRouterRefund(fromChainID, txid, logIndex)
+	if err != nil {
+		return err
+	}
+	if mr.Status != mongodb.RefundApproved || mr.RefundTx != "" {
+		return errAlreadyRefunded
+	}
+
+	bridge := router.GetBridgeByChainID(fromChainID)
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	builder, ok := bridge.(tokens.IRefundBuilder)
+	if !ok {
+		return tokens.ErrRefundNotSupported
+	}
+
+	taskKey := getRefundTaskKey(fromChainID, txid, logIndex)
+	err = restoreOfflineSignExtras(fromChainID, taskKey, args)
+	if err != nil {
+		return err
+	}
+	defer func() { updateOfflineSignExtras(fromChainID, taskKey, args, err) }()
+
+	start := time.Now()
+	rawTx, err := builder.BuildRefundTransaction(args)
+	if err != nil {
+		logWorkerError("refund", "build refund tx failed", err, "chainID", fromChainID, "txid", txid, "logIndex", logIndex)
+		return err
+	}
+	refundNonce := args.GetTxNonce() // assign after build tx
+	logWorker("refund", "build refund tx success", "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "refundNonce", refundNonce, "timespent", time.Since(start).String())
+
+	start = time.Now()
+	signedTx, txHash, err := bridge.MPCSignTransaction(rawTx, args)
+	if err != nil {
+		logWorkerError("refund", "sign refund tx failed", err, "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "timespent", time.Since(start).String())
+		return err
+	}
+	logWorker("refund", "sign refund tx success", "chainID", fromChainID, "txid", txid, "logIndex", logIndex, "txHash", txHash, "refundNonce", refundNonce, "timespent", time.Since(start).String())
+
+	// update database before sending transaction
+	err = mongodb.UpdateRouterRefund(fromChainID, txid, logIndex, &mongodb.RefundUpdateItems{
+		MPC:         args.From,
+		RefundTx:    txHash,
+		RefundValue: args.SwapValue.String(),
+		RefundNonce: refundNonce,
+		Status:      mongodb.RefundTxNotStable,
+		Timestamp:   now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = sendSignedTransaction(bridge, signedTx, args)
+	return err
+}
+
+// checkRefundsStable check refund txs are stable or failed
+func checkRefundsStable() {
+	res, err := mongodb.FindRouterRefundsWithStatus(mongodb.RefundTxNotStable, getSepTimeInFind(maxRefundLifetime))
+	if err != nil {
+		logWorkerError("refund", "find not stable refunds error", err)
+		return
+	}
+	for _, mr := range res {
+		if utils.IsCleanuping() {
+			return
+		}
+		err = processRefundStable(mr)
+		if err != nil {
+			logWorkerError("refund", "process refund stable error", err, "chainID", mr.FromChainID, "txid", mr.TxID, "logIndex", mr.LogIndex, "refundTx", mr.RefundTx)
+		}
+	}
+}
+
+func processRefundStable(mr *mongodb.MgoRefund) error {
+	bridge := router.GetBridgeByChainID(mr.FromChainID)
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+	txStatus, err := bridge.GetTransactionStatus(mr.RefundTx)
+	if err != nil || !txStatus.IsSwapTxOnChain() {
+		return checkIfRefundNonceHasPassed(bridge, mr)
+	}
+	if txStatus.Confirmations < bridge.GetChainConfig().Confirmations {
+		if mr.RefundHeight == 0 {
+			return mongodb.UpdateRouterRefund(mr.FromChainID, mr.TxID, mr.LogIndex, &mongodb.RefundUpdateItems{
+				RefundHeight: txStatus.BlockHeight,
+				Status:       mongodb.RefundTxNotStable,
+				Timestamp:    now(),
+			})
+		}
+		return nil
+	}
+	status := mongodb.RefundTxStable
+	if txStatus.IsSwapTxOnChainAndFailed() {
+		status = mongodb.RefundTxFailed
+	}
+	logWorker("refund", "mark refund "+status.String(), "chainID", mr.FromChainID, "txid", mr.TxID, "logIndex", mr.LogIndex, "refundTx", mr.RefundTx)
+	return mongodb.UpdateRouterRefund(mr.FromChainID, mr.TxID, mr.LogIndex, &mongodb.RefundUpdateItems{
+		RefundHeight: txStatus.BlockHeight,
+		Status:       status,
+		Timestamp:    now(),
+	})
+}
+
+// checkIfRefundNonceHasPassed mark refund failed only if the refund tx can never be mined,
+// admin should approve it again to retry.
+func checkIfRefundNonceHasPassed(bridge tokens.IBridge, mr *mongodb.MgoRefund) error {
+	if mr.Timestamp+treatAsNoncePassedInterval > now() {
+		return nil
+	}
+	nonceSetter, ok := bridge.(tokens.NonceSetter)
+	if !ok || mr.RefundNonce == 0 {
+		return nil
+	}
+	nonce, err := nonceSetter.GetPoolNonce(mr.MPC, "latest")
+	if err != nil {
+		return fmt.Errorf("get router mpc nonce failed, %w", err)
+	}
+	if nonce <= mr.RefundNonce {
+		return nil
+	}
+	// recheck as the tx may be mined just now
+	txStatus, err := bridge.GetTransactionStatus(mr.RefundTx)
+	if err == nil && txStatus.IsSwapTxOnChain() {
+		return nil
+	}
+	logWorkerWarn("refund", "mark refund failed as nonce passed", "chainID", mr.FromChainID, "txid", mr.TxID, "logIndex", mr.LogIndex, "refundTx", mr.RefundTx, "refundNonce", mr.RefundNonce, "latestNonce", nonce)
+	return mongodb.UpdateRouterRefund(mr.FromChainID, mr.TxID, mr.LogIndex, &mongodb.RefundUpdateItems{
+		Status:    mongodb.RefundTxFailed,
+		Timestamp: now(),
+		Memo:      "refund tx nonce passed",
+	})
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/admin"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+)
+
+const (
+	testRefundChainID = "56"
+	testRefundTxID    = "0x4b0c6fe9cd6aa3fe2b47db0bbdc5c4b9ab2dc0ee2e8d30e7b1b2c1d6a3e5f789"
+)
+
+func TestRefundableStatuses(t *testing.T) {
+	for _, status := range refundableStatuses {
+		if !status.IsRefundableStatus() {
+			t.Errorf("status %v in refundable statuses is not refundable", status.String())
+		}
+	}
+	notRefundable := []mongodb.SwapStatus{
+		mongodb.TxNotStable, mongodb.TxNotSwapped, mongodb.TxProcessed,
+		mongodb.MatchTxFailed, mongodb.TxSimulateReverted, mongodb.InsufficientLiquidity,
+	}
+	for _, status := range notRefundable {
+		if status.IsRefundableStatus() {
+			t.Errorf("status %v is refundable", status.String())
+		}
+	}
+	if !isManualMakeFailRefund(mongodb.ManualMakeFail.String()) || isManualMakeFailRefund(mongodb.SwapoutForbidden.String()) {
+		t.Errorf("wrong manual make fail refund check")
+	}
+}
+
+func TestVerifyManualRefundApproval(t *testing.T) {
+	defer func() { _ = params.SetExtraConfig(&params.ExtraConfig{}) }()
+
+	approverKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	approver := crypto.PubkeyToAddress(approverKey.PublicKey).String()
+
+	err := params.SetExtraConfig(&params.ExtraConfig{
+		Refund: &params.RefundConfig{
+			Enable:                true,
+			ManualRefundApprovers: []string{approver},
+		},
+	})
+	if err != nil {
+		t.Fatalf("set extra config failed: %v", err)
+	}
+
+	sign := func(method string, params ...string) string {
+		rawTx, errs := admin.SignWithKey(approverKey, method, params)
+		if errs != nil {
+			t.Fatal(errs)
+		}
+		return rawTx
+	}
+	otherApproval, err := admin.SignWithKey(otherKey, admin.ApproveRefundMethod, []string{testRefundChainID, testRefundTxID, "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		approval string
+		err      error
+	}{
+		{"approved", sign(admin.ApproveRefundMethod, testRefundChainID, testRefundTxID, "1"), nil},
+		{"empty approval", "", errMissRefundApproval},
+		{"not admin tx", "0x1234", errWrongRefundApproval},
+		{"not approver", otherApproval, errWrongRefundApproval},
+		{"other method", sign("reswap", testRefundChainID, testRefundTxID, "1"), errWrongRefundApproval},
+		{"other chain", sign(admin.ApproveRefundMethod, "1", testRefundTxID, "1"), errWrongRefundApproval},
+		{"other tx", sign(admin.ApproveRefundMethod, testRefundChainID, "0x1234", "1"), errWrongRefundApproval},
+		{"other log index", sign(admin.ApproveRefundMethod, testRefundChainID, testRefundTxID, "2"), errWrongRefundApproval},
+		{"miss log index", sign(admin.ApproveRefundMethod, testRefundChainID, testRefundTxID), errWrongRefundApproval},
+	}
+	for _, tt := range tests {
+		err = VerifyManualRefundApproval(tt.approval, testRefundChainID, testRefundTxID, 1)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%v: VerifyManualRefundApproval error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+
+	// refund is not allowed without refund config
+	if err = params.SetExtraConfig(&params.ExtraConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	approval := sign(admin.ApproveRefundMethod, testRefundChainID, testRefundTxID, "1")
+	if err = VerifyManualRefundApproval(approval, testRefundChainID, testRefundTxID, 1); !errors.Is(err, tokens.ErrRefundNotAllowed) {
+		t.Errorf("VerifyManualRefundApproval without refund config error = %v, want %v", err, tokens.ErrRefundNotAllowed)
+	}
+}
@@ -0,0 +1,181 @@
+package worker
+
+import (
+	"errors"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/log"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/rpc/client"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	defWatchReorgWindow = int64(24 * 3600) // seconds
+	reorgConfirmCount   = 3                // consecutive checks before marking reorged
+	reorgAlertTimeout   = 10               // seconds
+)
+
+var (
+	// key is swap key, value is count of consecutive checks found reorged
+	reorgSuspects = make(map[string]int)
+
+	reorgSwaps reorgSwapStore = mgoReorgSwapStore{}
+)
+
+// reorgSwapStore finds the paid swaps to watch and marks the reorged ones
+type reorgSwapStore interface {
+	// FindStable find stable swaps in order of (timestamp, key) after the position
+	FindStable(sinceTime int64, afterKey string) ([]*mongodb.MgoSwapResult, error)
+	MarkReorged(swap *mongodb.MgoSwapResult, memo string) error
+}
+
+type mgoReorgSwapStore struct{}
+
+func (mgoReorgSwapStore) FindStable(sinceTime int64, afterKey string) ([]*mongodb.MgoSwapResult, error) {
+	return mongodb.FindRouterSwapResultsWithStatusAfter(mongodb.MatchTxStable, sinceTime, afterKey)
+}
+
+func (mgoReorgSwapStore) MarkReorged(swap *mongodb.MgoSwapResult, memo string) error {
+	err := mongodb.UpdateRouterSwapResultStatus(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.SourceTxReorged, now(), memo)
+	if err == nil {
+		notifySwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex)
+	}
+	return err
+}
+
+// ReorgAlert reorg alert posted to webhook
+type ReorgAlert struct {
+	FromChainID string `json:"fromChainID"`
+	ToChainID   string `json:"toChainID"`
+	TxID        string `json:"txid"`
+	LogIndex    int    `json:"logIndex"`
+	SwapTx      string `json:"swaptx"`
+	Value       string `json:"value"`
+	Error       string `json:"error"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// StartWatchReorgJob watch source tx reorgs after swaps are paid
+func StartWatchReorgJob() {
+	logWorker("reorg", "start watch reorg job")
+	serverCfg = params.GetRouterServerConfig()
+	if serverCfg == nil {
+		logWorker("reorg", "stop watch reorg job as no router server config exist")
+		return
+	}
+	if !serverCfg.EnableWatchReorg {
+		logWorker("reorg", "stop watch reorg job as disabled")
+		return
+	}
+
+	watchWindow := serverCfg.WatchReorgWindow
+	if watchWindow <= 0 {
+		watchWindow = defWatchReorgWindow
+	}
+
+	mongodb.MgoWaitGroup.Add(1)
+	go doWatchReorgJob(watchWindow)
+}
+
+func doWatchReorgJob(watchWindow int64) {
+	defer mongodb.MgoWaitGroup.Done()
+	for {
+		watchReorgSwaps(getSepTimeInFind(watchWindow))
+		if utils.IsCleanuping() {
+			logWorker("reorg", "stop watch reorg job")
+			return
+		}
+		restInJob(restIntervalInWatchReorgJob)
+	}
+}
+
+// watchReorgSwaps check the swaps which are marked stable since septime.
+// the status timestamp of stable swaps is the time they became stable.
+// swaps are paged by position, as reorged swaps leave the stable status.
+func watchReorgSwaps(septime int64) {
+	sinceTime, afterKey := septime, ""
+	for {
+		res, err := reorgSwaps.FindStable(sinceTime, afterKey)
+		if err != nil {
+			logWorkerError("reorg", "find stable swap results error", err)
+			return
+		}
+		if len(res) == 0 {
+			return
+		}
+		for _, swap := range res {
+			if utils.IsCleanuping() {
+				return
+			}
+			err = processWatchReorgSwap(swap)
+			if err != nil {
+				logWorkerError("reorg", "process watch reorg swap error", err, "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex)
+			}
+		}
+		last := res[len(res)-1]
+		sinceTime, afterKey = last.Timestamp, last.Key
+	}
+}
+
+func processWatchReorgSwap(swap *mongodb.MgoSwapResult) error {
+	bridge := router.GetBridgeByChainID(swap.FromChainID)
+	if bridge == nil {
+		return tokens.ErrNoBridgeForChainID
+	}
+
+	// a missing tx is not a reorg proof on chains without source tx checker
+	// (eg. nodes of non-EVM chains may prune or not index old txs)
+	checker, ok := bridge.(tokens.ISourceTxChecker)
+	if !ok {
+		logWorkerTrace("reorg", "ignore chain without source tx checker", "chainID", swap.FromChainID, "txid", swap.TxID)
+		return nil
+	}
+	err := checker.CheckSourceTx(swap.TxID)
+
+	if !errors.Is(err, tokens.ErrSourceTxReorged) {
+		delete(reorgSuspects, swap.Key)
+		if err != nil {
+			logWorkerTrace("reorg", "check source tx failed", "chainID", swap.FromChainID, "txid", swap.TxID, "err", err)
+		}
+		return nil
+	}
+
+	reorgSuspects[swap.Key]++
+	if reorgSuspects[swap.Key] < reorgConfirmCount {
+		logWorkerWarn("reorg", "source tx may be reorged", "fromChainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "count", reorgSuspects[swap.Key], "err", err)
+		return nil
+	}
+	delete(reorgSuspects, swap.Key)
+
+	log.Error("[reorg] CRITICAL: source tx of paid swap is reorged", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "swaptx", swap.SwapTx, "value", swap.Value, "err", err)
+	err2 := reorgSwaps.MarkReorged(swap, err.Error())
+	sendReorgAlert(swap, err)
+	return err2
+}
+
+func sendReorgAlert(swap *mongodb.MgoSwapResult, reorgErr error) {
+	url := serverCfg.ReorgAlertWebhook
+	if url == "" {
+		return
+	}
+	alert := &ReorgAlert{
+		FromChainID: swap.FromChainID,
+		ToChainID:   swap.ToChainID,
+		TxID:        swap.TxID,
+		LogIndex:    swap.LogIndex,
+		SwapTx:      swap.SwapTx,
+		Value:       swap.Value,
+		Error:       reorgErr.Error(),
+		Timestamp:   time.Now().Unix(),
+	}
+	resp, err := client.HTTPPost(url, alert, nil, nil, reorgAlertTimeout)
+	if err != nil {
+		logWorkerError("reorg", "send reorg alert failed", err, "txid", swap.TxID)
+		return
+	}
+	_ = resp.Body.Close()
+}
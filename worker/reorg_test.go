@@ -0,0 +1,170 @@
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+const (
+	testReorgEVMChainID   = "1"
+	testReorgOtherChainID = "2"
+	testReorgPageSize     = 2
+)
+
+// memReorgSwapStore stores swap results in memory, pages are small
+// to check results leaving the stable status do not shift paging
+type memReorgSwapStore []*mongodb.MgoSwapResult
+
+func (m memReorgSwapStore) FindStable(sinceTime int64, afterKey string) ([]*mongodb.MgoSwapResult, error) {
+	sorted := append([]*mongodb.MgoSwapResult{}, m...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Timestamp != sorted[j].Timestamp {
+			return sorted[i].Timestamp < sorted[j].Timestamp
+		}
+		return sorted[i].Key < sorted[j].Key
+	})
+	var result []*mongodb.MgoSwapResult
+	for _, swap := range sorted {
+		if swap.Status != mongodb.MatchTxStable || swap.Timestamp < sinceTime ||
+			(swap.Timestamp == sinceTime && swap.Key <= afterKey) {
+			continue
+		}
+		result = append(result, swap)
+		if len(result) == testReorgPageSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m memReorgSwapStore) MarkReorged(swap *mongodb.MgoSwapResult, memo string) error {
+	for _, res := range m {
+		if res.Key == swap.Key {
+			res.Status = mongodb.SourceTxReorged
+			res.Memo = memo
+		}
+	}
+	return nil
+}
+
+// reorgTestBridge is an EVM like bridge with source tx checker
+type reorgTestBridge struct {
+	tokens.IBridge
+	reorged map[string]bool
+}
+
+func (b *reorgTestBridge) CheckSourceTx(txHash string) error {
+	if b.reorged[txHash] {
+		return tokens.ErrSourceTxReorged
+	}
+	return nil
+}
+
+// nonEVMTestBridge has no source tx checker and its node finds no tx
+type nonEVMTestBridge struct {
+	tokens.IBridge
+}
+
+func (b *nonEVMTestBridge) GetTransactionStatus(txHash string) (*tokens.TxStatus, error) {
+	return nil, tokens.ErrTxNotFound
+}
+
+func newReorgTestSwap(chainID, txid string, timestamp int64) *mongodb.MgoSwapResult {
+	return &mongodb.MgoSwapResult{
+		Key:         mongodb.GetRouterSwapKey(chainID, txid, 0),
+		FromChainID: chainID,
+		TxID:        txid,
+		Status:      mongodb.MatchTxStable,
+		Timestamp:   timestamp,
+	}
+}
+
+func setReorgTestEnv(t *testing.T, store memReorgSwapStore, bridges map[string]tokens.IBridge) {
+	t.Helper()
+	oldServerCfg := serverCfg
+	serverCfg = &params.RouterServerConfig{}
+	reorgSwaps = store
+	for chainID, bridge := range bridges {
+		router.RouterBridges.Store(chainID, bridge)
+	}
+	t.Cleanup(func() {
+		serverCfg = oldServerCfg
+		reorgSwaps = mgoReorgSwapStore{}
+		reorgSuspects = make(map[string]int)
+		for chainID := range bridges {
+			router.RouterBridges.Delete(chainID)
+		}
+	})
+}
+
+func TestWatchReorgSwaps(t *testing.T) {
+	evmBridge := &reorgTestBridge{reorged: make(map[string]bool)}
+	store := memReorgSwapStore{}
+	for i := 0; i < 5; i++ {
+		txid := fmt.Sprintf("0x%064d", i)
+		// equal timestamps are paged by key
+		store = append(store, newReorgTestSwap(testReorgEVMChainID, txid, int64(100+i/2)))
+		evmBridge.reorged[txid] = true
+	}
+	okSwap := newReorgTestSwap(testReorgEVMChainID, "0xok", 101)
+	oldSwap := newReorgTestSwap(testReorgEVMChainID, "0xold", 10)
+	evmBridge.reorged[oldSwap.TxID] = true
+	otherSwap := newReorgTestSwap(testReorgOtherChainID, "other", 101)
+	store = append(store, okSwap, oldSwap, otherSwap)
+
+	setReorgTestEnv(t, store, map[string]tokens.IBridge{
+		testReorgEVMChainID:   evmBridge,
+		testReorgOtherChainID: &nonEVMTestBridge{},
+	})
+
+	for i := 1; i <= reorgConfirmCount; i++ {
+		watchReorgSwaps(50)
+		for _, swap := range store[:5] {
+			wantReorged := i == reorgConfirmCount
+			if (swap.Status == mongodb.SourceTxReorged) != wantReorged {
+				t.Errorf("check %d: swap %v status is %v, want reorged %v", i, swap.TxID, swap.Status.String(), wantReorged)
+			}
+		}
+	}
+	if okSwap.Status != mongodb.MatchTxStable {
+		t.Errorf("swap with source tx on chain is marked %v", okSwap.Status.String())
+	}
+	if oldSwap.Status != mongodb.MatchTxStable {
+		t.Errorf("swap stable before the window is marked %v", oldSwap.Status.String())
+	}
+	if otherSwap.Status != mongodb.MatchTxStable {
+		t.Errorf("swap of non-EVM chain with tx not found is marked %v", otherSwap.Status.String())
+	}
+}
+
+func TestReorgSuspectsReset(t *testing.T) {
+	evmBridge := &reorgTestBridge{reorged: make(map[string]bool)}
+	swap := newReorgTestSwap(testReorgEVMChainID, "0x1234", 100)
+	store := memReorgSwapStore{swap}
+	setReorgTestEnv(t, store, map[string]tokens.IBridge{testReorgEVMChainID: evmBridge})
+
+	// the tx comes back before confirmed reorged
+	evmBridge.reorged[swap.TxID] = true
+	for i := 1; i < reorgConfirmCount; i++ {
+		watchReorgSwaps(0)
+	}
+	evmBridge.reorged[swap.TxID] = false
+	watchReorgSwaps(0)
+	evmBridge.reorged[swap.TxID] = true
+	for i := 1; i < reorgConfirmCount; i++ {
+		watchReorgSwaps(0)
+	}
+	if swap.Status != mongodb.MatchTxStable {
+		t.Fatalf("swap is marked %v without consecutive reorged checks", swap.Status.String())
+	}
+	watchReorgSwaps(0)
+	if swap.Status != mongodb.SourceTxReorged {
+		t.Errorf("swap is not marked reorged after %d consecutive checks", reorgConfirmCount)
+	}
+}
@@ -1,11 +1,17 @@
 package worker
 
 import (
+	"encoding/json"
+	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/deltaswapio/swaprouter/v3/common"
+	"github.com/deltaswapio/swaprouter/v3/common/hexutil"
 	"github.com/deltaswapio/swaprouter/v3/mpc"
 	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
 	"github.com/deltaswapio/swaprouter/v3/rpc/client"
 )
 
@@ -13,8 +19,83 @@ var (
 	reportStatStarter sync.Once
 
 	reportInterval = 120 * time.Second
+
+	// ReportStaleInterval report older than this is treated as stale
+	ReportStaleInterval = int64(3 * reportInterval / time.Second)
+
+	acceptAgreeCount    uint64
+	acceptDisagreeCount uint64
 )
 
+// OracleReport oracle health report (signed by the mpc user keystore of oracle)
+type OracleReport struct {
+	Enode            string                    `json:"enode"`
+	Timestamp        int64                     `json:"timestamp"`
+	Version          string                    `json:"version,omitempty"`
+	ConfigHash       string                    `json:"configHash,omitempty"`
+	ChainHeights     map[string]uint64         `json:"chainHeights,omitempty"` // key is chainID
+	Gateways         map[string]*GatewayHealth `json:"gateways,omitempty"`     // key is chainID
+	AcceptAgree      uint64                    `json:"acceptAgree,omitempty"`
+	AcceptDisagree   uint64                    `json:"acceptDisagree,omitempty"`
+	PendingSignInfos int                       `json:"pendingSignInfos,omitempty"`
+	User             string                    `json:"user,omitempty"`
+	Signature        hexutil.Bytes             `json:"signature,omitempty"`
+}
+
+// GatewayHealth gateway health
+type GatewayHealth struct {
+	Healthy int `json:"healthy"`
+	Total   int `json:"total"`
+}
+
+// SignHash the hash of report content (exclude signature)
+func (r *OracleReport) SignHash() []byte {
+	content := *r
+	content.Signature = nil
+	data, _ := json.Marshal(&content)
+	return common.Keccak256Hash(data).Bytes()
+}
+
+// RecoverSigner recover the signer of the signed report
+func (r *OracleReport) RecoverSigner() (string, error) {
+	if len(r.Signature) == 0 {
+		return "", errors.New("report is not signed")
+	}
+	signer, err := mpc.RecoverSigner(r.SignHash(), r.Signature)
+	if err != nil {
+		return "", err
+	}
+	return signer.LowerHex(), nil
+}
+
+// VerifySignature verify the report is signed by the specified user
+func (r *OracleReport) VerifySignature(user string) bool {
+	if !common.IsEqualIgnoreCase(r.User, user) {
+		return false
+	}
+	signer, err := r.RecoverSigner()
+	if err != nil {
+		return false
+	}
+	return common.IsEqualIgnoreCase(signer, user)
+}
+
+// GetConfigHash get hash of the config shared by server and oracles
+func GetConfigHash() string {
+	cfg := params.GetRouterConfig()
+	shared := map[string]interface{}{
+		"identifier":  cfg.Identifier,
+		"swapType":    cfg.SwapType,
+		"swapSubType": cfg.SwapSubType,
+		"extra":       cfg.Extra,
+	}
+	if len(cfg.SwapTypes) > 0 {
+		shared["swapTypes"] = cfg.SwapTypes
+	}
+	data, _ := json.Marshal(shared)
+	return common.Keccak256Hash(data).Hex()
+}
+
 // StartReportStatJob report stat job
 func StartReportStatJob() {
 	if params.GetRouterOracleConfig() == nil {
@@ -36,16 +117,17 @@ func reportStat() {
 
 func doReport() {
 	method := "swap.ReportOracleInfo"
-	timestamp := time.Now().Unix()
-	args := map[string]interface{}{
-		"enode":     mpc.GetMPCConfig(false).GetSelfEnode(),
-		"timestamp": timestamp,
+	report := collectOracleReport()
+	sig, err := mpc.GetMPCConfig(false).SignWithUserKey(report.SignHash())
+	if err != nil {
+		logWorkerWarn("reportstat", "sign report failed", "err", err)
+	} else {
+		report.Signature = sig
 	}
 	url := params.GetRouterOracleConfig().ServerAPIAddress
 	var result string
-	var err error
 	for i := 0; i < 3; i++ {
-		err = client.RPCPostWithTimeout(20, &result, url, method, args)
+		err = client.RPCPostWithTimeout(20, &result, url, method, report)
 		if err == nil {
 			break
 		}
@@ -53,6 +135,58 @@ func doReport() {
 	if err != nil {
 		logWorkerWarn("reportstat", "report stat failed", "err", err)
 	} else {
-		logWorker("reportstat", "report stat success", "timestamp", timestamp)
+		logWorker("reportstat", "report stat success", "timestamp", report.Timestamp)
+	}
+}
+
+func collectOracleReport() *OracleReport {
+	mpcConfig := mpc.GetMPCConfig(false)
+	report := &OracleReport{
+		Enode:            mpcConfig.GetSelfEnode(),
+		Version:          params.VersionWithMeta,
+		ConfigHash:       GetConfigHash(),
+		ChainHeights:     make(map[string]uint64),
+		Gateways:         make(map[string]*GatewayHealth),
+		AcceptAgree:      atomic.LoadUint64(&acceptAgreeCount),
+		AcceptDisagree:   atomic.LoadUint64(&acceptDisagreeCount),
+		PendingSignInfos: getPendingSignInfoCount(),
+	}
+	if user := mpcConfig.GetSelfUser(); user != (common.Address{}) {
+		report.User = user.LowerHex()
+	}
+	for _, chainID := range router.AllChainIDs {
+		cid := chainID.String()
+		bridge := router.GetBridgeByChainID(cid)
+		if bridge == nil {
+			continue
+		}
+		if height, err := bridge.GetLatestBlockNumber(); err == nil {
+			report.ChainHeights[cid] = height
+		}
+		gateway := bridge.GetGatewayConfig()
+		if gateway == nil {
+			continue
+		}
+		urls := gateway.AllGatewayURLs
+		if len(urls) == 0 {
+			urls = gateway.APIAddress
+		}
+		health := &GatewayHealth{Total: len(urls)}
+		for _, url := range urls {
+			if _, err := bridge.GetLatestBlockNumberOf(url); err == nil {
+				health.Healthy++
+			}
+		}
+		report.Gateways[cid] = health
+	}
+	// set timestamp at last as the above collecting may take a while
+	report.Timestamp = time.Now().Unix()
+	return report
+}
+
+func getPendingSignInfoCount() (count int) {
+	for _, acceptWorker := range acceptWorkers {
+		count += acceptWorker.acceptInfoQueue.Len()
 	}
+	return count
 }
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/tools/crypto"
+)
+
+func TestOracleReportSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	user := crypto.PubkeyToAddress(key.PublicKey).LowerHex()
+
+	report := &OracleReport{Enode: "enode", Timestamp: 1, User: user}
+	if _, err := report.RecoverSigner(); err == nil {
+		t.Error("recover signer of unsigned report succeed")
+	}
+	sig, err := crypto.Sign(report.SignHash(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report.Signature = sig
+
+	signer, err := report.RecoverSigner()
+	if err != nil || signer != user {
+		t.Errorf("recover signer = %v (err %v), want %v", signer, err, user)
+	}
+	if !report.VerifySignature(user) {
+		t.Error("verify signature of the user failed")
+	}
+	if report.VerifySignature("0x0000000000000000000000000000000000000001") {
+		t.Error("verify signature of other user succeed")
+	}
+
+	// signature does not cover the tampered content
+	report.Timestamp++
+	if signer, _ = report.RecoverSigner(); signer == user {
+		t.Error("recover signer of tampered report is the user")
+	}
+	if report.VerifySignature(user) {
+		t.Error("verify signature of tampered report succeed")
+	}
+}
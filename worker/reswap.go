@@ -160,7 +160,7 @@ func reswapRouterSwap(res *mongodb.MgoSwapResult, isManual bool) error {
 	if err != nil {
 		return err
 	}
-	routerMPC, err := router.GetRouterMPC(swap.GetTokenID(), res.ToChainID)
+	routerMPC, err := router.GetRouterMPC(tokens.SwapType(swap.SwapType), swap.GetTokenID(), res.ToChainID)
 	if err != nil {
 		return err
 	}
@@ -191,7 +191,11 @@ func reswapRouterSwap(res *mongodb.MgoSwapResult, isManual bool) error {
 		OriginFrom:  swap.From,
 		OriginTxTo:  swap.TxTo,
 		OriginValue: biValue,
-		Extra:       &tokens.AllExtras{},
+		Extra: &tokens.AllExtras{
+			// escalate priority fee (eg. solana) by the count of sent txs,
+			// it is not a replacing, so do not use `ReplaceNum` here.
+			EscalateNum: uint64(len(res.OldSwapTxs)),
+		},
 	}
 	args.SwapInfo, err = mongodb.ConvertFromSwapInfo(&swap.SwapInfo)
 	if err != nil {
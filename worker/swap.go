@@ -81,7 +81,9 @@ func startSwapProducer() {
 			case err == nil:
 				logWorker("swap", "process router swap success", ctx...)
 			case errors.Is(err, errAlreadySwapped),
-				errors.Is(err, errChainIsPaused):
+				errors.Is(err, errChainIsPaused),
+				errors.Is(err, tokens.ErrInsufficientLiquidity),
+				errors.Is(err, tokens.ErrMissTrustline):
 				ctx = append(ctx, "err", err)
 				logWorkerTrace("swap", "process router swap error", ctx...)
 			default:
@@ -162,12 +164,26 @@ func processRouterSwap(swap *mongodb.MgoSwap) (err error) {
 		return err
 	}
 
+	if cfg := params.GetRouterServerConfig(); cfg != nil && cfg.EnableCheckLiquidity {
+		err = checkSwapInLiquidity(swap, dstBridge)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg := params.GetRouterServerConfig(); cfg != nil && cfg.EnableCheckTrustline {
+		err = checkSwapTrustline(swap)
+		if err != nil {
+			return err
+		}
+	}
+
 	biFromChainID, biToChainID, biValue, err := getFromToChainIDAndValue(fromChainID, toChainID, res.Value)
 	if err != nil {
 		return err
 	}
 
-	routerMPC, err := router.GetRouterMPC(swap.GetTokenID(), toChainID)
+	routerMPC, err := router.GetRouterMPC(tokens.SwapType(swap.SwapType), swap.GetTokenID(), toChainID)
 	if err != nil {
 		return err
 	}
@@ -189,6 +205,10 @@ func processRouterSwap(swap *mongodb.MgoSwap) (err error) {
 		OriginValue: biValue,
 		Extra:       &tokens.AllExtras{},
 	}
+	if args.Reswapping {
+		// bumped gas limit of auto reswapping out of gas swaps
+		args.Extra.Gas = getReswapGasLimit(swap.Key)
+	}
 	args.SwapInfo, err = mongodb.ConvertFromSwapInfo(&res.SwapInfo)
 	if err != nil {
 		return err
@@ -260,6 +280,9 @@ func dispatchSwapTask(args *tokens.BuildTxArgs) error {
 	if !args.SwapType.IsValidType() {
 		return fmt.Errorf("unknown router swap type %d", args.SwapType)
 	}
+	if !tokens.IsSwapTypeEnabled(args.SwapType) {
+		return fmt.Errorf("router swap type %v is not enabled", args.SwapType.String())
+	}
 
 	chainID := args.ToChainID.String()
 	taskQueue, exist := swapTaskQueues[chainID]
@@ -279,8 +302,7 @@ func dispatchSwapTask(args *tokens.BuildTxArgs) error {
 
 	taskQueue.Add(args)
 
-	cacheKey := mongodb.GetRouterSwapKey(args.FromChainID.String(), args.SwapID, args.LogIndex)
-	swapTasksInQueue.Add(cacheKey)
+	swapTasksInQueue.Add(getSwapTaskKey(args))
 
 	return nil
 }
@@ -320,21 +342,41 @@ func startSwapConsumer(chainID string) {
 		}
 		logWorker("doSwap", "process router swap start", "args", args)
 		ctx := []interface{}{"fromChainID", args.FromChainID, "toChainID", args.ToChainID, "txid", args.SwapID, "logIndex", args.LogIndex}
-		err := doSwap(args)
+		var err error
+		switch {
+		case args.IsRefund():
+			err = doRefund(args)
+		case args.IsGasDrop():
+			err = doGasDrop(args)
+		default:
+			err = doSwap(args)
+		}
 		switch {
 		case err == nil:
 			logWorker("doSwap", "process router swap success", ctx...)
 		case errors.Is(err, errAlreadySwapped),
-			errors.Is(err, tokens.ErrNoBridgeForChainID):
+			errors.Is(err, errAlreadyRefunded),
+			errors.Is(err, errAlreadyGasDropped),
+			errors.Is(err, tokens.ErrNoBridgeForChainID),
+			isOfflineSignWaiting(err):
 			ctx = append(ctx, "err", err)
 			logWorkerTrace("doSwap", "process router swap failed", ctx...)
 		default:
 			logWorkerError("doSwap", "process router swap failed", err, ctx...)
 		}
 
-		cacheKey := mongodb.GetRouterSwapKey(args.FromChainID.String(), args.SwapID, args.LogIndex)
-		swapTasksInQueue.Remove(cacheKey)
+		swapTasksInQueue.Remove(getSwapTaskKey(args))
+	}
+}
+
+func getSwapTaskKey(args *tokens.BuildTxArgs) string {
+	if args.IsRefund() {
+		return getRefundTaskKey(args.FromChainID.String(), args.SwapID, args.LogIndex)
+	}
+	if args.IsGasDrop() {
+		return getGasDropTaskKey(args.FromChainID.String(), args.SwapID, args.LogIndex)
 	}
+	return mongodb.GetRouterSwapKey(args.FromChainID.String(), args.SwapID, args.LogIndex)
 }
 
 func checkAndUpdateProcessSwapTaskCache(key string) error {
@@ -381,6 +423,12 @@ func doSwap(args *tokens.BuildTxArgs) (err error) {
 		return tokens.ErrNoBridgeForChainID
 	}
 
+	err = restoreOfflineSignExtras(toChainID, cacheKey, args)
+	if err != nil {
+		return err
+	}
+	defer func() { updateOfflineSignExtras(toChainID, cacheKey, args, err) }()
+
 	start := time.Now()
 	rawTx, err := resBridge.BuildRawTransaction(args)
 	if err != nil {
@@ -388,6 +436,12 @@ func doSwap(args *tokens.BuildTxArgs) (err error) {
 		if errors.Is(err, tokens.ErrBuildTxErrorAndDelay) {
 			_ = updateSwapMemo(fromChainID, txid, logIndex, err.Error())
 		}
+		if errors.Is(err, tokens.ErrTxRevertPermanently) {
+			parkRevertedSwap(fromChainID, txid, logIndex, err)
+		}
+		if errors.Is(err, tokens.ErrMissTrustline) {
+			parkMissTrustlineSwap(fromChainID, txid, logIndex, err)
+		}
 		return err
 	}
 	if args.SwapValue == nil {
@@ -437,6 +491,13 @@ func doSwap(args *tokens.BuildTxArgs) (err error) {
 	}
 	isCachedSwapProcessed = true
 
+	if args.Extra.GasDrop != nil {
+		err = addGasDropForSwap(args)
+		if err != nil {
+			logWorkerError("doSwap", "add gas drop failed", err, "fromChainID", fromChainID, "toChainID", toChainID, "txid", txid, "logIndex", logIndex, "gasDrop", args.Extra.GasDrop)
+		}
+	}
+
 	err = mongodb.UpdateRouterSwapStatus(fromChainID, txid, logIndex, mongodb.TxProcessed, now(), "")
 	if err != nil {
 		logWorkerError("doSwap", "update router swap status failed", err, "fromChainID", fromChainID, "toChainID", toChainID, "txid", txid, "logIndex", logIndex)
@@ -494,6 +555,12 @@ func doSwapParallel(args *tokens.BuildTxArgs) (err error) {
 		if errors.Is(err, tokens.ErrBuildTxErrorAndDelay) {
 			_ = updateSwapMemo(fromChainID, txid, logIndex, err.Error())
 		}
+		if errors.Is(err, tokens.ErrTxRevertPermanently) {
+			parkRevertedSwap(fromChainID, txid, logIndex, err)
+		}
+		if errors.Is(err, tokens.ErrMissTrustline) {
+			parkMissTrustlineSwap(fromChainID, txid, logIndex, err)
+		}
 		return err
 	}
 
@@ -504,6 +571,17 @@ func doSwapParallel(args *tokens.BuildTxArgs) (err error) {
 	return nil
 }
 
+// parkRevertedSwap park swap whose payout will revert permanently
+// in simulation, and store the revert reason for manual processing.
+func parkRevertedSwap(fromChainID, txid string, logIndex int, err error) {
+	memo := err.Error()
+	logWorkerWarn("doSwap", "park swap as payout will revert", "fromChainID", fromChainID, "txid", txid, "logIndex", logIndex, "reason", memo)
+	if err := mongodb.UpdateRouterSwapStatus(fromChainID, txid, logIndex, mongodb.TxSimulateReverted, now(), memo); err == nil {
+		notifyRegisteredSwapStatus(fromChainID, txid, logIndex)
+	}
+	_ = updateSwapMemo(fromChainID, txid, logIndex, memo)
+}
+
 func signAndSendTx(rawTx interface{}, args *tokens.BuildTxArgs) error {
 	fromChainID := args.FromChainID.String()
 	toChainID := args.ToChainID.String()
@@ -514,6 +592,11 @@ func signAndSendTx(rawTx interface{}, args *tokens.BuildTxArgs) error {
 
 	start := time.Now()
 	signedTx, txHash, err := resBridge.MPCSignTransaction(rawTx, args)
+	// the sign task is running in its own goroutine, wait the offline signature here
+	for errors.Is(err, mpc.ErrOfflineSignPending) && !utils.IsCleanuping() {
+		sleepSeconds(offlineSignRetryInterval)
+		signedTx, txHash, err = resBridge.MPCSignTransaction(rawTx, args)
+	}
 	if err != nil {
 		logWorkerError("doSwap", "sign tx failed", err, "fromChainID", fromChainID, "toChainID", toChainID, "txid", txid, "logIndex", logIndex, "swapNonce", swapTxNonce, "timespent", time.Since(start).String())
 		if errors.Is(err, mpc.ErrGetSignStatusHasDisagree) {
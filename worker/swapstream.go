@@ -0,0 +1,251 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+)
+
+const (
+	defaultSwapStreamHistory = 10000
+	swapStreamChanSize       = 256
+)
+
+var (
+	// ErrSwapStreamSeqExpired resume sequence is older than the kept history
+	ErrSwapStreamSeqExpired = errors.New("swap stream sequence is expired")
+	// ErrSwapStreamEpochMismatch resume sequence is of an earlier process
+	ErrSwapStreamEpochMismatch = errors.New("swap stream epoch mismatch")
+	// ErrWrongSwapStreamID wrong resume id
+	ErrWrongSwapStreamID = errors.New("wrong swap stream id")
+)
+
+// SwapStatusEvent swap status update with sequence number,
+// either the swap result or the registered swap (no result yet) is set.
+type SwapStatusEvent struct {
+	Epoch      uint64
+	Seq        uint64
+	Swap       *mongodb.MgoSwapResult
+	Registered *mongodb.MgoSwap
+}
+
+// ID the resume id of the event in '<epoch>-<seq>' format
+func (e *SwapStatusEvent) ID() string {
+	return fmt.Sprintf("%d-%d", e.Epoch, e.Seq)
+}
+
+// ParseSwapStreamID parse resume id in '<epoch>-<seq>' format
+func ParseSwapStreamID(id string) (epoch, seq uint64, err error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 2 {
+		return 0, 0, ErrWrongSwapStreamID
+	}
+	epoch, err = strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, ErrWrongSwapStreamID
+	}
+	seq, err = strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, ErrWrongSwapStreamID
+	}
+	return epoch, seq, nil
+}
+
+// SwapStatusFilter subscribe swaps by source txid, by address (sender or receiver), or by tokenID
+type SwapStatusFilter struct {
+	FromChainID string
+	TxID        string
+	Address     string
+	TokenID     string
+}
+
+// IsEmpty is empty filter
+func (f *SwapStatusFilter) IsEmpty() bool {
+	return f.TxID == "" && f.Address == "" && f.TokenID == ""
+}
+
+// Match is swap status event match the filter
+func (f *SwapStatusFilter) Match(event *SwapStatusEvent) bool {
+	if event.Swap != nil {
+		swap := event.Swap
+		return f.match(swap.FromChainID, swap.TxID, swap.From, swap.Bind, swap.GetTokenID())
+	}
+	if event.Registered != nil {
+		swap := event.Registered
+		return f.match(swap.FromChainID, swap.TxID, swap.From, swap.Bind, swap.GetTokenID())
+	}
+	return false
+}
+
+func (f *SwapStatusFilter) match(fromChainID, txid, from, bind, tokenID string) bool {
+	if f.FromChainID != "" && f.FromChainID != fromChainID {
+		return false
+	}
+	if f.TxID != "" && !strings.EqualFold(f.TxID, txid) {
+		return false
+	}
+	if f.Address != "" && !strings.EqualFold(f.Address, from) && !strings.EqualFold(f.Address, bind) {
+		return false
+	}
+	if f.TokenID != "" && !strings.EqualFold(f.TokenID, tokenID) {
+		return false
+	}
+	return true
+}
+
+// SwapStatusSubscription subscription of swap status updates.
+// the channel is closed if the subscriber is too slow, and it should resume by the last id.
+type SwapStatusSubscription struct {
+	filter *SwapStatusFilter
+	ch     chan *SwapStatusEvent
+	once   sync.Once
+}
+
+// Chan get the channel of swap status updates
+func (s *SwapStatusSubscription) Chan() <-chan *SwapStatusEvent {
+	return s.ch
+}
+
+// Unsubscribe unsubscribe swap status updates
+func (s *SwapStatusSubscription) Unsubscribe() {
+	swapStreamLock.Lock()
+	defer swapStreamLock.Unlock()
+	s.close()
+}
+
+func (s *SwapStatusSubscription) close() {
+	s.once.Do(func() {
+		delete(swapStreamSubs, s)
+		close(s.ch)
+	})
+}
+
+var (
+	swapStreamLock sync.Mutex
+	// sequences restart from 1 in every process, the epoch tells them apart
+	swapStreamEpoch   = uint64(time.Now().UnixNano())
+	swapStreamSeq     uint64
+	swapStreamHistory []*SwapStatusEvent // ascending by sequence
+	swapStreamSubs    = make(map[*SwapStatusSubscription]struct{})
+)
+
+// IsSwapStreamEnabled is swap status streaming enabled
+func IsSwapStreamEnabled() bool {
+	serverCfg := params.GetRouterServerConfig()
+	return serverCfg != nil && serverCfg.APIServer != nil && serverCfg.APIServer.EnableSwapStream
+}
+
+func getSwapStreamHistorySize() int {
+	serverCfg := params.GetRouterServerConfig()
+	if serverCfg != nil && serverCfg.APIServer != nil && serverCfg.APIServer.SwapStreamHistory > 0 {
+		return serverCfg.APIServer.SwapStreamHistory
+	}
+	return defaultSwapStreamHistory
+}
+
+// SubscribeSwapStatus subscribe swap status updates matching the filter,
+// and returns the kept updates after 'fromID' to resume (empty means not resuming).
+func SubscribeSwapStatus(filter *SwapStatusFilter, fromID string) (*SwapStatusSubscription, []*SwapStatusEvent, error) {
+	var epoch, fromSeq uint64
+	if fromID != "" {
+		var err error
+		epoch, fromSeq, err = ParseSwapStreamID(fromID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	swapStreamLock.Lock()
+	defer swapStreamLock.Unlock()
+
+	var backlog []*SwapStatusEvent
+	if fromID != "" {
+		if epoch != swapStreamEpoch {
+			return nil, nil, ErrSwapStreamEpochMismatch
+		}
+		if fromSeq > swapStreamSeq ||
+			(len(swapStreamHistory) > 0 && fromSeq+1 < swapStreamHistory[0].Seq) {
+			return nil, nil, ErrSwapStreamSeqExpired
+		}
+		for _, event := range swapStreamHistory {
+			if event.Seq > fromSeq && filter.Match(event) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := &SwapStatusSubscription{
+		filter: filter,
+		ch:     make(chan *SwapStatusEvent, swapStreamChanSize),
+	}
+	swapStreamSubs[sub] = struct{}{}
+	return sub, backlog, nil
+}
+
+// publishSwapStatus assign sequence to the event and send it to the matched subscribers
+func publishSwapStatus(event *SwapStatusEvent) {
+	swapStreamLock.Lock()
+	defer swapStreamLock.Unlock()
+
+	swapStreamSeq++
+	event.Epoch = swapStreamEpoch
+	event.Seq = swapStreamSeq
+
+	swapStreamHistory = append(swapStreamHistory, event)
+	if overflow := len(swapStreamHistory) - getSwapStreamHistorySize(); overflow > 0 {
+		swapStreamHistory = append(swapStreamHistory[:0:0], swapStreamHistory[overflow:]...)
+	}
+
+	for sub := range swapStreamSubs {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			logWorkerWarn("swapstream", "drop slow subscriber", "seq", event.Seq)
+			sub.close()
+		}
+	}
+}
+
+// notifySwapStatus publish the latest swap result after status transitions
+func notifySwapStatus(fromChainID, txid string, logIndex int) {
+	if !IsSwapStreamEnabled() {
+		return
+	}
+	swap, err := mongodb.FindRouterSwapResult(fromChainID, txid, logIndex)
+	if err != nil {
+		logWorkerWarn("swapstream", "find swap result failed", "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "err", err)
+		return
+	}
+	publishSwapStatus(&SwapStatusEvent{Swap: swap})
+}
+
+// notifyRegisteredSwapStatus publish the registered swap after status transitions
+// of swaps (eg. verify failures and parking), which may have no result yet
+func notifyRegisteredSwapStatus(fromChainID, txid string, logIndex int) {
+	if !IsSwapStreamEnabled() {
+		return
+	}
+	swap, err := mongodb.FindRouterSwap(fromChainID, txid, logIndex)
+	if err != nil {
+		logWorkerWarn("swapstream", "find swap failed", "chainid", fromChainID, "txid", txid, "logIndex", logIndex, "err", err)
+		return
+	}
+	publishSwapStatus(&SwapStatusEvent{Registered: swap})
+}
+
+// NotifySwapRegistered publish the newly registered swap
+func NotifySwapRegistered(swap *mongodb.MgoSwap) {
+	if !IsSwapStreamEnabled() {
+		return
+	}
+	publishSwapStatus(&SwapStatusEvent{Registered: swap})
+}
@@ -0,0 +1,197 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+)
+
+func resetSwapStream() {
+	swapStreamLock.Lock()
+	defer swapStreamLock.Unlock()
+	for sub := range swapStreamSubs {
+		sub.close()
+	}
+	swapStreamSeq = 0
+	swapStreamHistory = nil
+}
+
+func newTestSwapResult(txid, from, bind, tokenID string) *mongodb.MgoSwapResult {
+	res := &mongodb.MgoSwapResult{
+		FromChainID: "1",
+		TxID:        txid,
+		From:        from,
+		Bind:        bind,
+	}
+	res.ERC20SwapInfo = &mongodb.ERC20SwapInfo{TokenID: tokenID}
+	return res
+}
+
+func TestSwapStatusFilter(t *testing.T) {
+	swap := newTestSwapResult("0xAAAA", "0xSender", "0xReceiver", "USDC")
+	registered := &mongodb.MgoSwap{FromChainID: "1", TxID: "0xAAAA", From: "0xSender", Bind: "0xReceiver"}
+	registered.ERC20SwapInfo = &mongodb.ERC20SwapInfo{TokenID: "USDC"}
+
+	tests := []struct {
+		filter SwapStatusFilter
+		match  bool
+	}{
+		{SwapStatusFilter{TxID: "0xaaaa"}, true},
+		{SwapStatusFilter{TxID: "0xbbbb"}, false},
+		{SwapStatusFilter{Address: "0xsender"}, true},
+		{SwapStatusFilter{Address: "0xreceiver"}, true},
+		{SwapStatusFilter{Address: "0xother"}, false},
+		{SwapStatusFilter{TokenID: "usdc"}, true},
+		{SwapStatusFilter{TokenID: "USDT"}, false},
+		{SwapStatusFilter{FromChainID: "1", TxID: "0xAAAA"}, true},
+		{SwapStatusFilter{FromChainID: "56", TxID: "0xAAAA"}, false},
+		{SwapStatusFilter{TxID: "0xAAAA", Address: "0xother"}, false},
+	}
+	for i, tt := range tests {
+		if have := tt.filter.Match(&SwapStatusEvent{Swap: swap}); have != tt.match {
+			t.Errorf("case %d: match swap result = %v, want %v", i, have, tt.match)
+		}
+		if have := tt.filter.Match(&SwapStatusEvent{Registered: registered}); have != tt.match {
+			t.Errorf("case %d: match registered swap = %v, want %v", i, have, tt.match)
+		}
+	}
+	if (&SwapStatusFilter{TxID: "0xAAAA"}).Match(&SwapStatusEvent{}) {
+		t.Error("empty event should not match")
+	}
+	if !(&SwapStatusFilter{FromChainID: "1"}).IsEmpty() {
+		t.Error("filter without txid, address and tokenid should be empty")
+	}
+}
+
+func TestParseSwapStreamID(t *testing.T) {
+	event := &SwapStatusEvent{Epoch: 123, Seq: 45}
+	epoch, seq, err := ParseSwapStreamID(event.ID())
+	if err != nil || epoch != 123 || seq != 45 {
+		t.Errorf("ParseSwapStreamID(%v) = (%v, %v, %v)", event.ID(), epoch, seq, err)
+	}
+	for _, id := range []string{"45", "a-1", "1-b", "1-2-3", "-1", ""} {
+		if _, _, err = ParseSwapStreamID(id); !errors.Is(err, ErrWrongSwapStreamID) {
+			t.Errorf("ParseSwapStreamID(%q) error = %v, want %v", id, err, ErrWrongSwapStreamID)
+		}
+	}
+}
+
+func TestSwapStreamResume(t *testing.T) {
+	resetSwapStream()
+	defer resetSwapStream()
+
+	filter := &SwapStatusFilter{TxID: "0xaaaa"}
+	for i := 0; i < 5; i++ {
+		txid := "0xaaaa"
+		if i%2 == 1 {
+			txid = "0xbbbb"
+		}
+		publishSwapStatus(&SwapStatusEvent{Swap: newTestSwapResult(txid, "", "", "")})
+	}
+
+	// resume after seq 1, get the matched seq 3 and 5
+	sub, backlog, err := SubscribeSwapStatus(filter, fmt.Sprintf("%d-%d", swapStreamEpoch, 1))
+	if err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	defer sub.Unsubscribe()
+	if len(backlog) != 2 || backlog[0].Seq != 3 || backlog[1].Seq != 5 {
+		t.Errorf("wrong backlog %v", backlog)
+	}
+	for _, event := range backlog {
+		if event.Epoch != swapStreamEpoch {
+			t.Errorf("wrong epoch %v of event %v", event.Epoch, event.Seq)
+		}
+	}
+
+	// not resuming
+	sub2, backlog, err := SubscribeSwapStatus(filter, "")
+	if err != nil || len(backlog) != 0 {
+		t.Errorf("subscribe without resume = (%v, %v)", backlog, err)
+	} else {
+		sub2.Unsubscribe()
+	}
+
+	// live events after subscribing
+	publishSwapStatus(&SwapStatusEvent{Swap: newTestSwapResult("0xbbbb", "", "", "")})
+	publishSwapStatus(&SwapStatusEvent{Swap: newTestSwapResult("0xaaaa", "", "", "")})
+	if event := <-sub.Chan(); event.Seq != 7 {
+		t.Errorf("live event seq = %v, want 7", event.Seq)
+	}
+
+	tests := []struct {
+		fromID string
+		err    error
+	}{
+		{fmt.Sprintf("%d-%d", swapStreamEpoch+1, 1), ErrSwapStreamEpochMismatch},
+		{fmt.Sprintf("%d-%d", swapStreamEpoch, 100), ErrSwapStreamSeqExpired},
+		{"3", ErrWrongSwapStreamID},
+	}
+	for _, tt := range tests {
+		if _, _, err = SubscribeSwapStatus(filter, tt.fromID); !errors.Is(err, tt.err) {
+			t.Errorf("resume from %v error = %v, want %v", tt.fromID, err, tt.err)
+		}
+	}
+}
+
+func TestSwapStreamHistoryExpired(t *testing.T) {
+	resetSwapStream()
+	defer resetSwapStream()
+
+	total := defaultSwapStreamHistory + 10
+	for i := 0; i < total; i++ {
+		publishSwapStatus(&SwapStatusEvent{Swap: newTestSwapResult("0xaaaa", "", "", "")})
+	}
+	if len(swapStreamHistory) != defaultSwapStreamHistory || swapStreamHistory[0].Seq != 11 {
+		t.Fatalf("wrong kept history, len %v, first seq %v", len(swapStreamHistory), swapStreamHistory[0].Seq)
+	}
+
+	filter := &SwapStatusFilter{TxID: "0xaaaa"}
+	if _, _, err := SubscribeSwapStatus(filter, fmt.Sprintf("%d-%d", swapStreamEpoch, 9)); !errors.Is(err, ErrSwapStreamSeqExpired) {
+		t.Errorf("resume from dropped seq error = %v, want %v", err, ErrSwapStreamSeqExpired)
+	}
+	// the last seen seq can be just before the first kept one
+	sub, backlog, err := SubscribeSwapStatus(filter, fmt.Sprintf("%d-%d", swapStreamEpoch, 10))
+	if err != nil || len(backlog) != defaultSwapStreamHistory {
+		t.Fatalf("resume from seq 10 = (%v, %v)", len(backlog), err)
+	}
+	sub.Unsubscribe()
+}
+
+func TestSwapStreamSlowSubscriber(t *testing.T) {
+	resetSwapStream()
+	defer resetSwapStream()
+
+	slow, _, err := SubscribeSwapStatus(&SwapStatusFilter{TxID: "0xaaaa"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _, err := SubscribeSwapStatus(&SwapStatusFilter{TxID: "0xbbbb"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Unsubscribe()
+
+	for i := 0; i <= swapStreamChanSize; i++ {
+		publishSwapStatus(&SwapStatusEvent{Swap: newTestSwapResult("0xaaaa", "", "", "")})
+	}
+
+	// the buffered events are delivered, then the channel is closed
+	count := 0
+	for range slow.Chan() {
+		count++
+	}
+	if count != swapStreamChanSize {
+		t.Errorf("received %v events before closed, want %v", count, swapStreamChanSize)
+	}
+	if _, exist := swapStreamSubs[slow]; exist {
+		t.Error("slow subscriber is not removed")
+	}
+	if _, exist := swapStreamSubs[other]; !exist {
+		t.Error("unmatched subscriber should be kept")
+	}
+	// unsubscribe after closed is safe
+	slow.Unsubscribe()
+}
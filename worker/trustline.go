@@ -0,0 +1,149 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/deltaswapio/swaprouter/v3/cmd/utils"
+	"github.com/deltaswapio/swaprouter/v3/mongodb"
+	"github.com/deltaswapio/swaprouter/v3/params"
+	"github.com/deltaswapio/swaprouter/v3/router"
+	"github.com/deltaswapio/swaprouter/v3/tokens"
+)
+
+// StartTrustlineJob resume swaps waiting for the receiver to create trustline
+func StartTrustlineJob() {
+	logWorker("trustline", "start check trustline job")
+	serverCfg = params.GetRouterServerConfig()
+	if serverCfg == nil {
+		logWorker("trustline", "stop check trustline job as no router server config exist")
+		return
+	}
+	if !serverCfg.EnableCheckTrustline {
+		logWorker("trustline", "stop check trustline job as disabled")
+		return
+	}
+	if !tokens.IsERC20Router() {
+		logWorker("trustline", "stop check trustline job as non erc20 swap")
+		return
+	}
+
+	mongodb.MgoWaitGroup.Add(1)
+	go doTrustlineJob()
+}
+
+func doTrustlineJob() {
+	defer mongodb.MgoWaitGroup.Done()
+	for {
+		res, err := FindMissTrustlineSwaps()
+		if err != nil {
+			logWorkerError("trustline", "find miss trustline swaps error", err)
+		}
+		if len(res) > 0 {
+			logWorker("trustline", "find miss trustline swaps", "count", len(res))
+		}
+		// key is toChainID + multichain token + receiver, value is trustline exist or not
+		checked := make(map[string]bool)
+		for _, swap := range res {
+			if utils.IsCleanuping() {
+				logWorker("trustline", "stop check trustline job")
+				return
+			}
+			err = processMissTrustlineSwap(swap, checked)
+			if err != nil {
+				logWorkerError("trustline", "process miss trustline swap error", err, "chainID", swap.FromChainID, "txid", swap.TxID, "logIndex", swap.LogIndex)
+			}
+		}
+		if utils.IsCleanuping() {
+			logWorker("trustline", "stop check trustline job")
+			return
+		}
+		restInJob(restIntervalInTrustlineJob)
+	}
+}
+
+// FindMissTrustlineSwaps find swaps waiting for receiver trustline
+func FindMissTrustlineSwaps() ([]*mongodb.MgoSwap, error) {
+	septime := getSepTimeInFind(maxMissTrustlineLifetime)
+	return mongodb.FindRouterSwapsWithStatus(mongodb.MissTrustline, septime)
+}
+
+// GetSwapTrustline get the trustline required by the receiver of swap,
+// nil info means no trustline is required.
+func GetSwapTrustline(swap *mongodb.MgoSwap) (*tokens.TrustlineInfo, error) {
+	if swap.ERC20SwapInfo == nil {
+		return nil, nil
+	}
+	dstBridge := router.GetBridgeByChainID(swap.ToChainID)
+	if dstBridge == nil {
+		return nil, tokens.ErrNoBridgeForChainID
+	}
+	checker, ok := dstBridge.(tokens.ITrustlineChecker)
+	if !ok {
+		return nil, nil
+	}
+	multichainToken := router.GetCachedMultichainToken(swap.GetTokenID(), swap.ToChainID)
+	if multichainToken == "" {
+		return nil, tokens.ErrMissTokenConfig
+	}
+	return checker.CheckTrustline(multichainToken, swap.Bind)
+}
+
+func processMissTrustlineSwap(swap *mongodb.MgoSwap, checked map[string]bool) error {
+	if swap.Status != mongodb.MissTrustline {
+		return nil
+	}
+	key := swap.ToChainID + ":" + swap.GetTokenID() + ":" + swap.Bind
+	exist, ok := checked[key]
+	if !ok {
+		info, err := GetSwapTrustline(swap)
+		if err != nil {
+			return err
+		}
+		exist = info == nil || info.Exist
+		checked[key] = exist
+	}
+	if !exist {
+		logWorkerTrace("trustline", "receiver still has no trustline", "txid", swap.TxID, "logIndex", swap.LogIndex, "toChainID", swap.ToChainID, "receiver", swap.Bind)
+		return nil
+	}
+	err := mongodb.UpdateRouterSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.TxNotSwapped, now(), "")
+	if err != nil {
+		return err
+	}
+	_ = updateSwapMemo(swap.FromChainID, swap.TxID, swap.LogIndex, "")
+	logWorker("trustline", "resume swap as receiver has created trustline", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "receiver", swap.Bind)
+	return nil
+}
+
+// parkMissTrustlineSwap park swap whose receiver has no trustline found when building the payout,
+// it is resumed by the trustline job after the receiver creates the trustline.
+func parkMissTrustlineSwap(fromChainID, txid string, logIndex int, err error) {
+	memo := err.Error()
+	logWorkerWarn("doSwap", "park swap for missing trustline", "fromChainID", fromChainID, "txid", txid, "logIndex", logIndex, "reason", memo)
+	if err := mongodb.UpdateRouterSwapStatus(fromChainID, txid, logIndex, mongodb.MissTrustline, now(), memo); err == nil {
+		notifyRegisteredSwapStatus(fromChainID, txid, logIndex)
+	}
+	_ = updateSwapMemo(fromChainID, txid, logIndex, memo)
+}
+
+// checkSwapTrustline park the swap if the receiver has no trustline
+// of the issued asset on the dest chain. rpc errors are not blocking.
+func checkSwapTrustline(swap *mongodb.MgoSwap) error {
+	info, err := GetSwapTrustline(swap)
+	if err != nil {
+		logWorkerWarn("swap", "check receiver trustline failed", "toChainID", swap.ToChainID, "receiver", swap.Bind, "err", err)
+		return nil
+	}
+	if info == nil || info.Exist {
+		return nil
+	}
+	memo := fmt.Sprintf("%v, receiver %v, currency %v, issuer %v", tokens.ErrMissTrustline, info.Receiver, info.Currency, info.Issuer)
+	logWorkerWarn("swap", "park swap for missing trustline", "fromChainID", swap.FromChainID, "toChainID", swap.ToChainID, "txid", swap.TxID, "logIndex", swap.LogIndex, "receiver", info.Receiver, "currency", info.Currency, "issuer", info.Issuer)
+	err = mongodb.UpdateRouterSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex, mongodb.MissTrustline, now(), memo)
+	if err != nil {
+		return err
+	}
+	notifyRegisteredSwapStatus(swap.FromChainID, swap.TxID, swap.LogIndex)
+	_ = updateSwapMemo(swap.FromChainID, swap.TxID, swap.LogIndex, memo)
+	return tokens.ErrMissTrustline
+}
@@ -27,6 +27,20 @@ var (
 
 	maxCheckFailedSwapLifetime       = int64(2 * 24 * 3600)
 	restIntervalInCheckFailedSwapJob = 60 * time.Second
+
+	maxInsufficientLiquidityLifetime = int64(30 * 24 * 3600)
+	restIntervalInLiquidityJob       = 60 * time.Second
+
+	maxMissTrustlineLifetime   = int64(30 * 24 * 3600)
+	restIntervalInTrustlineJob = 60 * time.Second
+
+	restIntervalInWatchReorgJob = 300 * time.Second
+
+	maxRefundLifetime       = int64(30 * 24 * 3600)
+	restIntervalInRefundJob = 60 * time.Second
+
+	maxGasDropLifetime       = int64(7 * 24 * 3600)
+	restIntervalInGasDropJob = 30 * time.Second
 )
 
 func now() int64 {
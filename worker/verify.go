@@ -226,11 +226,17 @@ func processRouterSwapVerify(swap *mongodb.MgoSwap) (err error) {
 		}
 	case errors.Is(err, tokens.ErrTxNotStable),
 		errors.Is(err, tokens.ErrRPCQueryError),
+		errors.Is(err, tokens.ErrQuorumNotReached),
 		errors.Is(err, tokens.ErrTxNotFound),
 		errors.Is(err, tokens.ErrNotFound):
 		if swapInfo != nil && swapInfo.Height > 0 {
 			_ = mongodb.UpdateRouterSwapHeight(fromChainID, txid, logIndex, swapInfo.Height)
 		}
+		if errors.Is(err, tokens.ErrTxNotStable) && err.Error() != swap.PendingReason {
+			if mongodb.UpdateRouterSwapPendingReason(fromChainID, txid, logIndex, err.Error()) == nil {
+				notifyRegisteredSwapStatus(fromChainID, txid, logIndex)
+			}
+		}
 		nowMilli := common.NowMilli()
 		if swap.InitTime+1000*maxTxNotFoundTime < nowMilli {
 			duration := time.Duration((nowMilli - swap.InitTime) / 1000 * int64(time.Second))
@@ -259,6 +265,9 @@ func processRouterSwapVerify(swap *mongodb.MgoSwap) (err error) {
 
 	if dbErr != nil {
 		logWorkerError("verify", "verify router swap db error", dbErr, "fromChainID", fromChainID, "toChainID", swap.ToChainID, "txid", txid, "logIndex", logIndex)
+	} else if err != nil || router.IsBigValueSwap(swapInfo) {
+		// the passed swap is published with its initial swap result
+		notifyRegisteredSwapStatus(fromChainID, txid, logIndex)
 	}
 
 	if err != nil {
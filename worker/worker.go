@@ -37,6 +37,21 @@ func StartRouterSwapWork(isServer bool) {
 	StartPassBigValueJob()
 	time.Sleep(interval)
 
+	StartLiquidityJob()
+	time.Sleep(interval)
+
+	StartTrustlineJob()
+	time.Sleep(interval)
+
+	StartWatchReorgJob()
+	time.Sleep(interval)
+
+	StartRefundJob()
+	time.Sleep(interval)
+
+	StartGasDropJob()
+	time.Sleep(interval)
+
 	//StartAggregateJob()
 	//time.Sleep(interval)
 